@@ -58,7 +58,7 @@ func helloWorld() {
 	doc.SetXY(7, 17).
 		SetColor("Red").
 		SetFont("ZapfDingbats", 300).
-		DrawText("a")
+		DrawText("❁")
 	//
 	// save the file:
 	// if the file exists, it will be overwritten
@@ -101,7 +101,7 @@ func corporateIpsum() {
 		FillBox(0, 25, 5, 5). // xywh
 		SetFont("zapfdingbats", 50).
 		SetColor("white").
-		DrawTextInBox(0, 25, 5, 5, "C", string(rune(063)))
+		DrawTextInBox(0, 25, 5, 5, "C", "✓")
 	//
 	// save the file
 	doc.SaveFile(FILENAME)
@@ -171,10 +171,10 @@ func dingbats() {
 			doc.DrawTextInBox(x, y, boxSize, boxSize, "TR",
 				fmt.Sprintf("%d", row*16+col))
 			//
-			// this is the right way to use a dingbat code (0-255):
-			// (casting int to rune to string won't work as expected)
+			// Unicode characters like ✓ or ★ are translated to dingbat
+			// codes. To use a dingbat by its code (0-255), add 0xF000:
 			code := row*16 + col
-			s := string([]byte{byte(code)})
+			s := string(rune(0xF000 + code))
 			//
 			// draw the dingbat icon
 			doc.SetColor("black")
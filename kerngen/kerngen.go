@@ -0,0 +1,276 @@
+// -----------------------------------------------------------------------------
+// github.com/balacode/one-file-pdf            one-file-pdf/kerngen/[kerngen.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package main
+
+// kerngen generates the kerning tables of the built-in fonts in
+// pdf_core.go from the KPX pairs of Adobe's AFM files of the standard
+// 14 fonts (Helvetica.afm, Times-Roman.afm, etc. version 002.000).
+// It is run by 'go generate' in the one-file-pdf directory:
+//
+//     AFM_DIR=/path/to/afm go generate
+//
+// Each table's comment records the AFM file and version it came from.
+// Oblique fonts share the table of their upright font, so kerngen
+// checks that their AFM files have the same KPX pairs.
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// kernTables lists the kerning tables in pdf_core.go, in order,
+// with the fonts kerned by each table
+var kernTables = []struct {
+	name  string
+	fonts []string
+}{
+	{"pdfKernHelvetica", []string{"Helvetica", "Helvetica-Oblique"}},
+	{"pdfKernHelveticaBold",
+		[]string{"Helvetica-Bold", "Helvetica-BoldOblique"}},
+	{"pdfKernTimesBold", []string{"Times-Bold"}},
+	{"pdfKernTimesBoldItalic", []string{"Times-BoldItalic"}},
+	{"pdfKernTimesItalic", []string{"Times-Italic"}},
+	{"pdfKernTimesRoman", []string{"Times-Roman"}},
+} //                                                                  kernTables
+
+// glyphChars maps the names of the glyphs that built-in fonts can draw
+// to the characters written for them. A glyph drawn by more than one
+// character (e.g. 'space' and no-break space) is kerned as the first.
+var glyphChars = map[string]rune{
+	"A": 'A', "AE": 'Æ', "Aacute": 'Á', "Abreve": 'Ă', "Acircumflex": 'Â',
+	"Adieresis": 'Ä', "Agrave": 'À', "Amacron": 'Ā', "Aogonek": 'Ą',
+	"Aring": 'Å', "Atilde": 'Ã', "B": 'B', "C": 'C', "Cacute": 'Ć',
+	"Ccaron": 'Č', "Ccedilla": 'Ç', "D": 'D', "Dcaron": 'Ď', "Dcroat": 'Đ',
+	"Delta": '∆', "E": 'E', "Eacute": 'É', "Ecaron": 'Ě', "Ecircumflex": 'Ê',
+	"Edieresis": 'Ë', "Edotaccent": 'Ė', "Egrave": 'È', "Emacron": 'Ē',
+	"Eogonek": 'Ę', "Eth": 'Ð', "Euro": '€', "F": 'F', "G": 'G', "Gbreve": 'Ğ',
+	"Gcommaaccent": 'Ģ', "H": 'H', "I": 'I', "Iacute": 'Í', "Icircumflex": 'Î',
+	"Idieresis": 'Ï', "Idotaccent": 'İ', "Igrave": 'Ì', "Imacron": 'Ī',
+	"Iogonek": 'Į', "J": 'J', "K": 'K', "Kcommaaccent": 'Ķ', "L": 'L',
+	"Lacute": 'Ĺ', "Lcaron": 'Ľ', "Lcommaaccent": 'Ļ', "Lslash": 'Ł', "M": 'M',
+	"N": 'N', "Nacute": 'Ń', "Ncaron": 'Ň', "Ncommaaccent": 'Ņ', "Ntilde": 'Ñ',
+	"O": 'O', "OE": 'Œ', "Oacute": 'Ó', "Ocircumflex": 'Ô', "Odieresis": 'Ö',
+	"Ograve": 'Ò', "Ohungarumlaut": 'Ő', "Omacron": 'Ō', "Oslash": 'Ø',
+	"Otilde": 'Õ', "P": 'P', "Q": 'Q', "R": 'R', "Racute": 'Ŕ', "Rcaron": 'Ř',
+	"Rcommaaccent": 'Ŗ', "S": 'S', "Sacute": 'Ś', "Scaron": 'Š',
+	"Scedilla": 'Ş', "Scommaaccent": 'Ș', "T": 'T', "Tcaron": 'Ť',
+	"Tcommaaccent": 'Ţ', "Thorn": 'Þ', "U": 'U', "Uacute": 'Ú',
+	"Ucircumflex": 'Û', "Udieresis": 'Ü', "Ugrave": 'Ù', "Uhungarumlaut": 'Ű',
+	"Umacron": 'Ū', "Uogonek": 'Ų', "Uring": 'Ů', "V": 'V', "W": 'W', "X": 'X',
+	"Y": 'Y', "Yacute": 'Ý', "Ydieresis": 'Ÿ', "Z": 'Z', "Zacute": 'Ź',
+	"Zcaron": 'Ž', "Zdotaccent": 'Ż', "a": 'a', "aacute": 'á', "abreve": 'ă',
+	"acircumflex": 'â', "acute": '´', "adieresis": 'ä', "ae": 'æ',
+	"agrave": 'à', "amacron": 'ā', "ampersand": '&', "aogonek": 'ą',
+	"aring": 'å', "asciicircum": '^', "asciitilde": '~', "asterisk": '*',
+	"at": '@', "atilde": 'ã', "b": 'b', "backslash": '\\', "bar": '|',
+	"braceleft": '{', "braceright": '}', "bracketleft": '[',
+	"bracketright": ']', "breve": '˘', "brokenbar": '¦', "bullet": '•',
+	"c": 'c', "cacute": 'ć', "caron": 'ˇ', "ccaron": 'č', "ccedilla": 'ç',
+	"cedilla": '¸', "cent": '¢', "circumflex": 'ˆ', "colon": ':', "comma": ',',
+	"copyright": '©', "currency": '¤', "d": 'd', "dagger": '†',
+	"daggerdbl": '‡', "dcaron": 'ď', "dcroat": 'đ', "degree": '°',
+	"dieresis": '¨', "divide": '÷', "dollar": '$', "dotaccent": '˙',
+	"dotlessi": 'ı', "e": 'e', "eacute": 'é', "ecaron": 'ě',
+	"ecircumflex": 'ê', "edieresis": 'ë', "edotaccent": 'ė', "egrave": 'è',
+	"eight": '8', "ellipsis": '…', "emacron": 'ē', "emdash": '—',
+	"endash": '–', "eogonek": 'ę', "equal": '=', "eth": 'ð', "exclam": '!',
+	"exclamdown": '¡', "f": 'f', "fi": 'ﬁ', "five": '5', "fl": 'ﬂ',
+	"florin": 'ƒ', "four": '4', "fraction": '⁄', "g": 'g', "gbreve": 'ğ',
+	"gcommaaccent": 'ģ', "germandbls": 'ß', "grave": '`', "greater": '>',
+	"greaterequal": '≥', "guillemotleft": '«', "guillemotright": '»',
+	"guilsinglleft": '‹', "guilsinglright": '›', "h": 'h', "hungarumlaut": '˝',
+	"hyphen": '-', "i": 'i', "iacute": 'í', "icircumflex": 'î',
+	"idieresis": 'ï', "igrave": 'ì', "imacron": 'ī', "iogonek": 'į', "j": 'j',
+	"k": 'k', "kcommaaccent": 'ķ', "l": 'l', "lacute": 'ĺ', "lcaron": 'ľ',
+	"lcommaaccent": 'ļ', "less": '<', "lessequal": '≤', "logicalnot": '¬',
+	"lozenge": '◊', "lslash": 'ł', "m": 'm', "macron": '¯', "minus": '−',
+	"mu": 'µ', "multiply": '×', "n": 'n', "nacute": 'ń', "ncaron": 'ň',
+	"ncommaaccent": 'ņ', "nine": '9', "notequal": '≠', "ntilde": 'ñ',
+	"numbersign": '#', "o": 'o', "oacute": 'ó', "ocircumflex": 'ô',
+	"odieresis": 'ö', "oe": 'œ', "ogonek": '˛', "ograve": 'ò',
+	"ohungarumlaut": 'ő', "omacron": 'ō', "one": '1', "onehalf": '½',
+	"onequarter": '¼', "onesuperior": '¹', "ordfeminine": 'ª',
+	"ordmasculine": 'º', "oslash": 'ø', "otilde": 'õ', "p": 'p',
+	"paragraph": '¶', "parenleft": '(', "parenright": ')', "partialdiff": '∂',
+	"percent": '%', "period": '.', "periodcentered": '·', "perthousand": '‰',
+	"plus": '+', "plusminus": '±', "q": 'q', "question": '?',
+	"questiondown": '¿', "quotedbl": '"', "quotedblbase": '„',
+	"quotedblleft": '“', "quotedblright": '”', "quoteleft": '‘',
+	"quoteright": '’', "quotesinglbase": '‚', "quotesingle": '\'', "r": 'r',
+	"racute": 'ŕ', "radical": '√', "rcaron": 'ř', "rcommaaccent": 'ŗ',
+	"registered": '®', "ring": '˚', "s": 's', "sacute": 'ś', "scaron": 'š',
+	"scedilla": 'ş', "scommaaccent": 'ș', "section": '§', "semicolon": ';',
+	"seven": '7', "six": '6', "slash": '/', "space": ' ', "sterling": '£',
+	"summation": '∑', "t": 't', "tcaron": 'ť', "tcommaaccent": 'ţ',
+	"thorn": 'þ', "three": '3', "threequarters": '¾', "threesuperior": '³',
+	"tilde": '˜', "trademark": '™', "two": '2', "twosuperior": '²', "u": 'u',
+	"uacute": 'ú', "ucircumflex": 'û', "udieresis": 'ü', "ugrave": 'ù',
+	"uhungarumlaut": 'ű', "umacron": 'ū', "underscore": '_', "uogonek": 'ų',
+	"uring": 'ů', "v": 'v', "w": 'w', "x": 'x', "y": 'y', "yacute": 'ý',
+	"ydieresis": 'ÿ', "yen": '¥', "z": 'z', "zacute": 'ź', "zcaron": 'ž',
+	"zdotaccent": 'ż', "zero": '0',
+} //                                                                  glyphChars
+
+// afmFile holds the version and KPX pairs of an AFM file
+type afmFile struct {
+	name     string
+	version  string
+	uniqueID string
+	kerning  map[[2]string]int
+} //                                                                     afmFile
+
+func main() {
+	afmDir := flag.String("afm", "", "directory of the AFM files")
+	source := flag.String("src", "pdf_core.go",
+		"Go source file with the kerning tables")
+	flag.Parse()
+	if *afmDir == "" {
+		fmt.Fprintln(os.Stderr, "usage: kerngen -afm <dir> [-src <file>]")
+		os.Exit(2)
+	}
+	data, err := os.ReadFile(*source)
+	if err == nil {
+		data, err = updateTables(data, *afmDir)
+	}
+	if err == nil {
+		err = os.WriteFile(*source, data, 0644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "kerngen:", err)
+		os.Exit(1)
+	}
+} //                                                                        main
+
+// readAFM reads the version and KPX pairs of AFM file 'filename'
+func readAFM(filename string) (*afmFile, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	ret := &afmFile{
+		name:    filepath.Base(filename),
+		kerning: map[[2]string]int{},
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 2 && fields[0] == "Version":
+			ret.version = fields[1]
+		case len(fields) == 3 && fields[0] == "Comment" &&
+			fields[1] == "UniqueID":
+			ret.uniqueID = fields[2]
+		case len(fields) == 4 && fields[0] == "KPX":
+			kern, err := strconv.Atoi(fields[3])
+			if err != nil {
+				return nil, fmt.Errorf("%s: bad KPX line %q", filename, line)
+			}
+			ret.kerning[[2]string{fields[1], fields[2]}] = kern
+		}
+	}
+	if ret.version == "" || len(ret.kerning) == 0 {
+		return nil, fmt.Errorf("%s: no version or KPX pairs", filename)
+	}
+	return ret, nil
+} //                                                                     readAFM
+
+// tableSource returns the Go source of kerning table 'name' of 'fonts',
+// with the pairs sorted by glyph names as in AFM files, and up to six
+// pairs per line with the same first glyph. Pairs of glyphs that
+// built-in fonts can't draw are left out.
+func tableSource(name string, fonts []string, afm *afmFile) string {
+	var pairs [][2]string
+	for pair, kern := range afm.kerning {
+		_, found1 := glyphChars[pair[0]]
+		_, found2 := glyphChars[pair[1]]
+		if found1 && found2 && kern != 0 {
+			pairs = append(pairs, pair)
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+	var buf strings.Builder
+	comment := name + " is the kerning of " + strings.Join(fonts, " and ") +
+		", from " + afm.name + " version " + afm.version
+	if afm.uniqueID != "" {
+		comment += " (UniqueID " + afm.uniqueID + ")"
+	}
+	line := "//"
+	for _, word := range strings.Fields(comment) {
+		if len(line)+1+len(word) > 72 {
+			buf.WriteString(line + "\n")
+			line = "//"
+		}
+		line += " " + word
+	}
+	buf.WriteString(line + "\nvar " + name + " = map[string]int{\n")
+	count := 0
+	for i, pair := range pairs {
+		if i > 0 && (pair[0] != pairs[i-1][0] || count == 6) {
+			buf.WriteString("\n")
+			count = 0
+		}
+		if count == 0 {
+			buf.WriteString("\t")
+		} else {
+			buf.WriteString(" ")
+		}
+		key := string([]rune{glyphChars[pair[0]], glyphChars[pair[1]]})
+		buf.WriteString(strconv.Quote(key) + ": " +
+			strconv.Itoa(afm.kerning[pair]) + ",")
+		count++
+	}
+	if count > 0 {
+		buf.WriteString("\n")
+	}
+	buf.WriteString("} //" + strings.Repeat(" ", 76-len(name)) + name + "\n")
+	return buf.String()
+} //                                                                 tableSource
+
+// updateTables returns Go 'source' with its kerning tables replaced
+// by tables generated from the AFM files in directory 'afmDir'
+func updateTables(source []byte, afmDir string) ([]byte, error) {
+	var buf strings.Builder
+	for i, table := range kernTables {
+		var first *afmFile
+		for _, font := range table.fonts {
+			afm, err := readAFM(filepath.Join(afmDir, font+".afm"))
+			if err != nil {
+				return nil, err
+			}
+			if first == nil {
+				first = afm
+			} else if !reflect.DeepEqual(afm.kerning, first.kerning) {
+				return nil, fmt.Errorf("%s and %s have different KPX pairs",
+					first.name, afm.name)
+			}
+		}
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(tableSource(table.name, table.fonts, first))
+	}
+	first, last := kernTables[0].name, kernTables[len(kernTables)-1].name
+	s := string(source)
+	start := strings.Index(s, "// "+first+" ")
+	end := strings.Index(s, "} //"+strings.Repeat(" ", 76-len(last))+last+"\n")
+	if start == -1 || end < start {
+		return nil, fmt.Errorf("kerning tables not found")
+	}
+	end += 81
+	return []byte(s[:start] + buf.String() + s[end:]), nil
+} //                                                                updateTables
+
+// end
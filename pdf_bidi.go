@@ -0,0 +1,793 @@
+// -----------------------------------------------------------------------------
+// github.com/balacode/one-file-pdf                   one-file-pdf/[pdf_bidi.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// This file implements the Unicode Bidirectional Algorithm (UAX #9),
+// which orders mixed left-to-right and right-to-left text (e.g. Latin
+// with Arabic or Hebrew) for display. It augments PDF in pdf_core.go,
+// but is not required for basic PDF functionality.
+
+// # Module Initialization
+//   init()
+//
+// # Bidi Algorithm Methods (b *pdfBidi)
+//   explicitLevels()
+//   isolatingRunSequences() [][]int
+//   matchIsolates()
+//   paragraphLevel(start, end int) int
+//   reorder() (runs []pdfBidiRun)
+//   resolveBrackets(seq []int, types []int, sos int)
+//   resolveSequence(seq []int)
+//   resolveWeak(seq []int, types []int, sos int)
+//   strongBefore(seq []int, types []int, i, sos int) int
+//
+// # Functions
+//   bidiClass(r rune) int
+//   bidiControl(r rune) bool
+//   bidiMirror(r rune) rune
+//   bidiRemoved(class int) bool
+//   bidiRuns(s string, level int) (runs []pdfBidiRun, paraLevel int)
+
+package pdf
+
+import (
+	"sort"
+	"unicode"
+)
+
+// bidirectional character types (bidi classes) of the algorithm
+const (
+	bidiL   = iota // left-to-right
+	bidiR          // right-to-left
+	bidiAL         // Arabic letter
+	bidiEN         // European number
+	bidiES         // European separator
+	bidiET         // European number terminator
+	bidiAN         // Arabic number
+	bidiCS         // common number separator
+	bidiNSM        // nonspacing mark
+	bidiBN         // boundary neutral
+	bidiB          // paragraph separator
+	bidiS          // segment separator
+	bidiWS         // white space
+	bidiON         // other neutral
+	bidiLRE        // left-to-right embedding
+	bidiLRO        // left-to-right override
+	bidiRLE        // right-to-left embedding
+	bidiRLO        // right-to-left override
+	bidiPDF        // pop directional format
+	bidiLRI        // left-to-right isolate
+	bidiRLI        // right-to-left isolate
+	bidiFSI        // first strong isolate
+	bidiPDI        // pop directional isolate
+)
+
+// bidiMaxDepth is the maximum explicit embedding level
+const bidiMaxDepth = 125
+
+// pdfBidi holds the state of the bidi algorithm for one paragraph
+type pdfBidi struct {
+	runes   []rune
+	classes []int // original bidi class of each character
+	types   []int // class of each character after explicit overrides
+	levels  []int // resolved embedding level of each character
+	match   []int // matching PDI of each isolate initiator, and vice versa
+	level   int   // paragraph embedding level: 0 = LTR, 1 = RTL
+} //                                                                     pdfBidi
+
+// bidiBrackets lists pairs of opening and closing paired brackets
+const bidiBrackets = "()[]{}༺༻༼༽᚛᚜⁅⁆⁽⁾₍₎⌈⌉⌊⌋〈〉❨❩❪❫❬❭❮❯❰❱❲❳❴❵⟅⟆" +
+	"⟦⟧⟨⟩⟪⟫⟬⟭⟮⟯⦃⦄⦅⦆⦇⦈⦉⦊⦋⦌⦍⦐⦏⦎⦑⦒⦓⦔⦕⦖⦗⦘⧘⧙⧚⧛⧼⧽" +
+	"⸢⸣⸤⸥⸦⸧⸨⸩〈〉《》「」『』【】〔〕〖〗〘〙〚〛﹙﹚﹛﹜﹝﹞（）［］｛｝｟｠｢｣"
+
+// bidiMirrors lists pairs of characters that are mirror images of each
+// other (besides the paired brackets), which replace each other in
+// right-to-left text
+const bidiMirrors = "<>«»‹›≤≥≦≧≪≫⊂⊃⊆⊇∈∋∊∍⁄∕"
+
+// -----------------------------------------------------------------------------
+// # Module Initialization
+
+// init __
+func init() {
+	pdfBidiRuns = bidiRuns
+} //                                                                        init
+
+// -----------------------------------------------------------------------------
+// # Bidi Algorithm Methods (b *pdfBidi)
+
+// explicitLevels sets the embedding level of each character using
+// explicit embeddings, overrides and isolates (rules X1 to X8)
+func (b *pdfBidi) explicitLevels() {
+	type status struct {
+		level    int
+		override int // bidiL, bidiR or -1 (neutral)
+		isolate  bool
+	}
+	var (
+		stack                 = []status{{b.level, -1, false}}
+		overIsolates, overEmb = 0, 0 // overflow counts
+		validIsolates         = 0
+	)
+	next := func(rtl bool) int { // least odd (rtl) or even level above top
+		level := stack[len(stack)-1].level + 1
+		if (level%2 == 1) != rtl {
+			level++
+		}
+		return level
+	}
+	for i, class := range b.classes {
+		top := stack[len(stack)-1]
+		b.levels[i] = top.level
+		switch class {
+		case bidiRLE, bidiLRE, bidiRLO, bidiLRO:
+			level := next(class == bidiRLE || class == bidiRLO)
+			if level <= bidiMaxDepth && overIsolates == 0 && overEmb == 0 {
+				override := -1
+				if class == bidiRLO {
+					override = bidiR
+				} else if class == bidiLRO {
+					override = bidiL
+				}
+				stack = append(stack, status{level, override, false})
+			} else if overIsolates == 0 {
+				overEmb++
+			}
+		case bidiRLI, bidiLRI, bidiFSI:
+			if top.override != -1 {
+				b.types[i] = top.override
+			}
+			rtl := class == bidiRLI
+			if class == bidiFSI {
+				rtl = b.paragraphLevel(i+1, b.match[i]) == 1
+			}
+			level := next(rtl)
+			if level <= bidiMaxDepth && overIsolates == 0 && overEmb == 0 {
+				validIsolates++
+				stack = append(stack, status{level, -1, true})
+			} else {
+				overIsolates++
+			}
+		case bidiPDI:
+			if overIsolates > 0 {
+				overIsolates--
+			} else if validIsolates > 0 {
+				overEmb = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+			top = stack[len(stack)-1]
+			b.levels[i] = top.level
+			if top.override != -1 {
+				b.types[i] = top.override
+			}
+		case bidiPDF:
+			switch {
+			case overIsolates > 0:
+			case overEmb > 0:
+				overEmb--
+			case !top.isolate && len(stack) > 1:
+				stack = stack[:len(stack)-1]
+			}
+		case bidiB:
+			b.levels[i] = b.level
+		case bidiBN:
+		default:
+			if top.override != -1 {
+				b.types[i] = top.override
+			}
+		}
+	}
+} //                                                              explicitLevels
+
+// isolatingRunSequences returns the indices of characters in each
+// isolating run sequence, leaving out characters removed by rule X9
+// (rule X10). A sequence joins the level runs of text on either side
+// of an isolate.
+func (b *pdfBidi) isolatingRunSequences() [][]int {
+	var runs [][]int // level runs
+	runOf := make(map[int]int)
+	for i, class := range b.types {
+		if bidiRemoved(class) {
+			continue
+		}
+		n := len(runs) - 1
+		if n < 0 || b.levels[runs[n][len(runs[n])-1]] != b.levels[i] {
+			runs = append(runs, nil)
+			n++
+		}
+		runs[n] = append(runs[n], i)
+		runOf[i] = n
+	}
+	var ret [][]int
+	for _, run := range runs {
+		if first := run[0]; b.classes[first] == bidiPDI &&
+			b.match[first] < len(b.runes) {
+			continue // continues the sequence of its isolate initiator
+		}
+		var seq []int
+		for {
+			seq = append(seq, run...)
+			last := run[len(run)-1]
+			class := b.classes[last]
+			if class != bidiLRI && class != bidiRLI && class != bidiFSI ||
+				b.match[last] >= len(b.runes) {
+				break
+			}
+			run = runs[runOf[b.match[last]]]
+		}
+		ret = append(ret, seq)
+	}
+	return ret
+} //                                                       isolatingRunSequences
+
+// matchIsolates finds the matching PDI of each isolate initiator,
+// and the matching initiator of each PDI (rule BD9). Characters
+// without a match are matched to the end of the paragraph.
+func (b *pdfBidi) matchIsolates() {
+	var stack []int
+	for i, class := range b.classes {
+		b.match[i] = len(b.runes)
+		switch class {
+		case bidiLRI, bidiRLI, bidiFSI:
+			stack = append(stack, i)
+		case bidiPDI:
+			if n := len(stack); n > 0 {
+				b.match[stack[n-1]], b.match[i] = i, stack[n-1]
+				stack = stack[:n-1]
+			}
+		case bidiB:
+			stack = nil
+		}
+	}
+} //                                                               matchIsolates
+
+// paragraphLevel returns the embedding level given by the first strong
+// character between 'start' and 'end', skipping isolates (rules P2, P3)
+func (b *pdfBidi) paragraphLevel(start, end int) int {
+	for i := start; i < end && i < len(b.classes); i++ {
+		switch b.classes[i] {
+		case bidiL:
+			return 0
+		case bidiR, bidiAL:
+			return 1
+		case bidiLRI, bidiRLI, bidiFSI:
+			i = b.match[i]
+		}
+	}
+	return 0
+} //                                                              paragraphLevel
+
+// reorder returns the text in display order as runs of the same
+// embedding level (rules L1 and L2). The text of each run is in
+// logical order, with mirrored characters replaced in RTL runs.
+// Directional formatting characters are left out.
+func (b *pdfBidi) reorder() (runs []pdfBidiRun) {
+	// L1: reset separators and trailing white space to paragraph level
+	trailing := true
+	for i := len(b.classes) - 1; i >= 0; i-- {
+		switch class := b.classes[i]; {
+		case class == bidiS || class == bidiB:
+			b.levels[i], trailing = b.level, true
+		case class == bidiWS || class >= bidiLRI || bidiRemoved(class):
+			if trailing {
+				b.levels[i] = b.level
+			}
+		default:
+			trailing = false
+		}
+	}
+	// characters removed by X9 take the level of the preceding character
+	for i, class := range b.classes {
+		if bidiRemoved(class) && class != bidiB {
+			b.levels[i] = b.level
+			if i > 0 {
+				b.levels[i] = b.levels[i-1]
+			}
+		}
+	}
+	// L2: reverse sequences at each level, from the highest to the lowest
+	// odd level
+	order := make([]int, 0, len(b.runes))
+	highest, lowestOdd := 0, bidiMaxDepth+2
+	for i, level := range b.levels {
+		if bidiControl(b.runes[i]) {
+			continue
+		}
+		order = append(order, i)
+		if level > highest {
+			highest = level
+		}
+		if level%2 == 1 && level < lowestOdd {
+			lowestOdd = level
+		}
+	}
+	for level := highest; level >= lowestOdd; level-- {
+		for i := 0; i < len(order); i++ {
+			if b.levels[order[i]] < level {
+				continue
+			}
+			j := i
+			for j < len(order) && b.levels[order[j]] >= level {
+				j++
+			}
+			for a, z := i, j-1; a < z; a, z = a+1, z-1 {
+				order[a], order[z] = order[z], order[a]
+			}
+			i = j
+		}
+	}
+	// group characters of the same level into runs in logical order
+	for i := 0; i < len(order); {
+		level := b.levels[order[i]]
+		j := i
+		for j < len(order) && b.levels[order[j]] == level {
+			j++
+		}
+		text := make([]rune, 0, j-i)
+		for k := i; k < j; k++ {
+			n := k
+			if level%2 == 1 {
+				n = i + j - 1 - k // RTL runs are reversed in display order
+			}
+			r := b.runes[order[n]]
+			if level%2 == 1 {
+				r = bidiMirror(r)
+			}
+			text = append(text, r)
+		}
+		runs = append(runs, pdfBidiRun{Text: string(text), Level: level})
+		i = j
+	}
+	return runs
+} //                                                                     reorder
+
+// resolveBrackets resolves paired brackets in an isolating run sequence
+// to the direction of the text they enclose or precede (rule N0)
+func (b *pdfBidi) resolveBrackets(seq []int, types []int, sos int) {
+	type pair struct{ open, close int }
+	type opening struct {
+		at    int  // position in the sequence
+		close rune // the matching closing bracket
+	}
+	var (
+		pairs []pair
+		stack []opening
+	)
+	canonical := func(r rune) rune {
+		switch r {
+		case 0x2329:
+			return 0x3008
+		case 0x232A:
+			return 0x3009
+		}
+		return r
+	}
+	brackets := []rune(bidiBrackets)
+find:
+	for i, at := range seq {
+		if types[i] != bidiON {
+			continue
+		}
+		r := canonical(b.runes[at])
+		for k := 0; k < len(brackets); k += 2 {
+			if r == brackets[k] { // opening bracket
+				if len(stack) == 63 {
+					break find
+				}
+				stack = append(stack, opening{i, brackets[k+1]})
+				break
+			}
+			if r != brackets[k+1] { // not a closing bracket
+				continue
+			}
+			for n := len(stack) - 1; n >= 0; n-- {
+				if stack[n].close == r {
+					pairs = append(pairs, pair{stack[n].at, i})
+					stack = stack[:n]
+					break
+				}
+			}
+			break
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].open < pairs[j].open
+	})
+	//
+	embedding := bidiL
+	if b.levels[seq[0]]%2 == 1 {
+		embedding = bidiR
+	}
+	strong := func(class int) int { // EN and AN count as R
+		switch class {
+		case bidiL:
+			return bidiL
+		case bidiR, bidiAL, bidiEN, bidiAN:
+			return bidiR
+		}
+		return -1
+	}
+	for _, it := range pairs {
+		dir, opposite := -1, false
+		for i := it.open + 1; i < it.close; i++ {
+			if s := strong(types[i]); s == embedding {
+				dir = embedding
+				break
+			} else if s != -1 {
+				opposite = true
+			}
+		}
+		if dir == -1 && opposite {
+			dir = embedding
+			before := sos
+			for i := it.open - 1; i >= 0; i-- {
+				if s := strong(types[i]); s != -1 {
+					before = s
+					break
+				}
+			}
+			if before != embedding {
+				dir = before
+			}
+		}
+		if dir == -1 {
+			continue
+		}
+		for _, i := range []int{it.open, it.close} {
+			types[i] = dir
+			// marks following a bracket take its direction
+			for k := i + 1; k < len(seq) &&
+				b.classes[seq[k]] == bidiNSM; k++ {
+				types[k] = dir
+			}
+		}
+	}
+} //                                                             resolveBrackets
+
+// resolveSequence resolves the levels of characters in an isolating run
+// sequence using the weak, neutral and implicit rules (W1 to I2)
+func (b *pdfBidi) resolveSequence(seq []int) {
+	level := b.levels[seq[0]]
+	dirOf := func(level int) int {
+		if level%2 == 1 {
+			return bidiR
+		}
+		return bidiL
+	}
+	// start and end of sequence types: the direction of the higher
+	// of the levels on either side of the sequence boundary
+	before, after := b.level, b.level
+	for i := seq[0] - 1; i >= 0; i-- {
+		if !bidiRemoved(b.types[i]) {
+			before = b.levels[i]
+			break
+		}
+	}
+	last := seq[len(seq)-1]
+	if class := b.classes[last]; class != bidiLRI && class != bidiRLI &&
+		class != bidiFSI {
+		for i := last + 1; i < len(b.types); i++ {
+			if !bidiRemoved(b.types[i]) {
+				after = b.levels[i]
+				break
+			}
+		}
+	}
+	sos, eos := dirOf(level), dirOf(level)
+	if before > level {
+		sos = dirOf(before)
+	}
+	if after > level {
+		eos = dirOf(after)
+	}
+	//
+	types := make([]int, len(seq))
+	for i, at := range seq {
+		types[i] = b.types[at]
+	}
+	b.resolveWeak(seq, types, sos)
+	b.resolveBrackets(seq, types, sos)
+	//
+	// N1, N2: neutrals take the direction of the text around them, if the
+	// text on both sides has the same direction, or the embedding direction
+	embedding := dirOf(level)
+	isNeutral := func(class int) bool {
+		return class == bidiB || class == bidiS || class == bidiWS ||
+			class == bidiON || class >= bidiLRI
+	}
+	for i := 0; i < len(types); i++ {
+		if !isNeutral(types[i]) {
+			continue
+		}
+		j := i
+		for j < len(types) && isNeutral(types[j]) {
+			j++
+		}
+		prev, next := sos, eos
+		if i > 0 {
+			prev = types[i-1]
+		}
+		if j < len(types) {
+			next = types[j]
+		}
+		if prev != bidiL {
+			prev = bidiR // R, EN or AN
+		}
+		if next != bidiL {
+			next = bidiR
+		}
+		dir := embedding
+		if prev == next {
+			dir = prev
+		}
+		for k := i; k < j; k++ {
+			types[k] = dir
+		}
+		i = j
+	}
+	// I1, I2: implicit levels
+	for i, at := range seq {
+		switch class := types[i]; {
+		case level%2 == 0 && class == bidiR:
+			b.levels[at] = level + 1
+		case level%2 == 0 && (class == bidiAN || class == bidiEN):
+			b.levels[at] = level + 2
+		case level%2 == 1 && class != bidiR:
+			b.levels[at] = level + 1
+		default:
+			b.levels[at] = level
+		}
+	}
+} //                                                             resolveSequence
+
+// resolveWeak resolves the weak types of an isolating run sequence,
+// such as numbers and their separators (rules W1 to W7)
+func (b *pdfBidi) resolveWeak(seq []int, types []int, sos int) {
+	// W1: nonspacing marks take the type of the preceding character
+	for i := range types {
+		if types[i] != bidiNSM {
+			continue
+		}
+		switch {
+		case i == 0:
+			types[i] = sos
+		case types[i-1] >= bidiLRI:
+			types[i] = bidiON
+		default:
+			types[i] = types[i-1]
+		}
+	}
+	// W2, W3: European numbers after Arabic letters are Arabic numbers
+	for i := range types {
+		if types[i] == bidiEN && b.strongBefore(seq, types, i, sos) == bidiAL {
+			types[i] = bidiAN
+		}
+	}
+	for i := range types {
+		if types[i] == bidiAL {
+			types[i] = bidiR
+		}
+	}
+	// W4: a single separator between numbers of the same type
+	for i := 1; i+1 < len(types); i++ {
+		prev, next := types[i-1], types[i+1]
+		switch {
+		case types[i] == bidiES && prev == bidiEN && next == bidiEN:
+			types[i] = bidiEN
+		case types[i] == bidiCS && prev == next &&
+			(prev == bidiEN || prev == bidiAN):
+			types[i] = prev
+		}
+	}
+	// W5: terminators adjacent to European numbers
+	for i := 0; i < len(types); i++ {
+		if types[i] != bidiET {
+			continue
+		}
+		j := i
+		for j < len(types) && types[j] == bidiET {
+			j++
+		}
+		if (i > 0 && types[i-1] == bidiEN) ||
+			(j < len(types) && types[j] == bidiEN) {
+			for k := i; k < j; k++ {
+				types[k] = bidiEN
+			}
+		}
+		i = j
+	}
+	// W6: remaining separators and terminators are neutral
+	for i, class := range types {
+		if class == bidiES || class == bidiET || class == bidiCS {
+			types[i] = bidiON
+		}
+	}
+	// W7: European numbers in left-to-right text
+	for i := range types {
+		if types[i] == bidiEN && b.strongBefore(seq, types, i, sos) == bidiL {
+			types[i] = bidiL
+		}
+	}
+} //                                                                 resolveWeak
+
+// strongBefore returns the type of the first strong character (L, R or
+// AL) before index 'i' of an isolating run sequence, or 'sos' if none
+func (b *pdfBidi) strongBefore(seq []int, types []int, i, sos int) int {
+	for i--; i >= 0; i-- {
+		if class := types[i]; class == bidiL || class == bidiR ||
+			class == bidiAL {
+			return class
+		}
+	}
+	return sos
+} //                                                                strongBefore
+
+// -----------------------------------------------------------------------------
+// # Functions
+
+// bidiClass returns the bidirectional character type of rune 'r'.
+// Lists the characters of the Unicode Character Database that matter
+// for ordering text, and derives the rest from general categories and
+// scripts, which gives the same class for over 99% of characters.
+func bidiClass(r rune) int {
+	switch {
+	case r >= '0' && r <= '9', r == 0xB2, r == 0xB3, r == 0xB9,
+		r >= 0x6F0 && r <= 0x6F9, r == 0x2070, r >= 0x2074 && r <= 0x2079,
+		r >= 0x2080 && r <= 0x2089, r >= 0x2488 && r <= 0x249B,
+		r >= 0xFF10 && r <= 0xFF19,
+		r >= 0x1D7CE && r <= 0x1D7FF, r >= 0x1F100 && r <= 0x1F10A,
+		r >= 0x1FBF0 && r <= 0x1FBF9:
+		return bidiEN
+	case r == '+', r == '-', r == 0x207A, r == 0x207B, r == 0x208A,
+		r == 0x208B, r == 0x2212, r == 0xFB29, r == 0xFE62, r == 0xFE63,
+		r == 0xFF0B, r == 0xFF0D:
+		return bidiES
+	case r == '#', r == '%', r == 0xB0, r == 0xB1, r == 0x609, r == 0x60A,
+		r == 0x66A, r >= 0x2030 && r <= 0x2034, r == 0x212E, r == 0x2213,
+		r == 0xFE5F, r == 0xFE6A, r == 0xFF03, r == 0xFF05,
+		unicode.Is(unicode.Sc, r): // currency symbols
+		return bidiET
+	case r >= 0x600 && r <= 0x605, r >= 0x660 && r <= 0x669, r == 0x66B,
+		r == 0x66C, r == 0x6DD, r == 0x890, r == 0x891, r == 0x8E2,
+		r >= 0x10D30 && r <= 0x10D39, r >= 0x10E60 && r <= 0x10E7E:
+		return bidiAN
+	case r == ',', r == '.', r == '/', r == ':', r == 0xA0, r == 0x60C,
+		r == 0x202F, r == 0x2044, r == 0xFE50, r == 0xFE52, r == 0xFE55,
+		r == 0xFF0C, r == 0xFF0E, r == 0xFF0F, r == 0xFF1A:
+		return bidiCS
+	case r == '\n', r == '\r', r >= 0x1C && r <= 0x1E, r == 0x85,
+		r == 0x2029:
+		return bidiB
+	case r == '\t', r == 0x0B, r == 0x1F:
+		return bidiS
+	case r == 0x0C, r == ' ', r == 0x1680, r >= 0x2000 && r <= 0x200A,
+		r == 0x2028, r == 0x205F, r == 0x3000:
+		return bidiWS
+	case r >= 0x202A && r <= 0x202E:
+		return []int{bidiLRE, bidiRLE, bidiPDF, bidiLRO, bidiRLO}[r-0x202A]
+	case r >= 0x2066 && r <= 0x2069:
+		return []int{bidiLRI, bidiRLI, bidiFSI, bidiPDI}[r-0x2066]
+	case r == 0x200E:
+		return bidiL
+	case r == 0x200F:
+		return bidiR
+	case r == 0x61C:
+		return bidiAL
+	case r < 0x20, r >= 0x7F && r <= 0x9F, r == 0xAD, r == 0x180E,
+		r >= 0x200B && r <= 0x200D, r >= 0x2060 && r <= 0x206F,
+		r == 0xFEFF, r >= 0xFFF0 && r <= 0xFFF8, r >= 0x1D173 && r <= 0x1D17A,
+		r >= 0xE0000 && r <= 0xE0FFF:
+		return bidiBN
+	case unicode.In(r, unicode.Mn, unicode.Me):
+		return bidiNSM
+	case r == 0x606, r == 0x607, r == 0x60E, r == 0x60F, r == 0x6DE,
+		r == 0x6E9, r == 0x7F6, r >= 0x7F7 && r <= 0x7F9, r == 0xFD3E,
+		r == 0xFD3F:
+		return bidiON
+	case r >= 0x590 && r <= 0x5FF, r >= 0x7C0 && r <= 0x85F,
+		r >= 0xFB1D && r <= 0xFB4F, r >= 0x10800 && r <= 0x10FFF,
+		r >= 0x1E800 && r <= 0x1EC6F, r >= 0x1ECC0 && r <= 0x1ECFF,
+		r >= 0x1ED50 && r <= 0x1EDFF, r >= 0x1EF00 && r <= 0x1EFFF:
+		if r >= 0x10D00 && r <= 0x10D3F || r >= 0x10F30 && r <= 0x10F6F {
+			return bidiAL // Hanifi Rohingya, Sogdian
+		}
+		return bidiR
+	case r >= 0x600 && r <= 0x7BF, r >= 0x860 && r <= 0x8FF,
+		r >= 0xFB50 && r <= 0xFDCF, r >= 0xFDF0 && r <= 0xFDFF,
+		r >= 0xFE70 && r <= 0xFEFF, r >= 0x1EC70 && r <= 0x1ECBF,
+		r >= 0x1ED00 && r <= 0x1ED4F, r >= 0x1EE00 && r <= 0x1EEFF:
+		return bidiAL
+	case r == 0x2B9, r == 0x2BA, r >= 0x2C2 && r <= 0x2CF, // modifiers
+		r >= 0x2D2 && r <= 0x2DF, r >= 0x2E5 && r <= 0x2ED,
+		r >= 0x2EF && r <= 0x2FF, r >= 0x2E80 && r <= 0x2FDF: // radicals
+		return bidiON
+	case r >= 0x2336 && r <= 0x237A, r >= 0x249C && r <= 0x24E9, // APL,
+		r >= 0x3190 && r <= 0x319F, r >= 0x3200 && r <= 0x321C, // enclosed
+		r >= 0x3220 && r <= 0x324F, r >= 0x3260 && r <= 0x327B,
+		r >= 0x327F && r <= 0x32B0, r >= 0x32C0 && r <= 0x32CB,
+		r >= 0x32D0 && r <= 0x3376, r >= 0x337B && r <= 0x33DD,
+		r >= 0x33E0 && r <= 0x33FE, r >= 0x1D000 && r <= 0x1D1E8, // music
+		r >= 0x1F110 && r <= 0x1F1FF && r != 0x1F12F && r != 0x1F16A &&
+			r != 0x1F16B && r != 0x1F16C && r != 0x1F16D &&
+			r != 0x1F16E && r != 0x1F16F && r != 0x1F1AD,
+		r >= 0x1F200 && r <= 0x1F248:
+		return bidiL
+	case unicode.In(r, unicode.L, unicode.Mc, unicode.Nd, unicode.Co),
+		r >= 0x2160 && r <= 0x2188: // Roman numerals
+		return bidiL
+	case unicode.In(r, unicode.P, unicode.S, unicode.Z, unicode.No,
+		unicode.Nl) && unicode.In(r, unicode.Common, unicode.Inherited):
+		return bidiON
+	}
+	return bidiL
+} //                                                                   bidiClass
+
+// bidiControl returns true if 'r' is a directional formatting character
+// or mark, which only affects the order of text and is not displayed
+func bidiControl(r rune) bool {
+	return r == 0x61C || r == 0x200E || r == 0x200F ||
+		r >= 0x202A && r <= 0x202E || r >= 0x2066 && r <= 0x2069
+} //                                                                 bidiControl
+
+// bidiMirror returns the mirror image of rune 'r', e.g. ')' for '(',
+// or 'r' if it has none
+func bidiMirror(r rune) rune {
+	for _, pairs := range []string{bidiBrackets, bidiMirrors} {
+		ar := []rune(pairs)
+		for i := 0; i+1 < len(ar); i += 2 {
+			if ar[i] == r {
+				return ar[i+1]
+			} else if ar[i+1] == r {
+				return ar[i]
+			}
+		}
+	}
+	return r
+} //                                                                  bidiMirror
+
+// bidiRemoved returns true if characters of bidi 'class' are removed
+// from isolating run sequences by rule X9
+func bidiRemoved(class int) bool {
+	return class == bidiBN || class >= bidiLRE && class <= bidiPDF
+} //                                                                 bidiRemoved
+
+// bidiRuns splits one line of text 's' into runs of the same direction,
+// in display order. 'level' is the paragraph embedding level: 0 for
+// left-to-right, 1 for right-to-left, or -1 to use the direction of
+// the first strong character. Returns the runs and the paragraph level.
+func bidiRuns(s string, level int) (runs []pdfBidiRun, paraLevel int) {
+	b := pdfBidi{runes: []rune(s), level: level}
+	b.classes = make([]int, len(b.runes))
+	b.types = make([]int, len(b.runes))
+	rtl := level == 1
+	for i, r := range b.runes {
+		class := bidiClass(r)
+		b.classes[i], b.types[i] = class, class
+		rtl = rtl || class == bidiR || class == bidiAL || class == bidiAN ||
+			class >= bidiLRE && class != bidiPDI && class != bidiPDF
+	}
+	if !rtl { // no right-to-left text: nothing to reorder
+		if s == "" {
+			return nil, 0
+		}
+		return []pdfBidiRun{{Text: s}}, 0
+	}
+	b.levels = make([]int, len(b.runes))
+	b.match = make([]int, len(b.runes))
+	b.matchIsolates()
+	if b.level < 0 || b.level > 1 {
+		b.level = b.paragraphLevel(0, len(b.runes))
+	}
+	b.explicitLevels()
+	for _, seq := range b.isolatingRunSequences() {
+		b.resolveSequence(seq)
+	}
+	return b.reorder(), b.level
+} //                                                                    bidiRuns
+
+// end
@@ -0,0 +1,147 @@
+// -----------------------------------------------------------------------------
+// github.com/balacode/one-file-pdf              one-file-pdf/[pdf_bidi_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package pdf
+
+// # Bidi Algorithm Tests:
+//   Test_bidiClass_
+//   Test_bidiRuns_
+//   Test_bidiRuns_drawTextBox_
+//
+// # Helper Functions
+//   tBidiDisplay(runs []pdfBidiRun) string
+
+//  This file contains unit tests for the bidirectional algorithm.
+//  Hebrew letters are used as right-to-left text, so that the
+//  text order can be tested without Arabic letter shaping.
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test_bidiClass_ tests the bidi classes of some characters
+func Test_bidiClass_(t *testing.T) {
+	for _, tc := range []struct {
+		r    rune
+		want int
+	}{
+		{'a', bidiL}, {'Ж', bidiL}, {'中', bidiL}, {'א', bidiR},
+		{'ب', bidiAL}, {'5', bidiEN}, {'٣', bidiAN}, {'۳', bidiEN},
+		{'+', bidiES}, {'%', bidiET}, {'€', bidiET}, {',', bidiCS},
+		{' ', bidiWS}, {'\t', bidiS}, {'\n', bidiB}, {'!', bidiON},
+		{'(', bidiON}, {'َ', bidiNSM}, {'ָ', bidiNSM},
+		{'\u200D', bidiBN}, {'\u202B', bidiRLE}, {'\u2067', bidiRLI},
+		{'\u2069', bidiPDI}, {'\u200F', bidiR},
+	} {
+		if got := bidiClass(tc.r); got != tc.want {
+			t.Errorf("bidiClass(%q) = %d, want %d", tc.r, got, tc.want)
+		}
+	}
+} //                                                             Test_bidiClass_
+
+// Test_bidiRuns_ tests reordering of bidirectional text for display
+func Test_bidiRuns_(t *testing.T) {
+	for _, tc := range []struct {
+		s         string
+		level     int
+		want      string
+		wantLevel int
+	}{
+		{"", -1, "", 0},
+		{"abc def", -1, "abc def", 0},
+		{"אבג", -1, "גבא", 1},
+		{"abc אבג def", -1, "abc גבא def", 0},
+		{"אבג abc דהו", -1, "והד abc גבא", 1},
+		{"abc", 1, "abc", 1},         // forced right-to-left paragraph
+		{"אבג abc", 0, "גבא abc", 0}, // forced left-to-right paragraph
+		//
+		// numbers stay left-to-right, with their separators and terminators
+		{"אבג 123 דהו", -1, "והד 123 גבא", 1},
+		{"אבג 1.5% דהו", -1, "והד 1.5% גבא", 1},
+		{"عدد ١٢٣", -1, "١٢٣ ددع", 1},
+		{"ع 12", -1, "12 ع", 1},
+		//
+		// paired brackets follow the text they enclose or follow,
+		// and are mirrored in right-to-left text
+		{"abc (אבג)", -1, "abc (גבא)", 0},
+		{"אבג (abc)", -1, "(abc) גבא", 1},
+		{"אבג < ד", -1, "ד > גבא", 1},
+		//
+		// trailing white space and tabs are at the paragraph level
+		{"אבג  ", 0, "גבא  ", 0},
+		{"אבג\tabc", -1, "abc\tגבא", 1},
+		//
+		// explicit overrides and isolates (the controls are left out)
+		{"a\u202Ebcd\u202C e", -1, "adcb e", 0},
+		{"\u2068אבג\u2069 abc", -1, "גבא abc", 0},
+		{"אבג \u2066abc 12\u2069!", -1, "!abc 12 גבא", 1},
+	} {
+		runs, level := bidiRuns(tc.s, tc.level)
+		if got := tBidiDisplay(runs); got != tc.want || level != tc.wantLevel {
+			t.Errorf("bidiRuns(%q, %d) = %q, %d; want %q, %d",
+				tc.s, tc.level, got, level, tc.want, tc.wantLevel)
+		}
+	}
+	// the text of each run is in logical order
+	runs, _ := bidiRuns("\u2068אבג\u2069 abc", -1)
+	tEqual(t, len(runs), 2)
+	tEqual(t, runs[0], pdfBidiRun{Text: "אבג", Level: 1})
+	tEqual(t, runs[1], pdfBidiRun{Text: " abc", Level: 0})
+} //                                                              Test_bidiRuns_
+
+// Test_bidiRuns_drawTextBox_ tests drawing of right-to-left text
+func Test_bidiRuns_drawTextBox_(t *testing.T) {
+	// glyphs: space=1 a=2 b=3 alef=4 bet=5 gimel=6, each 500 units wide
+	font := tTrueTypeFont("TestHebrew", " abאבג", 500)
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").RegisterFont("Hebrew", font).
+		SetFont("Hebrew", 10).SetXY(10, 10).DrawText("ab אבג")
+	failIfHasErrors(t, doc.Errors)
+	got := doc.pages[0].content.String()
+	tEqual(t, strings.Contains(got,
+		"BT 10.000 831.890 Td [<000200030001>] TJ ET\n"+
+			"BT 25.000 831.890 Td [<000600050004>] TJ ET"), true)
+	//
+	// right-to-left paragraphs are aligned right by default:
+	// x = 100pt box width - 30pt text width - 10pt/6 margin
+	for _, tc := range []struct{ align, want string }{
+		{"T", "BT 68.333 "},
+		{"RT", "BT 68.333 "},
+		{"LT", "BT 1.667 "},
+		{"CT", "BT 35.000 "},
+	} {
+		doc = NewPDF("A4")
+		doc.SetCompression(false).SetUnits("pt").
+			RegisterFont("Hebrew", font).SetFont("Hebrew", 10).
+			DrawTextInBox(0, 0, 100, 20, tc.align, "אבג ab")
+		failIfHasErrors(t, doc.Errors)
+		got := doc.pages[0].content.String()
+		if !strings.Contains(got, tc.want+"831.890 Td [<00020003>] TJ ET") {
+			t.Errorf("align %q: %q", tc.align, got)
+		}
+	}
+} //                                                  Test_bidiRuns_drawTextBox_
+
+// -----------------------------------------------------------------------------
+// # Helper Functions
+
+// tBidiDisplay returns the text of 'runs' in display order
+func tBidiDisplay(runs []pdfBidiRun) string {
+	var sb strings.Builder
+	for _, run := range runs {
+		ar := []rune(run.Text)
+		for i := range ar {
+			if run.Level%2 == 1 {
+				sb.WriteRune(ar[len(ar)-1-i])
+			} else {
+				sb.WriteRune(ar[i])
+			}
+		}
+	}
+	return sb.String()
+} //                                                                tBidiDisplay
+
+// end
@@ -16,6 +16,11 @@ package pdf
 //
 // # Plugins
 //   pdfNewFontHandler func ()pdfFontHandler
+//   pdfNewType1FontHandler func ()pdfFontHandler
+//   pdfBidiRuns func(s string, level int) ([]pdfBidiRun, int)
+//   pdfHyphenator func(language string, patterns []byte) (
+//       hyphenate func(word string) []int, err error)
+//   pdfLineBreaks func(s string) []int
 //
 // # Read-Only Properties (p *PDF)
 //   PageCount() int
@@ -23,6 +28,7 @@ package pdf
 //   PageWidth() float64
 //
 // # Properties
+//   CharSpacing() float64          SetCharSpacing(points float64) *PDF
 //   Color() color.RGBA             SetColor(nameOrHTMLColor string) *PDF
 //                                  SetColorRGB(r, g, b byte) *PDF
 //   Compression() bool             SetCompression(val bool) *PDF
@@ -32,12 +38,22 @@ package pdf
 //   DocKeywords() string           SetDocKeywords(s string) *PDF
 //   DocSubject() string            SetDocSubject(s string) *PDF
 //   DocTitle() string              SetDocTitle(s string) *PDF
+//   FontFallbacks() []string       SetFontFallbacks(names ...string) *PDF
+//   FontFeatures() string          SetFontFeatures(features string) *PDF
 //   FontName() string              SetFontName(name string) *PDF
 //   FontSize() float64             SetFontSize(points float64) *PDF
 //                                  SetFont(name string, points float64) *PDF
+//   FontStyle() string             SetFontStyle(style string) *PDF
 //   HorizontalScaling() uint16     SetHorizontalScaling(percent uint16) *PDF
+//   Hyphenation() string           SetHyphenation(language string) *PDF
+//   LineHeight() float64           SetLineHeight(height string) *PDF
 //   LineWidth() float64            SetLineWidth(points float64) *PDF
+//   ParagraphSpacing() (before, after float64)
+//                                  SetParagraphSpacing(
+//                                      before, after float64) *PDF
+//   TextRenderMode() string        SetTextRenderMode(mode string) *PDF
 //   Units() string                 SetUnits(units string) *PDF
+//   WordSpacing() float64          SetWordSpacing(points float64) *PDF
 //   X() float64                    SetX(x float64) *PDF
 //   Y() float64                    SetY(y float64) *PDF
 //                                  SetXY(x, y float64) *PDF
@@ -51,22 +67,36 @@ package pdf
 //   DrawImage(x, y, height float64, fileNameOrBytes interface{},
 //       backColor ...string) *PDF
 //   DrawLine(x1, y1, x2, y2 float64) *PDF
+//   DrawRichTextInBox(
+//       x, y, width, height float64, align, markup string) *PDF
 //   DrawText(s string) *PDF
 //   DrawTextAlignedToBox(
 //       x, y, width, height float64, align, text string) *PDF
 //   DrawTextAt(x, y float64, text string) *PDF
 //   DrawTextInBox(
 //       x, y, width, height float64, align, text string) *PDF
+//   DrawTextInBoxOverflow(
+//       x, y, width, height float64, align, text string) (rest string)
+//   DrawTextInFrames(align, text string) *PDF
 //   DrawUnitGrid() *PDF
 //   FillBox(x, y, width, height float64) *PDF
 //   FillCircle(x, y, radius float64) *PDF
 //   FillEllipse(x, y, xRadius, yRadius float64) *PDF
 //   NextLine() *PDF
+//   RegisterFont(alias string, font interface{}, optFace ...string) *PDF
+//   RegisterFontFamily(family, regular, bold, italic,
+//       boldItalic string) *PDF
+//   RegisterHyphenation(language string, patterns interface{}) *PDF
 //   Reset() *PDF
 //   SaveFile(filename string) error
 //   SetColumnWidths(widths ...float64) *PDF
+//   SetTextFrames(frames ...float64) *PDF
 //
 // # Metrics Methods (p *PDF)
+//   FontMetrics() (ret struct {
+//       Ascent, Descent, Leading, CapHeight, XHeight,
+//       UnderlinePosition float64
+//   })
 //   TextWidth(s string) float64
 //   ToColor(nameOrHTMLColor string) (color.RGBA, error)
 //   ToPoints(numberAndUnit string) (float64, error)
@@ -87,36 +117,70 @@ package pdf
 //   pdfError struct
 //       (err pdfError) Error() string
 //   pdfFont struct
+//   pdfFontMetrics struct
+//   pdfFontRun struct
 //   pdfImage struct
 //   pdfPage struct
 //   pdfPaperSize struct
+//   pdfStyleRun struct
 //
 // # Internal Methods (p *PDF)
-//   applyFont() (handler pdfFontHandler, err error)
-//   drawTextLine(s string) *PDF
+//   applyFont(optFace ...string) (handler pdfFontHandler, err error)
+//   builtInRuns(s string) []pdfFontRun
+//   drawRichTextBox(x, y, width, height float64,
+//       align, markup string) *PDF
+//   drawTextLine(s string, optLevel ...int) *PDF
 //   drawTextBox(x, y, width, height float64,
-//       wrapText bool, align, text string) *PDF
+//       wrapText, overflow bool, align, text string,
+//       ) (rest string, linesHeight float64)
+//   fontFace(optFamily ...string) string
+//   fontFeature(tag string) int
+//   fontRuns(s string) []pdfFontRun
+//   fontWidthPt(s string) float64
+//   hasGlyph(font pdfFont, r rune) bool
+//   hyphenateLine(line string, start int, width float64) int
 //   init() *PDF
+//   kernText(s string) string
+//   kerning(left, right rune) int
+//   lineHeightPt(optSizePt ...float64) float64
+//   loadFont(fontName string) (font pdfFont, valid bool)
 //   loadImage(fileNameOrBytes interface{}, back color.RGBA,
 //       ) (img pdfImage, idx int, err error)
 //   makeImage(source image.Image, back color.RGBA,
 //       ) (widthPx, heightPx int, isGray bool, ar []byte)
+//   parseRichText(markup string) (paras [][]pdfStyleRun)
 //   reservePage() *PDF
+//   restoreState() *PDF
+//   selectFont(id int) *PDF
+//   setStyle(run pdfStyleRun)
 //   textWidthPt(s string) float64
+//   wrapRuns(widthPt float64, runs []pdfStyleRun) (ret [][]pdfStyleRun)
+//   wrapTotalFit(width float64, text string, justify bool) (ret []string)
 //
 // # Internal Generation Methods (p *PDF)
-//   nextObj() int
+//   nextObj(reservedNo ...int) int
+//   reserveObj() int
 //   write(a ...interface{}) *PDF
 //   writeCurve(x1, y1, x2, y2, x3, y3 float64) *PDF
 //   writeMode(optFill ...bool) (mode string)
-//   writeObj(objType string) *PDF
+//   writeObj(objType string, reservedNo ...int) *PDF
 //   writePages(pagesIndex, fontsIndex, imagesIndex int) *PDF
 //   writeStreamData(ar []byte) *PDF
-//   writeStreamObj(ar []byte) *PDF
+//   writeStreamObj(ar []byte, reservedNo ...int) *PDF
 //
 // # Internal Functions (*PDF) - just attached to PDF, but not using its data
+//   builtInCode(font *pdfFont, r rune) (code byte, ok bool)
+//   builtInText(font *pdfFont, s string) string
+//   appendRun(runs []pdfStyleRun, run pdfStyleRun) []pdfStyleRun
+//   builtInWidth(builtInIndex int, r rune) (width int, ok bool)
 //   escape(s string) string
+//   fontMetrics(font *pdfFont) pdfFontMetrics
 //   isWhiteSpace(s string) bool
+//   lineBreaks(s string) []int
+//   linesEnd(para string, lines []string) int
+//   newFontHandler(font interface{}) pdfFontHandler
+//   parseFeatures(s string) (ret map[string]int, invalid string)
+//   softHyphens(s string) string
 //   splitLines(s string) []string
 //   toUpperLettersDigits(s, extras string) string
 //   (p *PDF):
@@ -131,9 +195,24 @@ package pdf
 //
 // # Internal Constants
 //   pdfBlack = color.RGBA{A: 255}
+//   pdfBuiltInGlyphs = map[rune]struct
+//   pdfBuiltInMetrics = []pdfFontMetrics
+//   pdfDifferenceCodes = []byte
+//   pdfFontFamilies = map[string][4]string
+//   pdfFontKerning = []map[string]int
 //   pdfFontNames = []string
 //   pdfFontWidths = [][]int
+//   pdfKernHelvetica = map[string]int
+//   pdfKernHelveticaBold = map[string]int
+//   pdfKernTimesBold = map[string]int
+//   pdfKernTimesBoldItalic = map[string]int
+//   pdfKernTimesItalic = map[string]int
+//   pdfKernTimesRoman = map[string]int
 //   pdfStandardPaperSizes = map[string][2]int
+//   pdfSymbolChars = []rune
+//   pdfTextRenderModes = []string
+//   pdfWinAnsiCodes = map[rune]byte
+//   pdfZapfDingbatsChars = []rune
 
 import (
 	"bytes"
@@ -146,9 +225,11 @@ import (
 	_ "image/jpeg"
 	_ "image/png" // init image decoders
 	"io"
+	"math"
 	"os"
 	"reflect"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode" // only uses IsDigit(), IsLetter(), IsSpace()
@@ -167,6 +248,10 @@ type PDF struct {
 	images       []pdfImage   // all the images used in this PDF
 	columnWidths []float64    // user-set column widths (like tab stops)
 	columnNo     int          // number of the current column
+	textFrames   [][4]float64 // frames set by SetTextFrames() (in points)
+	frameNo      int          // number of the current text frame
+	frameTop     float64      // height used in the current frame (in points)
+	framePageNo  int          // page of the current text frame
 	units        string       // name of active measurement unit
 	ptPerUnit    float64      // number of points per measurement unit
 	color        color.RGBA   // current drawing color
@@ -174,15 +259,34 @@ type PDF struct {
 	font         *pdfFont     // currently selected font
 	fontName     string       // current font's name
 	fontSizePt   float64      // current font's size (in points)
+	fontStyle    string       // current font style: "", "B", "I" or "BI"
+	fontFeatures string       // OpenType features set by SetFontFeatures()
+	fontFallback []string     // fonts set by SetFontFallbacks()
 	horzScaling  uint16       // horizontal scaling factor (in %)
+	renderMode   int          // text rendering mode set by SetTextRenderMode()
+	charSpacing  float64      // space added after each character (in points)
+	wordSpacing  float64      // space added after each space (in points)
+	hyphenation  string       // language set by SetHyphenation()
+	lineHeight   float64      // line height set by SetLineHeight() (points)
+	lineSpacing  float64      // line height as a multiple of the font size
+	paraSpacing  [2]float64   // space before and after paragraphs (points)
 	compression  bool         // enable stream compression?
 	content      bytes.Buffer // content buffer where PDF is written
 	writer       io.Writer    // writer to PDF buffer or current page's buffer
 	objOffsets   []int        // object offsets used by Bytes() and write..()
 	objIndex     int          // object index used by Bytes() and write..()
+	objCount     int          // number of objects, including reserveObj()'s
 	errors       []error      // errors that occurred during method calls
 	isInit       bool         // has the PDF been initialized?
 	//
+	// fonts registered by RegisterFont() and RegisterFontFamily()
+	fontAliases  map[string]pdfFont
+	fontFamilies map[string][4]string
+	fontFiles    map[string]pdfFont // fonts read from files by loadFont()
+	//
+	// hyphenation patterns registered by RegisterHyphenation()
+	hyphenators map[string]func(word string) []int
+	//
 	// document metadata fields
 	docAuthor, docCreator, docKeywords, docSubject, docTitle string
 } //                                                                         PDF
@@ -209,20 +313,56 @@ func NewPDF(paperSize string) PDF {
 // plugin to instantiate a font handler
 var pdfNewFontHandler func() pdfFontHandler
 
+// plugin to instantiate a handler for PostScript Type 1 fonts
+var pdfNewType1FontHandler func() pdfFontHandler
+
+// plugin to split a line of bidirectional text into runs in display
+// order. 'level' is the paragraph's direction: 0 for left-to-right,
+// 1 for right-to-left, or -1 to detect it. Returns the runs and
+// the paragraph's direction.
+var pdfBidiRuns func(s string, level int) (runs []pdfBidiRun, paraLevel int)
+
+// plugin to read hyphenation patterns for a language. Returns a function
+// that returns the points where a word can be hyphenated, as the number
+// of characters before each hyphen.
+var pdfHyphenator func(language string, patterns []byte) (
+	hyphenate func(word string) []int, err error)
+
+// plugin to find the byte offsets in 's' where a line can be broken,
+// i.e. where new lines can start (excluding the start and end of 's')
+var pdfLineBreaks func(s string) []int
+
+// pdfBidiRun is a run of text with the same direction
+type pdfBidiRun struct {
+	Text  string // text in logical (not display) order
+	Level int    // embedding level: odd levels are right-to-left
+} //                                                                  pdfBidiRun
+
 // pdfFontHandler interface provides methods to parse and embed TrueType fonts.
 type pdfFontHandler interface {
 	//
 	// reads and parses a font from a file name, slice of bytes, or io.Reader
-	readFont(owner *PDF, font interface{}) bool
+	// 'optFace' selects a font in a collection by index or PostScript name
+	readFont(owner *PDF, font interface{}, optFace ...string) bool
 	//
 	// returns the width of text 's' in points
 	textWidthPt(s string) float64
 	//
-	// writes text in the string 's' and returns its width in points
-	writeText(s string)
+	// returns true if the font has a glyph for the character 'r'
+	hasGlyph(r rune) bool
+	//
+	// returns the vertical metrics of the font, in thousandths of its size
+	fontMetrics() pdfFontMetrics
+	//
+	// writes text in the string 's', which is in logical order. If 'rtl'
+	// is true, the glyphs are written in reverse (right-to-left) order
+	writeText(s string, rtl bool)
 	//
 	// writes the PDF objects that define the subset font (i.e. embeds font)
 	writeFontObjects(font *pdfFont)
+	//
+	// returns the minimum PDF version needed to embed the font, e.g. "1.4"
+	pdfVersion() string
 } //                                                              pdfFontHandler
 
 // -----------------------------------------------------------------------------
@@ -240,6 +380,18 @@ func (p *PDF) PageWidth() float64 { return p.ToUnits(p.paperSize.widthPt) }
 // -----------------------------------------------------------------------------
 // # Properties (p *PDF)
 
+// CharSpacing returns the space added after each character, in points.
+func (p *PDF) CharSpacing() float64 { p.init(); return p.charSpacing }
+
+// SetCharSpacing changes the space added after each character, in points.
+// Negative values move characters closer. Unless turned on with
+// SetFontFeatures(), ligatures are not used when characters are spaced.
+func (p *PDF) SetCharSpacing(points float64) *PDF {
+	p.init()
+	p.charSpacing = points
+	return p
+} //                                                              SetCharSpacing
+
 // Color returns the current color, which is used for text, lines and fills.
 func (p *PDF) Color() color.RGBA { p.init(); return p.color }
 
@@ -325,12 +477,53 @@ func (p *PDF) DocTitle() string { p.init(); return p.docTitle }
 // SetDocTitle sets the optional 'document title' metadata property.
 func (p *PDF) SetDocTitle(s string) *PDF { p.docTitle = s; return p }
 
+// FontFallbacks returns the names of fonts set by SetFontFallbacks().
+func (p *PDF) FontFallbacks() []string {
+	p.init()
+	return append([]string{}, p.fontFallback...)
+} //                                                               FontFallbacks
+
+// SetFontFallbacks sets a list of fonts to use for characters that are
+// not in the current font, e.g. SetFontFallbacks("NotoSans", "Symbol").
+// Each character is drawn in the current font if it has the character,
+// otherwise in the first font from the list that has it. The names can
+// be registered fonts or families, built-in fonts, or font file names.
+// Call SetFontFallbacks() without arguments to stop using fallbacks.
+func (p *PDF) SetFontFallbacks(names ...string) *PDF {
+	p.init()
+	p.fontFallback = append([]string{}, names...)
+	return p
+} //                                                            SetFontFallbacks
+
+// FontFeatures returns the OpenType features set by SetFontFeatures().
+func (p *PDF) FontFeatures() string { p.init(); return p.fontFeatures }
+
+// SetFontFeatures turns OpenType features of TrueType and OpenType
+// fonts on or off for subsequent text. Specify 4-letter feature tags
+// separated by spaces or commas, e.g. "smcp tnum". Prefix a tag with
+// '-' to turn it off, or follow it with '=n' to pick alternate glyph n,
+// e.g. "-liga salt=2". Features ccmp, clig, kern, liga and rlig are on
+// by default, as are the Arabic joining forms fina, init, isol and medi.
+// Turning off kern also turns off kerning of built-in fonts.
+func (p *PDF) SetFontFeatures(features string) *PDF {
+	p.init()
+	if _, invalid := p.parseFeatures(features); invalid != "" {
+		return p.putError(0xE7F0B2, "Invalid font feature", invalid)
+	}
+	p.fontFeatures = features
+	return p
+} //                                                             SetFontFeatures
+
 // FontName returns the name of the currently-active typeface.
 func (p *PDF) FontName() string { p.init(); return p.fontName }
 
 // SetFontName changes the current font, while using the
 // same font size as the previous font. Use one of the
-// standard font names, such as 'Helvetica'.
+// standard font names, such as 'Helvetica', or the
+// file name of a TrueType font, e.g. "fonts/Arial.ttf".
+// To use a font in a collection, append '#' and the
+// index or PostScript name of the font to the file
+// name, e.g. "fonts/Noto.ttc#2" or "Noto.ttc#NotoSans".
 func (p *PDF) SetFontName(name string) *PDF {
 	p.init()
 	p.fontName = name
@@ -355,6 +548,29 @@ func (p *PDF) SetFont(name string, points float64) *PDF {
 	return p.SetFontName(name).SetFontSize(points)
 } //                                                                     SetFont
 
+// FontStyle returns the current font style: "", "B", "I" or "BI".
+func (p *PDF) FontStyle() string { p.init(); return p.fontStyle }
+
+// SetFontStyle changes the current font style. Use "B" for bold, "I" for
+// italic, "BI" for bold italic or "" for regular. The style selects the
+// font from the current font family, such as 'Helvetica', 'Courier',
+// 'Times' or a family registered with RegisterFontFamily().
+// The style has no effect when the font name is not a family's name.
+func (p *PDF) SetFontStyle(style string) *PDF {
+	p.init()
+	s := strings.ToUpper(strings.TrimSpace(style))
+	if strings.Trim(s, "BI") != "" || len(s) > 2 || s == "BB" || s == "II" {
+		return p.putError(0xE3D51F, "Invalid font style", style)
+	}
+	p.fontStyle = ""
+	for _, c := range "BI" {
+		if strings.ContainsRune(s, c) {
+			p.fontStyle += string(c)
+		}
+	}
+	return p
+} //                                                                SetFontStyle
+
 // HorizontalScaling returns the current horizontal scaling in percent.
 func (p *PDF) HorizontalScaling() uint16 { p.init(); return p.horzScaling }
 
@@ -366,6 +582,57 @@ func (p *PDF) SetHorizontalScaling(percent uint16) *PDF {
 	return p
 } //                                                        SetHorizontalScaling
 
+// Hyphenation returns the language of the patterns used to hyphenate
+// words when text is wrapped, or "" if words are not hyphenated.
+func (p *PDF) Hyphenation() string { p.init(); return p.hyphenation }
+
+// SetHyphenation turns on hyphenation of words that don't fit on a line
+// when text is wrapped, using the patterns registered for 'language' by
+// RegisterHyphenation(). Specify "" to turn hyphenation off.
+func (p *PDF) SetHyphenation(language string) *PDF {
+	p.init()
+	key := strings.ToLower(strings.TrimSpace(language))
+	if _, found := p.hyphenators[key]; key != "" && !found {
+		return p.putError(0xE7B2D4, "Hyphenation patterns not registered",
+			language)
+	}
+	p.hyphenation = key
+	return p
+} //                                                              SetHyphenation
+
+// LineHeight returns the distance between the baselines of lines of
+// text in points, which is the font size unless set by SetLineHeight().
+func (p *PDF) LineHeight() float64 { p.init(); return p.lineHeightPt() }
+
+// SetLineHeight changes the distance between the baselines of lines of
+// text, used by NextLine(), DrawText() with columns, DrawTextInBox(),
+// DrawRichTextInBox() and DrawTextInFrames(). Paragraph spacing (see
+// SetParagraphSpacing()) is only added in text boxes and frames.
+// Specify a number without a unit for a multiple of the font size
+// (e.g. "1.5"), or a number and unit for a fixed height (e.g. "14pt" or
+// "5mm"). Specify "" to use the font size.
+func (p *PDF) SetLineHeight(height string) *PDF {
+	p.init()
+	s := strings.TrimSpace(height)
+	if s == "" {
+		p.lineHeight, p.lineSpacing = 0, 0
+		return p
+	}
+	valid := func(n float64) bool {
+		return n > 0 && !math.IsInf(n, 0) && !math.IsNaN(n)
+	}
+	if n, err := strconv.ParseFloat(s, 64); err == nil && valid(n) {
+		p.lineHeight, p.lineSpacing = 0, n
+		return p
+	}
+	points, err := p.ToPoints(s)
+	if err != nil || !valid(points) {
+		return p.putError(0xE4E7B9, "Invalid line height", height)
+	}
+	p.lineHeight, p.lineSpacing = points, 0
+	return p
+} //                                                               SetLineHeight
+
 // LineWidth returns the current line width in points.
 func (p *PDF) LineWidth() float64 { p.init(); return p.lineWidth }
 
@@ -376,6 +643,67 @@ func (p *PDF) SetLineWidth(points float64) *PDF {
 	return p
 } //                                                                SetLineWidth
 
+// ParagraphSpacing returns the space added before and after paragraphs
+// in points (see SetParagraphSpacing()).
+func (p *PDF) ParagraphSpacing() (before, after float64) {
+	p.init()
+	return p.paraSpacing[0], p.paraSpacing[1]
+} //                                                            ParagraphSpacing
+
+// SetParagraphSpacing changes the space added before and after paragraphs
+// in points. DrawTextInBox() and DrawRichTextInBox() add the space
+// between paragraphs (i.e. text split by newline characters), and
+// DrawTextInFrames() also adds it between texts drawn in the same frame.
+// Only text boxes and frames use it: NextLine() and DrawText() with
+// columns don't know where paragraphs end, and only advance by the line
+// height (see SetLineHeight()).
+func (p *PDF) SetParagraphSpacing(before, after float64) *PDF {
+	p.init()
+	invalid := func(n float64) bool {
+		return n < 0 || math.IsInf(n, 0) || math.IsNaN(n)
+	}
+	if invalid(before) || invalid(after) {
+		return p.putError(0xE9C3D6, "Invalid paragraph spacing",
+			fmt.Sprint(before, " ", after))
+	}
+	p.paraSpacing = [2]float64{before, after}
+	return p
+} //                                                         SetParagraphSpacing
+
+// TextRenderMode returns the current text rendering mode.
+func (p *PDF) TextRenderMode() string {
+	return pdfTextRenderModes[p.init().renderMode]
+} //                                                              TextRenderMode
+
+// SetTextRenderMode changes how text is drawn: "fill" (the default),
+// "stroke" to draw outlines using the line width, "fill-stroke",
+// or "invisible" e.g. for a searchable text layer over an image.
+// The clip modes "fill-clip", "stroke-clip", "fill-stroke-clip" and
+// "clip" also add the text to the clipping path, so that the
+// following drawing on the page only appears inside the text, until
+// a mode that doesn't clip is set. Each line and each font run of
+// text is a separate text object, and the clipping path is where
+// they intersect, so only draw one line of text in one font to clip.
+func (p *PDF) SetTextRenderMode(mode string) *PDF {
+	p.init()
+	name := strings.ToLower(strings.TrimSpace(mode))
+	name = strings.NewReplacer("+", "-", " ", "-", "_", "-").Replace(name)
+	n := -1
+	for i, it := range pdfTextRenderModes {
+		if it == name {
+			n = i
+		}
+	}
+	if n == -1 {
+		return p.putError(0xE3D5B8, "Invalid text render mode", mode)
+	}
+	p.renderMode = n
+	if n < 4 && p.page != nil && p.page.isClipping {
+		p.restoreState() // ends clipping
+	}
+	return p
+} //                                                           SetTextRenderMode
+
 // Units returns the currently selected measurement units.
 // E.g.: mm cm " in inch inches tw twip twips pt point points
 func (p *PDF) Units() string { p.init(); return p.units }
@@ -391,6 +719,17 @@ func (p *PDF) SetUnits(units string) *PDF {
 	return p
 } //                                                                    SetUnits
 
+// WordSpacing returns the space added after each space, in points.
+func (p *PDF) WordSpacing() float64 { p.init(); return p.wordSpacing }
+
+// SetWordSpacing changes the space added after each space character
+// (U+0020) in addition to the space's width, in points.
+func (p *PDF) SetWordSpacing(points float64) *PDF {
+	p.init()
+	p.wordSpacing = points
+	return p
+} //                                                              SetWordSpacing
+
 // X returns the X-coordinate of the current drawing position.
 func (p *PDF) X() float64 { return p.reservePage().ToUnits(p.page.x) }
 
@@ -451,7 +790,19 @@ func (p *PDF) Bytes() []byte {
 	p.writer = &p.content
 	p.objOffsets = []int{}
 	p.objIndex = 0
-	p.write("%PDF-1.4\n\n").
+	p.objCount = imagesIndex + len(p.images) - 1
+	if p.docTitle != "" || p.docSubject != "" ||
+		p.docKeywords != "" || p.docAuthor != "" || p.docCreator != "" {
+		p.objCount++
+		infoIndex = p.objCount // font handlers reserve objects after this
+	}
+	version := "1.4"
+	for _, font := range p.fonts {
+		if font.handler != nil && font.handler.pdfVersion() > version {
+			version = font.handler.pdfVersion()
+		}
+	}
+	p.write("%PDF-", version, "\n\n").
 		writeObj("/Catalog").write("/Pages 2 0 R>>\n" + "endobj\n\n")
 
 	//
@@ -461,9 +812,24 @@ func (p *PDF) Bytes() []byte {
 	// write fonts
 	for _, font := range p.fonts {
 		if font.handler == nil {
+			// symbolic fonts (Symbol and ZapfDingbats) use their own encoding
+			encoding := "\n/Encoding/WinAnsiEncoding"
+			if font.builtInIndex == 4 || font.builtInIndex == 9 {
+				encoding = ""
+			} else if len(font.differences) > 0 {
+				encoding = "\n/Encoding<</Type/Encoding" +
+					"/BaseEncoding/WinAnsiEncoding/Differences["
+				for i, r := range font.differences {
+					code := pdfDifferenceCodes[i]
+					if i == 0 || code != pdfDifferenceCodes[i-1]+1 {
+						encoding += strconv.Itoa(int(code))
+					}
+					encoding += "/" + pdfBuiltInGlyphs[r].name
+				}
+				encoding += "]>>"
+			}
 			p.writeObj("/Font").write("/Subtype/Type1/Name/FNT", font.id, "\n",
-				"/BaseFont/", font.name, "\n",
-				"/Encoding/StandardEncoding>>\n"+"endobj\n")
+				"/BaseFont/", font.name, encoding, ">>\n"+"endobj\n")
 		} else {
 			font.handler.writeFontObjects(&font)
 		}
@@ -484,10 +850,7 @@ func (p *PDF) Bytes() []byte {
 		p.compression = old
 	}
 	// write info object
-	if p.docTitle != "" || p.docSubject != "" ||
-		p.docKeywords != "" || p.docAuthor != "" || p.docCreator != "" {
-		//
-		infoIndex = imagesIndex + len(p.images)
+	if infoIndex > 0 {
 		p.writeObj("/Info")
 		for _, tuple := range [][]string{
 			{"/Title ", p.docTitle}, {"/Subject ", p.docSubject},
@@ -606,7 +969,24 @@ func (p *PDF) DrawLine(x1, y1, x2, y2 float64) *PDF {
 	// m: move  l:line  S: stroke path (for lines)
 } //                                                                    DrawLine
 
+// DrawRichTextInBox draws word-wrapped text with inline changes of
+// style within a rectangle, aligned like DrawTextInBox(). The text can
+// contain these tags, which can be nested: <b>bold</b>, <i>italic</i>,
+// <color=red>color</color> and <size=12>font size in points</size>.
+// Colors are names or HTML color values, as in SetColor(). Bold and
+// italic use the fonts of the current font family (see SetFontStyle()).
+// Anything else between '<' and '>' is drawn as text. To draw '<', '>'
+// or '&' write &lt;, &gt; or &amp;, e.g. "<b>a &lt;b&gt; tag</b>".
+// Each line is as high as the largest font in it, scaled by the line
+// height set with SetLineHeight(), and paragraphs are spaced by
+// SetParagraphSpacing().
+func (p *PDF) DrawRichTextInBox(
+	x, y, width, height float64, align, markup string) *PDF {
+	return p.drawRichTextBox(x, y, width, height, align, markup)
+} //                                                           DrawRichTextInBox
+
 // DrawText draws a text string at the current position (X, Y).
+// Right-to-left and bidirectional text is reordered for display.
 func (p *PDF) DrawText(s string) *PDF {
 	if len(p.columnWidths) == 0 {
 		return p.drawTextLine(s)
@@ -629,7 +1009,8 @@ func (p *PDF) DrawText(s string) *PDF {
 // 'B' to align the text to the top or bottom of the box.
 func (p *PDF) DrawTextAlignedToBox(
 	x, y, width, height float64, align, text string) *PDF {
-	return p.drawTextBox(x, y, width, height, false, align, text)
+	p.drawTextBox(x, y, width, height, false, false, align, text)
+	return p
 } //                                                        DrawTextAlignedToBox
 
 // DrawTextAt draws text at the specified point (x, y).
@@ -642,11 +1023,76 @@ func (p *PDF) DrawTextAt(x, y float64, text string) *PDF {
 // the text is center-aligned both vertically and horizontally.
 // Specify 'L' or 'R' to align the text left or right, and 'T' or
 // 'B' to align the text to the top or bottom of the box.
+// Right-to-left paragraphs (e.g. in Arabic or Hebrew) are aligned
+// right, unless 'L' or 'C' is specified. Specify 'J' to justify the
+// text: each line of a paragraph except the last is stretched by word
+// spacing to fill the width of the box. Specify 'K' to break the lines
+// of each paragraph with the Knuth-Plass total-fit algorithm, which
+// spreads words and hyphens evenly over all lines, instead of filling
+// one line at a time. Specify 'S' to shrink the font size until the
+// text fits in the height of the box, and 'X' to clip the text to the
+// box, so that lines that don't fit are cut off. Lines are wrapped to
+// leave a margin of 1/6 of the font size on the left and right.
 func (p *PDF) DrawTextInBox(
 	x, y, width, height float64, align, text string) *PDF {
-	return p.drawTextBox(x, y, width, height, true, align, text)
+	p.drawTextBox(x, y, width, height, true, false, align, text)
+	return p
 } //                                                               DrawTextInBox
 
+// DrawTextInBoxOverflow draws word-wrapped text within a rectangle like
+// DrawTextInBox(), but only draws the lines that fit in the height
+// of the box, and returns the rest of the text, e.g. to continue it in
+// another box. Returns a blank string if all the text fits.
+func (p *PDF) DrawTextInBoxOverflow(
+	x, y, width, height float64, align, text string) (rest string) {
+	rest, _ = p.drawTextBox(x, y, width, height, true, true, align, text)
+	return rest
+} //                                                       DrawTextInBoxOverflow
+
+// DrawTextInFrames draws word-wrapped text in the frames set by
+// SetTextFrames(), like DrawTextInBox() but aligned to the top of each
+// frame. Text that doesn't fit in a frame continues in the next frame.
+// When the frames of the page run out, a new page is added and the
+// text continues in its first frame. Text drawn by the next call of
+// this method continues below the text in the last frame used.
+func (p *PDF) DrawTextInFrames(align, text string) *PDF {
+	if len(p.textFrames) == 0 {
+		return p.putError(0xE5A9D3, "No text frames", "")
+	}
+	p.reservePage()
+	if p.framePageNo != p.pageNo { // start from the first frame of a page
+		p.frameNo, p.frameTop, p.framePageNo = 0, 0, p.pageNo
+	}
+	align = "T" + strings.NewReplacer("B", "", "b", "").Replace(align)
+	for empty := 0; text != ""; {
+		if p.frameNo >= len(p.textFrames) {
+			p.AddPage()
+			p.frameNo, p.frameTop, p.framePageNo = 0, 0, p.pageNo
+		}
+		if p.frameTop > 0 { // continue below the previous text
+			p.frameTop += p.paraSpacing[0] + p.paraSpacing[1]
+		}
+		frame := p.textFrames[p.frameNo]
+		rest, linesHeight := p.drawTextBox(p.ToUnits(frame[0]),
+			p.ToUnits(frame[1]+p.frameTop), p.ToUnits(frame[2]),
+			p.ToUnits(frame[3]-p.frameTop), true, true, align, text)
+		if rest == "" {
+			p.frameTop += linesHeight
+			break
+		}
+		if linesHeight == 0 && p.frameTop == 0 {
+			if empty++; empty == len(p.textFrames) {
+				return p.putError(0xE1F4B8, "Text frames too small for text",
+					rest)
+			}
+		} else {
+			empty = 0
+		}
+		p.frameNo, p.frameTop, text = p.frameNo+1, 0, rest
+	}
+	return p
+} //                                                            DrawTextInFrames
+
 // DrawUnitGrid draws a light-gray grid demarcated in the
 // current measurement unit. The grid fills the entire page.
 // It helps with item positioning.
@@ -682,14 +1128,14 @@ func (p *PDF) FillEllipse(x, y, xRadius, yRadius float64) *PDF {
 } //                                                                 FillEllipse
 
 // NextLine advances the text writing position to the next line.
-// I.e. the Y increases by the height of the font and
-// the X-coordinate is reset to zero.
+// I.e. the Y increases by the line height (see SetLineHeight())
+// and the X-coordinate is reset to zero.
 func (p *PDF) NextLine() *PDF {
-	x, y := 0.0, p.Y()+p.FontSize()*p.ptPerUnit
+	x, y := 0.0, p.Y()+p.ToUnits(p.lineHeightPt())
 	if len(p.columnWidths) > 0 {
 		x = p.columnWidths[0]
 	}
-	if y > p.paperSize.heightPt*p.ptPerUnit {
+	if y > p.ToUnits(p.paperSize.heightPt) {
 		p.AddPage()
 		y = 0
 	}
@@ -697,6 +1143,99 @@ func (p *PDF) NextLine() *PDF {
 	return p.SetXY(x, y)
 } //                                                                    NextLine
 
+// RegisterFont reads a TrueType or OpenType font (which can be in a WOFF
+// file) from a file name, slice of bytes or io.Reader, and makes it
+// available using 'alias' as the font name in SetFont() and SetFontName().
+// The font is only read once, no matter how often it is used. To use a
+// font in a collection (.ttc file), specify its index or PostScript name
+// in 'optFace'.
+//
+// PostScript Type 1 fonts are read from .pfb or .pfa files (or their
+// data) with their metrics from an .afm file. 'optFace' specifies the
+// AFM file name or data. By default, it has the font file's name.
+func (p *PDF) RegisterFont(alias string, font interface{},
+	optFace ...string) *PDF {
+	p.init()
+	key := p.toUpperLettersDigits(alias, "")
+	if key == "" {
+		return p.putError(0xE8B21C, "Invalid font alias", alias)
+	}
+	handler, errCount := p.newFontHandler(font), len(p.errors)
+	if handler == nil {
+		return p.putError(0xE1A2D6, "No font handler to read font", alias)
+	}
+	if !handler.readFont(p, font, optFace...) {
+		if len(p.errors) == errCount { // file not found
+			p.putError(0xE5F4A3, "Invalid font", alias)
+		}
+		return p
+	}
+	if p.fontAliases == nil {
+		p.fontAliases = make(map[string]pdfFont)
+	}
+	p.fontAliases[key] = pdfFont{name: alias, handler: handler}
+	return p
+} //                                                                RegisterFont
+
+// RegisterFontFamily makes a font family available using 'family' as the
+// font name in SetFont() and SetFontName(). SetFontStyle() then selects
+// the regular, bold, italic or bold-italic font. Each font can be a font
+// alias from RegisterFont(), a standard font name or a font file name.
+// If a bold, italic or bold-italic font is blank, the regular is used.
+func (p *PDF) RegisterFontFamily(family, regular, bold, italic,
+	boldItalic string) *PDF {
+	p.init()
+	key := p.toUpperLettersDigits(family, "")
+	if key == "" || regular == "" {
+		return p.putError(0xE4C9A0, "Invalid font family",
+			family+": "+regular)
+	}
+	if p.fontFamilies == nil {
+		p.fontFamilies = make(map[string][4]string)
+	}
+	p.fontFamilies[key] = [4]string{regular, bold, italic, boldItalic}
+	return p
+} //                                                          RegisterFontFamily
+
+// RegisterHyphenation reads the hyphenation patterns of 'language'
+// (e.g. "en", "de", "fr" or "es") from a file name or slice of bytes.
+// Patterns are in the format of TeX pattern files, such as
+// hyph-en-us.tex or hyph-de-1996.tex from the hyph-utf8 project.
+// Call SetHyphenation() to hyphenate words using the patterns.
+func (p *PDF) RegisterHyphenation(language string,
+	patterns interface{}) *PDF {
+	p.init()
+	key := strings.ToLower(strings.TrimSpace(language))
+	if key == "" {
+		return p.putError(0xE3F6A8, "Invalid hyphenation language", language)
+	}
+	if pdfHyphenator == nil {
+		return p.putError(0xE9A1C5, "No hyphenator to read patterns", language)
+	}
+	var data []byte
+	switch val := patterns.(type) {
+	case string:
+		var err error
+		if data, err = os.ReadFile(val); err != nil {
+			return p.putError(0xE5D8B2, "Failed reading file", err.Error())
+		}
+	case []byte:
+		data = val
+	default:
+		return p.putError(0xE2C4F7, "Invalid type in patterns",
+			fmt.Sprintf("%s = %v", reflect.TypeOf(patterns), patterns))
+	}
+	hyphenate, err := pdfHyphenator(key, data)
+	if err, isT := err.(pdfError); isT {
+		return p.putError(0xE8E3A6, err.msg, err.val)
+	}
+	if p.hyphenators == nil {
+		p.hyphenators = make(map[string]func(word string) []int)
+	}
+	p.hyphenators[key] = hyphenate
+	return p
+} //                                                         RegisterHyphenation
+
 // Reset releases all resources and resets all variables, except paper size.
 func (p *PDF) Reset() *PDF {
 	p.page, p.writer = nil, nil
@@ -721,9 +1260,66 @@ func (p *PDF) SetColumnWidths(widths ...float64) *PDF {
 	return p
 } //                                                             SetColumnWidths
 
+// SetTextFrames sets the frames that DrawTextInFrames() pours text into,
+// in the order text flows through them on each page. Specify 4 numbers
+// for each frame: x, y, width and height, e.g. two columns:
+// SetTextFrames(1, 1, 9, 27, 11, 1, 9, 27)
+// To remove all text frames, call this method without any argument.
+func (p *PDF) SetTextFrames(frames ...float64) *PDF {
+	p.init()
+	if len(frames)%4 != 0 {
+		return p.putError(0xE8B6F1, "Invalid text frames",
+			fmt.Sprint(frames))
+	}
+	p.textFrames = nil
+	for i := 0; i < len(frames); i += 4 {
+		if frames[i+2] <= 0 || frames[i+3] <= 0 {
+			p.textFrames = nil
+			return p.putError(0xE8B6F1, "Invalid text frames",
+				fmt.Sprint(frames[i:i+4]))
+		}
+		p.textFrames = append(p.textFrames, [4]float64{frames[i] * p.ptPerUnit,
+			frames[i+1] * p.ptPerUnit, frames[i+2] * p.ptPerUnit,
+			frames[i+3] * p.ptPerUnit})
+	}
+	p.frameNo, p.frameTop, p.framePageNo = 0, 0, p.pageNo
+	return p
+} //                                                               SetTextFrames
+
 // -----------------------------------------------------------------------------
 // # Metrics Methods (p *PDF)
 
+// FontMetrics returns the vertical metrics of the current font at the
+// current font size, in current units: the ascent above the baseline,
+// the descent below the baseline (a negative number), the leading, i.e.
+// the distance between baselines that the font recommends, the height
+// of capital letters, the x-height (zero if the font doesn't specify
+// it) and the position of underlines (a negative number).
+func (p *PDF) FontMetrics() (ret struct {
+	Ascent, Descent, Leading, CapHeight, XHeight,
+	UnderlinePosition float64
+}) {
+	p.init()
+	font, valid := p.loadFont(p.fontFace())
+	if !valid {
+		p.putError(0xE2A7C9, "Invalid font", p.fontFace())
+		font, _ = p.loadFont("Helvetica")
+	}
+	metrics := p.fontMetrics(&font)
+	for _, it := range []struct {
+		field *float64
+		val   int
+	}{
+		{&ret.Ascent, metrics.ascent}, {&ret.Descent, metrics.descent},
+		{&ret.Leading, metrics.leading}, {&ret.CapHeight, metrics.capHeight},
+		{&ret.XHeight, metrics.xHeight},
+		{&ret.UnderlinePosition, metrics.underline},
+	} {
+		*it.field = p.ToUnits(float64(it.val) * p.fontSizePt / 1000)
+	}
+	return ret
+} //                                                                 FontMetrics
+
 // TextWidth returns the width of the text in current units.
 func (p *PDF) TextWidth(s string) float64 {
 	return p.ToUnits(p.textWidthPt(s))
@@ -803,58 +1399,54 @@ func (p *PDF) ToUnits(points float64) float64 {
 } //                                                                     ToUnits
 
 // WrapTextLines splits a string into multiple lines so that the text
-// fits in the specified width. The text is wrapped on word boundaries.
-// Newline characters ("\r" and "\n") also cause text to be split.
+// fits in the specified width. Lines are broken where the Unicode line
+// breaking algorithm allows: e.g. after spaces, hyphens and slashes
+// (which splits long URLs), or between CJK characters, but not at
+// non-breaking spaces. A line can also be broken at a soft hyphen
+// (U+00AD), which is then shown as a hyphen; other soft hyphens are
+// removed. Newline characters ("\r" and "\n") also cause text to be split.
+// If hyphenation is on (see SetHyphenation()), a word that doesn't fit
+// is split at a hyphenation point, and a hyphen is added to the line.
 // You can find out the number of lines needed to wrap some
 // text by checking the length of the returned array.
 func (p *PDF) WrapTextLines(width float64, text string) (ret []string) {
-	fit := func(s string, step, n int, width float64) int {
-		for max := len(s); n > 0 && n <= max; {
-			w := p.TextWidth(s[:n])
-			switch step {
-			case 1, 3: //       keep halving (or - 1) until n chars fit in width
-				if w <= width {
-					return n
-				}
-				n--
-				if step == 1 {
-					n /= 2
-				}
-			case 2: //               increase n until n chars won't fit in width
-				if w > width {
-					return n
-				}
-				n = 1 + int((float64(n) * 1.2)) //    increase n by 1 + 20% of n
-			}
-		}
-		return 0
+	fits := func(s string) bool { // trailing spaces don't need to fit
+		return p.TextWidth(strings.TrimRight(p.softHyphens(s), " ")) <= width
 	}
 	// split text into lines. then break lines based on text width
 	for _, line := range p.splitLines(text) {
-		for p.TextWidth(line) > width {
-			n := len(line) //    reduce, increase, then reduce n to get best fit
-			for i := 1; i <= 3; i++ {
-				n = fit(line, i, n, width)
+		for !fits(line) {
+			// find the last break opportunity where the line fits
+			breaks := p.lineBreaks(line)
+			i := sort.Search(len(breaks), func(i int) bool {
+				return !fits(line[:breaks[i]])
+			})
+			n := 0 // start of the word that doesn't fit
+			if i > 0 {
+				n = breaks[i-1]
+			}
+			if cut := p.hyphenateLine(line, n, width); cut > 0 {
+				ret = append(ret, p.softHyphens(line[:cut])+"-")
+				line = line[cut:]
+				continue
 			}
-			// move to the last word (if white-space is found)
-			found, max := false, n
-			for n > 0 {
-				if p.isWhiteSpace(line[n-1 : n]) {
-					found = true
+			if n == 0 { // no break fits: break after the last char. that fits
+				var runes []int
+				for i := range line {
+					runes = append(runes, i)
+				}
+				i = sort.Search(len(runes), func(i int) bool {
+					return i > 0 && !fits(line[:runes[i]])
+				})
+				if i <= 1 {
 					break
 				}
-				n--
-			}
-			if !found {
-				n = max
-			}
-			if n <= 0 {
-				break
+				n = runes[i-1]
 			}
-			ret = append(ret, line[:n])
+			ret = append(ret, p.softHyphens(line[:n]))
 			line = line[n:]
 		}
-		ret = append(ret, line)
+		ret = append(ret, strings.ReplaceAll(line, "\u00AD", ""))
 	}
 	return ret
 } //                                                               WrapTextLines
@@ -914,8 +1506,25 @@ type pdfFont struct {
 	builtInIndex     int
 	isBold, isItalic bool
 	handler          pdfFontHandler
+	differences      []rune // characters coded by pdfDifferenceCodes
 } //                                                                     pdfFont
 
+// pdfFontMetrics specifies the vertical metrics of a font,
+// in thousandths of the font size
+type pdfFontMetrics struct {
+	ascent, descent int // height above and depth below the baseline
+	leading         int // recommended distance between baselines
+	capHeight       int // height of capital letters
+	xHeight         int // height of lowercase letters like 'x'
+	underline       int // position of underlines (below the baseline)
+} //                                                              pdfFontMetrics
+
+// pdfFontRun is a run of text drawn in one font (see fontRuns())
+type pdfFontRun struct {
+	font pdfFont
+	text string
+} //                                                                  pdfFontRun
+
 // pdfImage represents an image
 type pdfImage struct {
 	filename          string     // name of file from which image was read
@@ -933,6 +1542,9 @@ type pdfPage struct {
 	strokeColor, nonStrokeColor color.RGBA   // "
 	fontID                      int          // "
 	horzScaling                 uint16       // "
+	renderMode                  int          // "
+	charSpacing, wordSpacing    float64      // "
+	isClipping                  bool         // text clipping after 'q'?
 	content                     bytes.Buffer // write..() calls send output here
 } //                                                                     pdfPage
 
@@ -942,6 +1554,14 @@ type pdfPaperSize struct {
 	widthPt, heightPt float64 // width and height in points
 } //                                                                pdfPaperSize
 
+// pdfStyleRun is a run of text drawn in one style (see parseRichText())
+type pdfStyleRun struct {
+	text   string     // the text
+	style  string     // font style: "", "B", "I" or "BI"
+	color  color.RGBA // text color
+	sizePt float64    // font size in points
+} //                                                                 pdfStyleRun
+
 // -----------------------------------------------------------------------------
 // # Internal Methods (p *PDF)
 
@@ -960,156 +1580,620 @@ type pdfPaperSize struct {
 //   standard (built-in) font like Helvetica or a TrueType font.
 // - Fills the document-wide list of fonts (p.fonts).
 // - Adds items to the list of font ID's used on the current page.
-func (p *PDF) applyFont() (handler pdfFontHandler, err error) {
-	var (
-		font  pdfFont
-		name  = p.toUpperLettersDigits(p.fontName, "")
-		valid = name != ""
-	)
-	if valid {
-		valid = false
-		for i, fname := range pdfFontNames {
-			fname = p.toUpperLettersDigits(fname, "")
-			if fname != name {
-				continue
-			}
-			has := strings.Contains
-			font = pdfFont{
-				name:         pdfFontNames[i],
-				builtInIndex: i,
-				isBold:       has(fname, "BOLD"),
-				isItalic:     has(fname, "OBLIQUE") || has(fname, "ITALIC"),
-			}
-			valid = true
-			break
-		}
-	}
-	if !valid && pdfNewFontHandler != nil {
-		handler = pdfNewFontHandler()
-		valid = handler.readFont(p, p.fontName)
-		font.handler = handler
+//
+// 'optFace' specifies a font to use instead of the current font,
+// for drawing characters with a font from SetFontFallbacks().
+func (p *PDF) applyFont(optFace ...string) (handler pdfFontHandler, err error) {
+	p.reservePage()
+	fontName := p.fontFace()
+	if len(optFace) > 0 {
+		fontName = optFace[0]
 	}
+	font, valid := p.loadFont(fontName)
+	handler = font.handler
 	// if there is no selected font or it's invalid, use Helvetica
 	if !valid {
-		err = pdfError{id: 0xE86819, msg: "Invalid font", val: p.fontName}
+		err = pdfError{id: 0xE86819, msg: "Invalid font", val: fontName}
 		p.fontName = "Helvetica"
 		p.applyFont()
 		return nil, err
 	}
 	// has the font been added to the global list? if not, add it:
-	for _, it := range p.fonts {
-		if font.name == it.name {
-			font.id = it.id
-			break
-		}
-	}
 	if font.id == 0 {
 		font.id = 1 + len(p.fonts)
 		p.fonts = append(p.fonts, font)
 	}
-	if p.page.fontID == font.id &&
-		int(p.page.fontSizePt*100) == int(p.fontSizePt)*100 {
-		return handler, err
-	}
-	// add the font ID to the current page, if not already referenced
-	var alreadyUsedOnPage bool
-	for _, id := range p.page.fontIDs {
-		if id == font.id {
-			alreadyUsedOnPage = true
-			break
-		}
-	}
-	if !alreadyUsedOnPage {
-		p.page.fontIDs = append(p.page.fontIDs, 0)
-		p.page.fontIDs[len(p.page.fontIDs)-1] = font.id
-	}
-	p.page.fontID = font.id
-	p.page.fontSizePt = p.fontSizePt
-	p.write("BT /FNT", p.page.fontID, " ", int(p.page.fontSizePt),
-		" Tf ET\n")
-	// BT: begin text  /FNT0 i0 Tf: set font to FNT0 index i0  ET: end text
+	p.selectFont(font.id)
 	return handler, err
 } //                                                                   applyFont
 
-// drawTextLine writes a line of text at the current coordinates to the
-// current page's content stream, using a sequence of raw PDF commands
-func (p *PDF) drawTextLine(s string) *PDF {
-	if s == "" {
-		return p
-	}
-	// draw the text
-	handler, err := p.applyFont()
-	if err, isT := err.(pdfError); isT {
-		p.putError(0xEAEAC4, err.msg, err.val)
-	}
-	if p.page.horzScaling != p.horzScaling {
-		p.page.horzScaling = p.horzScaling
-		p.write("BT ", p.page.horzScaling, " Tz ET\n")
-		// BT: begin text  n0 Tz: set horiz. text scaling to n0%  ET: end text
-	}
-	p.writeMode(true) // fill / non-stroke
-	if handler == nil {
-		p.write("BT ", int(p.page.x), " ", int(p.page.y),
-			" Td (", p.escape(s), ") Tj ET\n")
-		// BT: begin text  Td: move text position  Tj: show text  ET: end text
-	} else {
-		handler.writeText(s)
+// builtInRuns splits text in the current built-in font into runs that
+// use the same font resource. Characters that are not in WinAnsiEncoding
+// are added to the /Differences of one of the font's resources, and when
+// all their codes are taken, another resource of the font is added.
+func (p *PDF) builtInRuns(s string) (runs []pdfFontRun) {
+	var (
+		fontID = p.font.id
+		id     = fontID // resource of the current run
+		start  = 0
+	)
+	for i, r := range s {
+		var (
+			next     = id
+			font     = p.fonts[id-1]
+			_, ok    = p.builtInCode(&font, r)
+			_, found = pdfBuiltInGlyphs[r]
+			symbolic = font.builtInIndex == 4 || font.builtInIndex == 9
+		)
+		if !ok && found && !symbolic {
+			next = 0
+			for _, it := range p.fonts { // use a resource that has it
+				if _, has := p.builtInCode(&it, r); has &&
+					it.handler == nil && it.name == font.name {
+					next = it.id
+					break
+				}
+			}
+		}
+		if next == 0 { // else the current or another resource with room
+			for _, it := range append([]pdfFont{font}, p.fonts...) {
+				if it.handler == nil && it.name == font.name &&
+					len(it.differences) < len(pdfDifferenceCodes) {
+					next = it.id
+					break
+				}
+			}
+			if next == 0 { // all codes are taken: add a resource
+				font.id, font.differences = len(p.fonts)+1, nil
+				p.fonts = append(p.fonts, font)
+				next = font.id
+			}
+			p.fonts[next-1].differences =
+				append(p.fonts[next-1].differences, r)
+		}
+		if next != id {
+			if i > start {
+				runs = append(runs, pdfFontRun{font: p.fonts[id-1],
+					text: s[start:i]})
+			}
+			id, start = next, i
+		}
 	}
-	p.page.x += p.textWidthPt(s)
-	return p
-} //                                                                drawTextLine
+	p.font = &p.fonts[fontID-1] // in case p.fonts was reallocated
+	return append(runs, pdfFontRun{font: p.fonts[id-1], text: s[start:]})
+} //                                                                 builtInRuns
 
-// drawTextBox draws a line of text, or a word-wrapped block of text.
-// align: specify up to 2 flags: L R T B to align left, right, top or bottom
-// the default (blank) is C center, both vertically and horizontally
-func (p *PDF) drawTextBox(x, y, width, height float64,
-	wrapText bool, align, text string) *PDF {
-	if text == "" {
+// drawRichTextBox draws word-wrapped text in the styles given by markup
+// (see DrawRichTextInBox()). 'align' has the L R T B J flags of
+// drawTextBox()
+func (p *PDF) drawRichTextBox(x, y, width, height float64,
+	align, markup string) *PDF {
+	if markup == "" {
 		return p
 	}
 	p.reservePage()
-	handler, err := p.applyFont()
+	_, err := p.applyFont()
 	if err, isT := err.(pdfError); isT {
-		p.putError(0xE0737C, err.msg, err.val)
+		p.putError(0xE4C7A1, err.msg, err.val)
 	}
-	var lines []string
-	if wrapText {
-		lines = p.WrapTextLines(width, text)
-		_ = handler // TODO: ^needs to interact with font handler to get width
-	} else {
-		lines = []string{text}
+	var (
+		base = pdfStyleRun{style: p.fontStyle, color: p.color,
+			sizePt: p.fontSizePt}
+		fontID = p.font.id
+		margin = p.fontSizePt / 6 // left and right margin
+		lines  [][]pdfStyleRun
+		levels []int  // direction of each line's paragraph (0: LTR, 1: RTL)
+		isLast []bool // is the last line of a paragraph?
+	)
+	for _, para := range p.parseRichText(markup) {
+		level, text := 0, ""
+		for _, run := range para {
+			text += run.text
+		}
+		if pdfBidiRuns != nil {
+			_, level = pdfBidiRuns(text, -1)
+		}
+		wrapped := p.wrapRuns(width*p.ptPerUnit-2*margin, para)
+		for i, line := range wrapped {
+			lines, levels = append(lines, line), append(levels, level)
+			isLast = append(isLast, i == len(wrapped)-1)
+		}
+	}
+	// each line is as high as its largest font, scaled by the line height,
+	// plus the paragraph spacing if it starts a paragraph. The first line's
+	// baseline is its largest font size below the top.
+	heights, allLinesHeight := make([]float64, len(lines)), 0.0
+	for i, line := range lines {
+		sizePt := base.sizePt // for blank lines
+		for j, run := range line {
+			if j == 0 || run.sizePt > sizePt {
+				sizePt = run.sizePt
+			}
+		}
+		heights[i] = sizePt
+		if i > 0 {
+			heights[i] = p.lineHeightPt(sizePt)
+		}
+		if i > 0 && isLast[i-1] {
+			heights[i] += p.paraSpacing[0] + p.paraSpacing[1]
+		}
+		allLinesHeight += heights[i]
 	}
 	align = strings.ToUpper(align)
-	lineHeight := p.FontSize()
-	allLinesHeight := lineHeight * float64(len(lines))
+	justify := strings.Contains(align, "J")
 	//
 	// calculate aligned y-axis position of text (top, bottom, center)
-	y, height = y*p.ptPerUnit+p.fontSizePt, height*p.ptPerUnit
+	y, height = y*p.ptPerUnit+heights[0], height*p.ptPerUnit
 	if strings.Contains(align, "B") { // bottom
 		y += height - allLinesHeight - 4 //                           4pt margin
 	} else if !strings.Contains(align, "T") {
-		y += height/2 - allLinesHeight/2 - p.fontSizePt*0.15 //           center
+		capHeight := float64(p.fontMetrics(p.font).capHeight) *
+			heights[0] / 1000
+		y += (height - allLinesHeight - heights[0] + capHeight) / 2
 	}
 	y = p.paperSize.heightPt - y
 	//
-	// calculate x-axis position of text (left, right, center)
+	// calculate x-axis position of each line (left, right, center)
 	x, width = x*p.ptPerUnit, width*p.ptPerUnit
-	for _, line := range lines {
+	wordSpacing := p.wordSpacing
+	for i, line := range lines {
+		if i > 0 {
+			y -= heights[i]
+		}
+		if n := len(line); n > 0 {
+			line[n-1].text = strings.TrimRight(line[n-1].text, " ")
+		}
+		lineWidth, spaces := 0.0, 0
+		for _, run := range line {
+			p.setStyle(run)
+			lineWidth += p.textWidthPt(run.text)
+			spaces += strings.Count(run.text, " ")
+		}
 		off := 0.0 //                                   x-offset to align in box
-		if strings.Contains(align, "L") {
-			off = p.fontSizePt / 6 //                                left margin
-		} else if strings.Contains(align, "R") {
-			off = width - p.textWidthPt(line) - p.fontSizePt/6
+		if justify && !isLast[i] && spaces > 0 {
+			p.wordSpacing += (width - lineWidth - 2*margin) /
+				float64(spaces) / float64(p.horzScaling) * 100
+			off = margin
+		} else if strings.Contains(align, "L") ||
+			justify && levels[i] == 0 && !strings.ContainsAny(align, "CR") {
+			off = margin
+		} else if strings.Contains(align, "R") ||
+			levels[i] == 1 && !strings.Contains(align, "C") { // RTL default
+			off = width - lineWidth - margin
 		} else {
-			off = width/2 - p.textWidthPt(line)/2 //                      center
+			off = width/2 - lineWidth/2 //                                center
 		}
 		p.page.x, p.page.y = x+off, y
-		p.drawTextLine(line)
-		y -= lineHeight
+		for j := range line {
+			run := line[j]
+			if levels[i] == 1 { // right-to-left runs are displayed in reverse
+				run = line[len(line)-1-j]
+			}
+			p.setStyle(run)
+			p.drawTextLine(run.text, levels[i])
+		}
+		p.wordSpacing = wordSpacing
 	}
+	p.fontStyle, p.color, p.fontSizePt = base.style, base.color, base.sizePt
+	p.font = &p.fonts[fontID-1]
 	return p
+} //                                                             drawRichTextBox
+
+// drawTextLine writes a line of text at the current coordinates to the
+// current page's content stream, using a sequence of raw PDF commands.
+// Bidirectional text is written in display order. 'optLevel' is the
+// paragraph's direction: 0 for left-to-right or 1 for right-to-left.
+// By default, it is the direction of the first strong character.
+func (p *PDF) drawTextLine(s string, optLevel ...int) *PDF {
+	if s == "" {
+		return p
+	}
+	// draw the text
+	handler, err := p.applyFont()
+	if err, isT := err.(pdfError); isT {
+		p.putError(0xEAEAC4, err.msg, err.val)
+	}
+	if p.page.horzScaling != p.horzScaling {
+		p.page.horzScaling = p.horzScaling
+		p.write("BT ", p.page.horzScaling, " Tz ET\n")
+		// BT: begin text  n0 Tz: set horiz. text scaling to n0%  ET: end text
+	}
+	if p.renderMode >= 4 && !p.page.isClipping {
+		p.page.isClipping = true
+		p.write("q\n") // q: save graphics state, to end clipping with 'Q'
+	}
+	if p.page.renderMode != p.renderMode {
+		p.page.renderMode = p.renderMode
+		p.write("BT ", p.page.renderMode, " Tr ET\n")
+		// n0 Tr: set text rendering mode, e.g. 1: stroke, 3: invisible
+	}
+	if p.page.charSpacing != p.charSpacing {
+		p.page.charSpacing = p.charSpacing
+		p.write("BT ", p.page.charSpacing, " Tc ET\n")
+		// n0 Tc: set character spacing to n0 unscaled text space units
+	}
+	if p.page.wordSpacing != p.wordSpacing {
+		p.page.wordSpacing = p.wordSpacing
+		p.write("BT ", p.page.wordSpacing, " Tw ET\n")
+		// n0 Tw: set word spacing (applies to single-byte code 32 only)
+	}
+	p.writeMode(true) // fill / non-stroke
+	level := -1
+	if len(optLevel) > 0 {
+		level = optLevel[0]
+	}
+	runs := []pdfBidiRun{{Text: s}}
+	if pdfBidiRuns != nil {
+		runs, _ = pdfBidiRuns(s, level)
+	}
+	fontID := p.font.id
+	for _, run := range runs {
+		rtl := run.Level%2 == 1
+		parts := []pdfFontRun{{font: *p.font, text: run.Text}}
+		if len(p.fontFallback) > 0 {
+			parts = p.fontRuns(run.Text)
+		}
+		for i := range parts {
+			part := parts[i]
+			if rtl { // runs of fallback fonts are also displayed in reverse
+				part = parts[len(parts)-1-i]
+			}
+			if part.font.name != p.font.name {
+				handler, _ = p.applyFont(part.font.name)
+			}
+			text := part.text
+			if handler == nil {
+				if rtl { // built-in fonts have no shaping: just reverse the text
+					ar := []rune(text)
+					for i, j := 0, len(ar)-1; i < j; i, j = i+1, j-1 {
+						ar[i], ar[j] = ar[j], ar[i]
+					}
+					text = string(ar)
+				}
+				for _, it := range p.builtInRuns(text) {
+					p.selectFont(it.font.id)
+					p.write("BT ", p.page.x, " ", p.page.y,
+						" Td ", p.kernText(it.text), " ET\n")
+					// BT: begin text  Td: move text position  ET: end text
+					p.page.x += p.fontWidthPt(it.text)
+				}
+				continue
+			}
+			handler.writeText(text, rtl)
+			p.page.x += p.fontWidthPt(text)
+		}
+	}
+	p.font = &p.fonts[fontID-1] // next applyFont() switches from fallbacks
+	return p
+} //                                                                drawTextLine
+
+// drawTextBox draws a line of text, or a word-wrapped block of text.
+// align: specify up to 2 flags: L R T B to align left, right, top or bottom
+// the default (blank) is C center, both vertically and horizontally.
+// J justifies each line of a paragraph except the last, by adding word
+// spacing to fill the width. The last line is aligned by the other
+// flags, or at the start of the paragraph's direction by default.
+// S shrinks the font size until the text fits in the height of the box,
+// and X clips the text to the box. If 'overflow' is true, only the lines
+// that fit in the box are drawn, and the rest of the text is returned.
+// Also returns the height of the lines drawn, in points. Lines are
+// spaced by the line height, and paragraphs by the paragraph spacing.
+func (p *PDF) drawTextBox(x, y, width, height float64,
+	wrapText, overflow bool, align, text string,
+) (rest string, linesHeight float64) {
+	if text == "" {
+		return "", 0
+	}
+	p.reservePage()
+	handler, err := p.applyFont()
+	if err, isT := err.(pdfError); isT {
+		p.putError(0xE0737C, err.msg, err.val)
+	}
+	_ = handler // TODO: ^needs to interact with font handler to get width
+	//
+	// wrap each paragraph, noting its direction (0: LTR, 1: RTL)
+	var (
+		lines      []string
+		levels     []int
+		isLast     []bool // is the last line of a paragraph?
+		paraNo     []int  // index of each line's paragraph
+		paragraphs = []string{text}
+	)
+	if wrapText {
+		paragraphs = p.splitLines(text)
+	}
+	align = strings.ToUpper(align)
+	justify := strings.Contains(align, "J")
+	wrap := func() {
+		lines, levels, isLast, paraNo = nil, nil, nil, nil
+		// leave a margin of 1/6 of the font size on each side
+		wrapWidth := width - p.fontSizePt/3/p.ptPerUnit
+		for n, para := range paragraphs {
+			level, wrapped := 0, []string{para}
+			if pdfBidiRuns != nil {
+				_, level = pdfBidiRuns(para, -1)
+			}
+			if wrapText && strings.Contains(align, "K") {
+				wrapped = p.wrapTotalFit(wrapWidth, para, justify)
+			} else if wrapText {
+				wrapped = p.WrapTextLines(wrapWidth, para)
+			}
+			for i, line := range wrapped {
+				lines, levels = append(lines, line), append(levels, level)
+				isLast = append(isLast, i == len(wrapped)-1)
+				paraNo = append(paraNo, n)
+			}
+		}
+	}
+	// offsets returns the distance of each line's baseline from the first
+	// baseline, and the height of all the lines (to the last baseline)
+	offsets := func() (ret []float64, linesHeight float64) {
+		lineHeight, off := p.lineHeightPt(), 0.0
+		for i := range lines {
+			if i > 0 {
+				off += lineHeight
+				if paraNo[i] != paraNo[i-1] {
+					off += p.paraSpacing[0] + p.paraSpacing[1]
+				}
+			}
+			ret = append(ret, off)
+		}
+		if len(lines) == 0 {
+			return ret, 0
+		}
+		return ret, off + p.fontSizePt
+	}
+	wrap()
+	fontSizePt := p.fontSizePt
+	defer func() { p.fontSizePt = fontSizePt }()
+	for strings.Contains(align, "S") && p.fontSizePt > 1 {
+		if _, h := offsets(); h <= height*p.ptPerUnit {
+			break
+		}
+		// Tf only writes whole sizes, so e.g. 10.5 shrinks to 10, 9...
+		p.fontSizePt = math.Ceil(p.fontSizePt) - 1
+		wrap()
+	}
+	offs, _ := offsets()
+	fit := 0
+	for fit < len(lines) &&
+		offs[fit]+p.fontSizePt <= height*p.ptPerUnit+0.001 {
+		fit++
+	}
+	if overflow && fit < len(lines) {
+		// return the text after the lines that fit, from the middle of
+		// the paragraph of the first line that doesn't fit
+		n := fit
+		for n > 0 && paraNo[n-1] == paraNo[fit] {
+			n--
+		}
+		para := paragraphs[paraNo[fit]]
+		rest = para[p.linesEnd(para, lines[n:fit]):]
+		for _, para := range paragraphs[paraNo[fit]+1:] {
+			rest += "\n" + para
+		}
+		lines, levels, isLast = lines[:fit], levels[:fit], isLast[:fit]
+	}
+	offs, allLinesHeight := offsets()
+	if len(lines) > 0 {
+		linesHeight = offs[len(lines)-1] + p.lineHeightPt()
+	}
+	//
+	// calculate aligned y-axis position of text (top, bottom, center)
+	top := y * p.ptPerUnit
+	y, height = top+p.fontSizePt, height*p.ptPerUnit
+	if strings.Contains(align, "B") { // bottom
+		y += height - allLinesHeight - 4 //                           4pt margin
+	} else if !strings.Contains(align, "T") {
+		// center: the middle of the space from the top of the first line's
+		// capital letters to the last baseline is the middle of the box
+		capHeight := float64(p.fontMetrics(p.font).capHeight) *
+			p.fontSizePt / 1000
+		y += (height - allLinesHeight - p.fontSizePt + capHeight) / 2
+	}
+	y = p.paperSize.heightPt - y
+	//
+	// calculate x-axis position of text (left, right, center)
+	x, width = x*p.ptPerUnit, width*p.ptPerUnit
+	clip, isClipping := strings.Contains(align, "X"), p.page.isClipping
+	if clip {
+		// q: save graphics state  re: rectangle  W n: clip to it
+		p.write("q\n", x, " ", p.paperSize.heightPt-top-height, " ",
+			width, " ", height, " re W n\n")
+	}
+	wordSpacing := p.wordSpacing
+	for i, line := range lines {
+		off := 0.0 //                                   x-offset to align in box
+		if justify && !isLast[i] && strings.Count(
+			strings.TrimRight(line, " "), " ") > 0 {
+			// spread the free width over the spaces between words: word
+			// spacing (Tw) is scaled by horizontal scaling, like widths
+			line = strings.TrimRight(line, " ")
+			free := width - p.textWidthPt(line) - p.fontSizePt/3
+			p.wordSpacing += free / float64(strings.Count(line, " ")) /
+				float64(p.horzScaling) * 100
+			off = p.fontSizePt / 6 //                                left margin
+		} else if strings.Contains(align, "L") ||
+			justify && levels[i] == 0 && !strings.ContainsAny(align, "CR") {
+			off = p.fontSizePt / 6 //                                left margin
+		} else if strings.Contains(align, "R") ||
+			levels[i] == 1 && !strings.Contains(align, "C") { // RTL default
+			line = strings.TrimRight(line, " ") // don't align unseen spaces
+			off = width - p.textWidthPt(line) - p.fontSizePt/6
+		} else {
+			line = strings.TrimRight(line, " ")
+			off = width/2 - p.textWidthPt(line)/2 //                      center
+		}
+		p.page.x, p.page.y = x+off, y-offs[i]
+		p.drawTextLine(line, levels[i])
+		p.wordSpacing = wordSpacing
+	}
+	if clip {
+		if p.page.isClipping && !isClipping { // ends text clipping in box
+			p.write("Q\n")
+		}
+		p.restoreState()
+		p.page.isClipping = isClipping
+	}
+	return rest, linesHeight
 } //                                                                 drawTextBox
 
+// fontFeature returns the value of the OpenType feature 'tag' set by
+// SetFontFeatures(): 0 if the feature is off, 1 if it is on, or the
+// number of the alternate glyph to use
+func (p *PDF) fontFeature(tag string) int {
+	if p.fontFeatures != "" {
+		features, _ := p.parseFeatures(p.fontFeatures)
+		if val, found := features[tag]; found {
+			return val
+		}
+	}
+	if p.charSpacing != 0 && (tag == "clig" || tag == "liga") {
+		return 0 // spaced characters are not joined by default
+	}
+	if strings.Contains(" ccmp clig fina init isol kern liga medi rlig ",
+		" "+tag+" ") {
+		return 1 // on by default
+	}
+	return 0
+} //                                                                 fontFeature
+
+// fontFace returns the name of the current font. When the current font
+// name is a font family, returns the family's font in the current style.
+// 'optFamily' specifies a font or family name to use instead.
+func (p *PDF) fontFace(optFamily ...string) string {
+	name := p.fontName
+	if len(optFamily) > 0 {
+		name = optFamily[0]
+	}
+	key := p.toUpperLettersDigits(name, "")
+	faces, found := p.fontFamilies[key]
+	if !found {
+		faces, found = pdfFontFamilies[key]
+	}
+	if !found {
+		return name
+	}
+	i := 0 // index of face: 0=regular, 1=bold, 2=italic, 3=bold italic
+	if strings.Contains(p.fontStyle, "B") {
+		i++
+	}
+	if strings.Contains(p.fontStyle, "I") {
+		i += 2
+	}
+	if faces[i] == "" {
+		return faces[0]
+	}
+	return faces[i]
+} //                                                                    fontFace
+
+// fontRuns splits 's' into runs of characters that use the same font.
+// Each character uses the current font if it has the character, or the
+// first font from SetFontFallbacks() that has it. Characters that are
+// in none of the fonts use the current font.
+func (p *PDF) fontRuns(s string) (runs []pdfFontRun) {
+	fonts := []pdfFont{*p.font}
+	for _, name := range p.fontFallback {
+		font, valid := p.loadFont(p.fontFace(name))
+		if valid && font.name != p.font.name {
+			fonts = append(fonts, font)
+		}
+	}
+	start, last := 0, 0
+	for i, r := range s {
+		n := 0
+		for j, font := range fonts {
+			if p.hasGlyph(font, r) {
+				n = j
+				break
+			}
+		}
+		if i > 0 && n != last {
+			runs = append(runs, pdfFontRun{font: fonts[last], text: s[start:i]})
+			start = i
+		}
+		last = n
+	}
+	return append(runs, pdfFontRun{font: fonts[last], text: s[start:]})
+} //                                                                    fontRuns
+
+// fontWidthPt returns the width of text in points, using only the font
+// last set by applyFont(), without fonts from SetFontFallbacks().
+// The width includes character spacing and word spacing.
+func (p *PDF) fontWidthPt(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	spacing := (p.charSpacing*float64(len([]rune(s))) +
+		p.wordSpacing*float64(strings.Count(s, " "))) *
+		float64(p.horzScaling) / 100.0
+	if p.font != nil && p.font.handler != nil {
+		return p.font.handler.textWidthPt(s) + spacing
+	}
+	w, prev := 0.0, rune(-1)
+	for i, r := range s {
+		id := p.font.builtInIndex
+		width, ok := p.builtInWidth(id, r)
+		if !ok && (id == 4 || id == 9) {
+			p.putError(0xE5A9C3, "Character not in font",
+				fmt.Sprintf("at %d = '%s' (U+%04X) in %s",
+					i, string(r), r, p.font.name))
+			break
+		}
+		if !ok {
+			p.putError(0xE31046, "Rune out of range",
+				fmt.Sprintf("at %d = '%s'", i, string(r)))
+			break
+		}
+		w += float64(width + p.kerning(prev, r))
+		prev = r
+	}
+	return w*p.fontSizePt/1000.0*float64(p.horzScaling)/100.0 + spacing
+} //                                                                 fontWidthPt
+
+// hasGlyph returns true if 'font' can draw the character 'r'
+func (p *PDF) hasGlyph(font pdfFont, r rune) bool {
+	if font.handler != nil {
+		return font.handler.hasGlyph(r)
+	}
+	_, ok := p.builtInWidth(font.builtInIndex, r)
+	return ok
+} //                                                                    hasGlyph
+
+// hyphenateLine returns the number of bytes from the start of 'line' to
+// write on a line of 'width' (in current units) followed by a hyphen,
+// by splitting the word at line[start:] at the last hyphenation point
+// where it fits. Returns 0 if hyphenation is off, or no point fits.
+func (p *PDF) hyphenateLine(line string, start int, width float64) int {
+	hyphenate := p.hyphenators[p.hyphenation]
+	if hyphenate == nil {
+		return 0
+	}
+	// hyphenate the letters of the word, without punctuation around them
+	word := line[start:]
+	if i := strings.IndexFunc(word, unicode.IsSpace); i != -1 {
+		word = word[:i]
+	}
+	begin := strings.IndexFunc(word, unicode.IsLetter)
+	if begin == -1 {
+		return 0
+	}
+	letters := word[begin:]
+	if i := strings.IndexFunc(letters, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}); i != -1 {
+		letters = letters[:i]
+	}
+	points := hyphenate(letters)
+	for i := len(points) - 1; i >= 0; i-- {
+		n := start + begin + len(string([]rune(letters)[:points[i]]))
+		if p.TextWidth(p.softHyphens(line[:n])+"-") <= width {
+			return n
+		}
+	}
+	return 0
+} //                                                               hyphenateLine
+
 // init initializes the PDF object, if not initialized already
 func (p *PDF) init() *PDF {
 	if p.isInit {
@@ -1125,6 +2209,113 @@ func (p *PDF) init() *PDF {
 	return p
 } //                                                                        init
 
+// kernText returns text in a built-in font with the operator to show it,
+// encoded with the font's encoding (see builtInCode()):
+// (text) Tj, or [(text) n (text)] TJ if any characters are kerned, where
+// each number n moves the following text left by n thousandths of a point
+// per point of height
+func (p *PDF) kernText(s string) string {
+	var (
+		buf   bytes.Buffer
+		start = 0
+		prev  = rune(-1)
+		font  = p.font
+	)
+	for i, r := range s {
+		if kern := p.kerning(prev, r); kern != 0 {
+			buf.WriteString("(" + p.escape(p.builtInText(font, s[start:i])) +
+				") " + strconv.Itoa(-kern) + " ")
+			start = i
+		}
+		prev = r
+	}
+	if start == 0 {
+		return "(" + p.escape(p.builtInText(font, s)) + ") Tj" // Tj: show text
+	}
+	return "[" + buf.String() + "(" +
+		p.escape(p.builtInText(font, s[start:])) + ")] TJ"
+	// TJ: show text, adjusting the position of each string
+} //                                                                    kernText
+
+// kerning returns the adjustment of the space between characters 'left'
+// and 'right' in the current built-in font, in thousandths of a point
+// per point of height (negative values move characters closer)
+func (p *PDF) kerning(left, right rune) int {
+	id := p.font.builtInIndex
+	if id < 0 || id >= len(pdfFontKerning) || left < 0 {
+		return 0
+	}
+	kern := pdfFontKerning[id][string([]rune{left, right})]
+	if kern != 0 && p.fontFeature("kern") == 0 {
+		return 0 // kerning is turned off by SetFontFeatures()
+	}
+	return kern
+} //                                                                     kerning
+
+// lineHeightPt returns the current line height in points, for the
+// current font size or font size 'optSizePt' (in points)
+func (p *PDF) lineHeightPt(optSizePt ...float64) float64 {
+	sizePt := p.fontSizePt
+	if len(optSizePt) > 0 {
+		sizePt = optSizePt[0]
+	}
+	switch {
+	case p.lineHeight > 0:
+		return p.lineHeight
+	case p.lineSpacing > 0:
+		return p.lineSpacing * sizePt
+	}
+	return sizePt
+} //                                                                lineHeightPt
+
+// loadFont returns the font named 'fontName', which can be a registered
+// font, a built-in font, or a font file that is read once and cached.
+// If the font has been used in the document, returns it with its ID.
+func (p *PDF) loadFont(fontName string) (font pdfFont, valid bool) {
+	name := p.toUpperLettersDigits(fontName, "")
+	if it, found := p.fontAliases[name]; found { // registered font
+		font, valid = it, true
+	} else if it, found := p.fontFiles[fontName]; found {
+		font, valid = it, true
+	} else if name != "" {
+		for i, fname := range pdfFontNames {
+			fname = p.toUpperLettersDigits(fname, "")
+			if fname != name {
+				continue
+			}
+			has := strings.Contains
+			font = pdfFont{
+				name:         pdfFontNames[i],
+				builtInIndex: i,
+				isBold:       has(fname, "BOLD"),
+				isItalic:     has(fname, "OBLIQUE") || has(fname, "ITALIC"),
+			}
+			valid = true
+			break
+		}
+		if !valid {
+			font.handler = p.newFontHandler(fontName)
+		}
+		if font.handler != nil {
+			font.name = fontName
+			valid = font.handler.readFont(p, fontName)
+			if valid { // don't parse the same font file again
+				if p.fontFiles == nil {
+					p.fontFiles = make(map[string]pdfFont)
+				}
+				p.fontFiles[fontName] = font
+			}
+		}
+	}
+	for _, it := range p.fonts {
+		if valid && it.name == font.name {
+			font.id = it.id
+			break
+		}
+	}
+	return font, valid
+} //                                                                    loadFont
+
 // loadImage reads an image from a file or byte array, stores its data in
 // the PDF's images array, and returns a pdfImage and its reference index
 func (p *PDF) loadImage(fileNameOrBytes interface{}, back color.RGBA,
@@ -1203,6 +2394,90 @@ func makeImage(source image.Image, back color.RGBA,
 	return widthPx, heightPx, isGray, ar
 } //                                                                   makeImage
 
+// parseRichText splits text with markup (see DrawRichTextInBox()) into
+// paragraphs of runs of text in the same style. Styles start from the
+// current font style, color and font size.
+func (p *PDF) parseRichText(markup string) (paras [][]pdfStyleRun) {
+	var (
+		bold, italic int
+		colors       = []color.RGBA{p.color}
+		sizes        = []float64{p.fontSizePt}
+		para         []pdfStyleRun
+		entities     = strings.NewReplacer("&lt;", "<", "&gt;", ">",
+			"&amp;", "&")
+	)
+	add := func(s string) { // adds text in the current style
+		if s == "" {
+			return
+		}
+		for i, text := range p.splitLines(entities.Replace(s)) {
+			if i > 0 {
+				paras, para = append(paras, para), nil
+			}
+			run := pdfStyleRun{text: text, color: colors[len(colors)-1],
+				sizePt: sizes[len(sizes)-1]}
+			if bold > 0 || strings.Contains(p.fontStyle, "B") {
+				run.style = "B"
+			}
+			if italic > 0 || strings.Contains(p.fontStyle, "I") {
+				run.style += "I"
+			}
+			if text != "" {
+				para = p.appendRun(para, run)
+			}
+		}
+	}
+	for s := markup; s != ""; {
+		i := strings.IndexByte(s, '<')
+		j := strings.IndexByte(s[i+1:], '>') + i + 1
+		if i == -1 || j == i {
+			add(s)
+			break
+		}
+		add(s[:i])
+		tag, known := strings.ToLower(s[i+1:j]), true
+		switch {
+		case tag == "b":
+			bold++
+		case tag == "/b" && bold > 0:
+			bold--
+		case tag == "i":
+			italic++
+		case tag == "/i" && italic > 0:
+			italic--
+		case strings.HasPrefix(tag, "color="):
+			val := s[i+7 : j]
+			color, err := p.ToColor(val)
+			if err, isT := err.(pdfError); isT {
+				p.putError(0xE6B3D9, err.msg, val)
+			}
+			colors = append(colors, color)
+		case tag == "/color" && len(colors) > 1:
+			colors = colors[:len(colors)-1]
+		case strings.HasPrefix(tag, "size="):
+			val := s[i+6 : j]
+			size, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+			if err != nil || size <= 0 {
+				p.putError(0xE2F8C6, "Invalid font size", val)
+				size = sizes[len(sizes)-1]
+			}
+			sizes = append(sizes, size)
+		case tag == "/size" && len(sizes) > 1:
+			sizes = sizes[:len(sizes)-1]
+		default:
+			known = tag == "/b" || tag == "/i" || tag == "/color" ||
+				tag == "/size" // unmatched closing tags are ignored
+		}
+		if !known {
+			add("<")
+			s = s[i+1:]
+			continue
+		}
+		s = s[j+1:]
+	}
+	return append(paras, para)
+} //                                                               parseRichText
+
 // reservePage ensures there is at least one page in the PDF
 func (p *PDF) reservePage() *PDF {
 	if len(p.pages) == 0 {
@@ -1211,43 +2486,293 @@ func (p *PDF) reservePage() *PDF {
 	return p
 } //                                                                 reservePage
 
-// textWidthPt returns the width of text in points
+// restoreState writes 'Q' to restore the graphics state saved by 'q',
+// e.g. to end clipping. The state saved by 'q' is not known here, so
+// the page's state is reset, to be written again when used.
+func (p *PDF) restoreState() *PDF {
+	COLOR := color.RGBA{1, 0, 1, 0x01} // unlikely default color
+	pg := p.page
+	pg.lineWidth, pg.fontSizePt, pg.fontID = -1, 0, -1
+	pg.strokeColor, pg.nonStrokeColor = COLOR, COLOR
+	pg.horzScaling, pg.renderMode, pg.isClipping = 0, -1, false
+	pg.charSpacing, pg.wordSpacing = -1e9, -1e9 // unlikely values
+	//
+	// Q: restore graphics state
+	return p.write("Q\n")
+} //                                                                restoreState
+
+// selectFont makes the font with ID 'id' the current font, and writes
+// a font change command if the page's font or font size has changed
+func (p *PDF) selectFont(id int) *PDF {
+	p.font = &p.fonts[id-1]
+	if p.page.fontID == id &&
+		int(p.page.fontSizePt*100) == int(p.fontSizePt)*100 {
+		return p
+	}
+	// add the font ID to the current page, if not already referenced
+	var alreadyUsedOnPage bool
+	for _, it := range p.page.fontIDs {
+		if it == id {
+			alreadyUsedOnPage = true
+			break
+		}
+	}
+	if !alreadyUsedOnPage {
+		p.page.fontIDs = append(p.page.fontIDs, 0)
+		p.page.fontIDs[len(p.page.fontIDs)-1] = id
+	}
+	p.page.fontID = id
+	p.page.fontSizePt = p.fontSizePt
+	p.write("BT /FNT", p.page.fontID, " ", int(p.page.fontSizePt),
+		" Tf ET\n")
+	// BT: begin text  /FNT0 i0 Tf: set font to FNT0 index i0  ET: end text
+	return p
+} //                                                                  selectFont
+
+// setStyle changes the font style, color and font size to those of 'run',
+// and loads its font to measure text. (drawTextLine() applies the font)
+func (p *PDF) setStyle(run pdfStyleRun) {
+	p.fontStyle, p.color, p.fontSizePt = run.style, run.color, run.sizePt
+	if font, valid := p.loadFont(p.fontFace()); valid {
+		p.font = &font
+	}
+} //                                                                    setStyle
+
+// textWidthPt returns the width of text in points. Characters that
+// are not in the current font are measured in fallback fonts.
 func (p *PDF) textWidthPt(s string) float64 {
-	if s == "" {
-		return 0
+	if len(p.fontFallback) == 0 || p.font == nil || s == "" {
+		return p.fontWidthPt(s)
 	}
-	if p.font != nil && p.font.handler != nil {
-		return p.font.handler.textWidthPt(s)
+	var (
+		w    float64
+		font = p.font
+	)
+	for _, run := range p.fontRuns(s) {
+		p.font = &run.font
+		w += p.fontWidthPt(run.text)
 	}
-	w := 0.0
-	for i, r := range s {
-		if r < 0 || r > 255 {
-			p.putError(0xE31046, "Rune out of range",
-				fmt.Sprintf("at %d = '%s'", i, string(r)))
-			break
+	p.font = font
+	return w
+} //                                                                 textWidthPt
+
+// wrapRuns splits a paragraph of styled runs into lines that fit in
+// 'widthPt'. Lines are broken where WrapTextLines() breaks them, and
+// words that are too wide for a line are broken between characters.
+func (p *PDF) wrapRuns(widthPt float64, runs []pdfStyleRun,
+) (ret [][]pdfStyleRun) {
+	var (
+		words     [][]pdfStyleRun // text between line break opportunities
+		line      []pdfStyleRun
+		lineWidth float64
+		newWord   = true
+	)
+	text := ""
+	for _, run := range runs {
+		text += run.text
+	}
+	// a word can have several styles. 'at' is the offset of 's' in 'text'
+	breaks, at := p.lineBreaks(text), 0
+	for _, run := range runs {
+		for s := run.text; s != ""; {
+			for len(breaks) > 0 && breaks[0] <= at {
+				breaks = breaks[1:]
+			}
+			n := len(s)
+			if len(breaks) > 0 && breaks[0]-at < n {
+				n = breaks[0] - at
+			}
+			if newWord {
+				words = append(words, nil)
+			}
+			piece := run
+			piece.text, s = s[:n], s[n:]
+			words[len(words)-1] = append(words[len(words)-1], piece)
+			at += n
+			newWord = len(breaks) > 0 && breaks[0] == at
 		}
-		id := p.fonts[p.page.fontID-1].builtInIndex
-		if id >= 0 && id <= 9 {
-			w += float64(pdfFontWidths[r][id])
-		} else {
-			w += 600 // for Courier font
+	}
+	for _, word := range words {
+		widths, wordWidth := make([]float64, len(word)), 0.0
+		for i, run := range word {
+			p.setStyle(run)
+			widths[i] = p.textWidthPt(run.text)
+			wordWidth += widths[i]
+		}
+		// trailing spaces don't need to fit in the line
+		fitWidth := wordWidth - widths[len(word)-1] +
+			p.textWidthPt(strings.TrimRight(word[len(word)-1].text, " "))
+		if len(line) > 0 && lineWidth+fitWidth > widthPt {
+			ret, line, lineWidth = append(ret, line), nil, 0
+		}
+		if fitWidth <= widthPt {
+			for _, run := range word {
+				line = p.appendRun(line, run)
+			}
+			lineWidth += wordWidth
+			continue
+		}
+		for _, run := range word { // break a word too wide for a line
+			p.setStyle(run)
+			for _, r := range run.text {
+				char := run
+				char.text = string(r)
+				width := p.textWidthPt(char.text)
+				if len(line) > 0 && lineWidth+width > widthPt && r != ' ' {
+					ret, line, lineWidth = append(ret, line), nil, 0
+				}
+				line, lineWidth = p.appendRun(line, char), lineWidth+width
+			}
 		}
 	}
-	return w * p.fontSizePt / 1000.0 * float64(p.horzScaling) / 100.0
-} //                                                                 textWidthPt
+	return append(ret, line)
+} //                                                                    wrapRuns
+
+// wrapTotalFit splits 'text' into lines that fit in 'width' (in current
+// units) like WrapTextLines(), but chooses the breaks of each paragraph
+// with the Knuth-Plass total-fit algorithm: the breaks that minimize the
+// sum of the squared badness of all lines, with penalties for hyphens.
+// This makes the lines of the paragraph evenly full, instead of filling
+// each line in turn. If 'justify' is true, lines stretch their spaces
+// by half, or shrink them by a third. Otherwise lines are ragged: their
+// badness comes from the space left on the right (100 for 3 ems).
+// A paragraph that can't be broken this way (e.g. because a word is
+// too wide) is wrapped by WrapTextLines().
+func (p *PDF) wrapTotalFit(width float64, text string, justify bool,
+) (ret []string) {
+	const (
+		linePenalty   = 10   // demerits added to every line
+		hyphenPenalty = 50   // penalty for breaking at a hyphenation point
+		doubleHyphen  = 3000 // demerits for two hyphenated lines in a row
+	)
+	space, em := p.TextWidth(" "), p.ToUnits(p.fontSizePt)
+	hyphenate := p.hyphenators[p.hyphenation]
+	for _, line := range p.splitLines(text) {
+		if p.TextWidth(strings.TrimRight(p.softHyphens(line), " ")) <= width {
+			ret = append(ret, strings.ReplaceAll(line, "\u00AD", ""))
+			continue
+		}
+		// collect break opportunities, noting hyphenation points
+		isHyphen := map[int]bool{}
+		for _, n := range p.lineBreaks(line) {
+			isHyphen[n] = false
+		}
+		if hyphenate != nil {
+			start := -1 // start of the current word's letters
+			for i, r := range line + " " {
+				if unicode.IsLetter(r) {
+					if start == -1 {
+						start = i
+					}
+					continue
+				}
+				if start == -1 {
+					continue
+				}
+				word := line[start:i]
+				for _, point := range hyphenate(word) {
+					n := start + len(string([]rune(word)[:point]))
+					if _, found := isHyphen[n]; !found {
+						isHyphen[n] = true
+					}
+				}
+				start = -1
+			}
+		}
+		breaks := []int{0, len(line)}
+		for n := range isHyphen {
+			breaks = append(breaks, n)
+		}
+		sort.Ints(breaks)
+		//
+		// find the least total demerits to reach each break
+		demerits, prev := make([]float64, len(breaks)), make([]int, len(breaks))
+		for i := range demerits {
+			demerits[i] = -1 // not reachable
+		}
+		demerits[0] = 0
+		for a := 0; a < len(breaks)-1; a++ {
+			if demerits[a] < 0 {
+				continue
+			}
+			for b := a + 1; b < len(breaks); b++ {
+				s := strings.TrimRight(
+					p.softHyphens(line[breaks[a]:breaks[b]]), " ")
+				if isHyphen[breaks[b]] {
+					s += "-"
+				}
+				w, isLast := p.TextWidth(s), b == len(breaks)-1
+				stretch := float64(strings.Count(s, " ")) * space
+				badness := 0.0
+				if w <= width && !isLast {
+					r := (width - w) / (3 * em)
+					if justify && stretch > 0 {
+						r = (width - w) / (stretch / 2)
+					} else if justify {
+						r = 100 // no spaces to stretch: as bad as it gets
+					}
+					badness = 100 * r * r * r
+				} else if w > width {
+					if !justify || isLast || w-stretch/3 > width {
+						break // longer lines won't fit either
+					}
+					r := (w - width) / (stretch / 3)
+					badness = 100 * r * r * r
+				}
+				sum := demerits[a] + (linePenalty+badness)*(linePenalty+badness)
+				if isHyphen[breaks[b]] {
+					sum += hyphenPenalty * hyphenPenalty
+					if isHyphen[breaks[a]] {
+						sum += doubleHyphen
+					}
+				}
+				if demerits[b] < 0 || sum < demerits[b] {
+					demerits[b], prev[b] = sum, a
+				}
+			}
+		}
+		last := len(breaks) - 1
+		if demerits[last] < 0 {
+			ret = append(ret, p.WrapTextLines(width, line)...)
+			continue
+		}
+		var lines []string
+		for b := last; b > 0; b = prev[b] {
+			s := p.softHyphens(line[breaks[prev[b]]:breaks[b]])
+			if isHyphen[breaks[b]] {
+				s += "-"
+			}
+			lines = append([]string{s}, lines...)
+		}
+		ret = append(ret, lines...)
+	}
+	return ret
+} //                                                                wrapTotalFit
 
 // -----------------------------------------------------------------------------
 // # Internal Generation Methods (p *PDF)
 
-// nextObj increases the object serial no. and stores its offset in array
-func (p *PDF) nextObj() int {
-	p.objIndex++
-	for len(p.objOffsets) <= p.objIndex {
-		p.objOffsets = append(p.objOffsets, p.content.Len())
+// nextObj increases the object serial no. and stores its offset in array.
+// To write an object numbered by reserveObj(), specify it in reservedNo.
+func (p *PDF) nextObj(reservedNo ...int) int {
+	n := p.objIndex + 1
+	if len(reservedNo) > 0 {
+		n = reservedNo[0]
+	} else {
+		p.objIndex = n
 	}
-	return p.objIndex
+	for len(p.objOffsets) <= n {
+		p.objOffsets = append(p.objOffsets, 0)
+	}
+	p.objOffsets[n] = p.content.Len()
+	return n
 } //                                                                     nextObj
 
+// reserveObj returns a new object number after the numbers of all the
+// objects that Bytes() writes in sequence. Font handlers use it for
+// objects written out of sequence (e.g. font descriptors and files).
+func (p *PDF) reserveObj() int { p.objCount++; return p.objCount }
+
 // write writes strings and numbers to the current page's content
 // stream or to the final generated PDF, if there is no active page
 func (p *PDF) write(a ...interface{}) *PDF {
@@ -1290,8 +2815,8 @@ func (p *PDF) writeMode(optFill ...bool) (mode string) {
 } //                                                                   writeMode
 
 // writeObj writes an object header. objType must start with '/', e.g. /Catalog
-func (p *PDF) writeObj(objType string) *PDF {
-	return p.write(p.nextObj(), " 0 obj <</Type", objType)
+func (p *PDF) writeObj(objType string, reservedNo ...int) *PDF {
+	return p.write(p.nextObj(reservedNo...), " 0 obj <</Type", objType)
 } //                                                                    writeObj
 
 // writePages writes all PDF pages
@@ -1341,8 +2866,13 @@ func (p *PDF) writePages(pagesIndex, fontsIndex, imagesIndex int) *PDF {
 		if len(pg.fontIDs) > 0 || len(pg.imageIDs) > 0 {
 			p.write(">> ")
 		}
-		p.write(">>\n" + "endobj\n\n")       // write page object
-		p.writeStreamObj(pg.content.Bytes()) // write page's stream
+		p.write(">>\n" + "endobj\n\n") // write page object
+		content := pg.content.Bytes()
+		if pg.isClipping {
+			// Q: restore graphics state saved by 'q' when clipping started
+			content = append(content[:len(content):len(content)], "Q\n"...)
+		}
+		p.writeStreamObj(content) // write page's stream
 	}
 	return p
 } //                                                                  writePages
@@ -1368,31 +2898,131 @@ func (p *PDF) writeStreamData(ar []byte) *PDF {
 } //                                                             writeStreamData
 
 // writeStreamObj outputs a stream object to the document's main buffer
-func (p *PDF) writeStreamObj(ar []byte) *PDF {
-	return p.write(p.nextObj(), " 0 obj <<").
+func (p *PDF) writeStreamObj(ar []byte, reservedNo ...int) *PDF {
+	return p.write(p.nextObj(reservedNo...), " 0 obj <<").
 		writeStreamData(ar).write("\n" + "endobj\n\n")
 } //                                                              writeStreamObj
 
 // -----------------------------------------------------------------------------
 // # Internal Functions (just attached to PDF, but not using it)
 
-// escape escapes special characters '(', '(' and '\' in strings
-// in order to avoid them interfering with PDF commands
+// appendRun appends 'run' to 'runs', or adds its text to the last run
+// if it has the same style
+func (*PDF) appendRun(runs []pdfStyleRun, run pdfStyleRun) []pdfStyleRun {
+	if n := len(runs); n > 0 {
+		last, next := runs[n-1], run
+		last.text, next.text = "", ""
+		if last == next {
+			runs[n-1].text += run.text
+			return runs
+		}
+	}
+	return append(runs, run)
+} //                                                                   appendRun
+
+// builtInCode returns the code of character 'r' in the encoding of the
+// built-in 'font': WinAnsiEncoding (Windows-1252) and the font's
+// /Differences, or the font's own encoding for Symbol and ZapfDingbats.
+// Returns false if the font's encoding has no code for the character.
+//
+// Symbol and ZapfDingbats translate Unicode characters like α or ✓ to
+// their codes, and U+F000 plus a code (0 to 255) selects a glyph by its
+// code. Other characters, including letters like 'a', have no code.
+func (*PDF) builtInCode(font *pdfFont, r rune) (code byte, ok bool) {
+	if id := font.builtInIndex; id == 4 || id == 9 { // Symbol, ZapfDingbats
+		chars := pdfSymbolChars
+		if id == 9 {
+			chars = pdfZapfDingbatsChars
+		}
+		for i, it := range chars {
+			if it == r && it != 0 {
+				return byte(32 + i), true
+			}
+		}
+		if r >= 0xF000 && r <= 0xF0FF {
+			return byte(r), true
+		}
+		return 0, false
+	}
+	if (r >= 0 && r < 128) || (r >= 160 && r <= 255) {
+		return byte(r), true // same as ISO-8859-1 and Unicode
+	}
+	if code, ok = pdfWinAnsiCodes[r]; ok {
+		return code, ok
+	}
+	for i, it := range font.differences {
+		if it == r {
+			return pdfDifferenceCodes[i], true
+		}
+	}
+	return 0, false
+} //                                                                 builtInCode
+
+// builtInText encodes 's' with the encoding of the built-in 'font',
+// leaving out characters that the encoding can't represent
+// (measuring text with textWidthPt() reports them).
+func (p *PDF) builtInText(font *pdfFont, s string) string {
+	buf := bytes.NewBuffer(make([]byte, 0, len(s)))
+	for _, r := range s {
+		if code, ok := p.builtInCode(font, r); ok {
+			buf.WriteByte(code)
+		}
+	}
+	return buf.String()
+} //                                                                 builtInText
+
+// builtInWidth returns the width of character 'r' in the built-in font
+// 'builtInIndex', in thousandths of a point per point of height.
+// Returns false if the font has no glyph for the character.
+func (p *PDF) builtInWidth(builtInIndex int, r rune) (width int, ok bool) {
+	id := builtInIndex
+	if code, ok := p.builtInCode(&pdfFont{builtInIndex: id}, r); ok {
+		if id < 0 || id > 9 {
+			return 600, true // for Courier font
+		}
+		return pdfFontWidths[code][id], true
+	}
+	glyph, found := pdfBuiltInGlyphs[r]
+	switch {
+	case !found || id == 4 || id == 9:
+		return 0, false
+	case id < 0 || id > 9:
+		return 600, true
+	case glyph.widths != nil:
+		return glyph.widths[id], true
+	}
+	return p.builtInWidth(id, glyph.like)
+} //                                                                builtInWidth
+
+// escape escapes special characters '(', ')', '\' and carriage returns
+// in strings in order to avoid them interfering with PDF commands
 func (*PDF) escape(s string) string {
-	has := strings.Contains
-	if !has(s, "(") && !has(s, ")") && !has(s, "\\") {
+	if !strings.ContainsAny(s, "()\\\r") {
 		return s
 	}
 	buf := bytes.NewBuffer(make([]byte, 0, len(s)))
-	for _, r := range s {
-		if r == '(' || r == ')' || r == '\\' {
-			buf.WriteRune('\\')
+	for i := 0; i < len(s); i++ { // bytes, not runes: 's' may be encoded
+		switch c := s[i]; c {
+		case '(', ')', '\\':
+			buf.WriteByte('\\')
+		case '\r': // readers would read an unescaped CR as a line feed
+			buf.WriteString("\\r")
+			continue
 		}
-		buf.WriteRune(r)
+		buf.WriteByte(s[i])
 	}
 	return buf.String()
 } //                                                                      escape
 
+// fontMetrics returns the vertical metrics of 'font',
+// in thousandths of the font size
+func (*PDF) fontMetrics(font *pdfFont) pdfFontMetrics {
+	if font.handler != nil {
+		return font.handler.fontMetrics()
+	}
+	return pdfBuiltInMetrics[font.builtInIndex]
+} //                                                                 fontMetrics
+
 // isWhiteSpace returns true if all the chars. in 's' are white-spaces
 func (*PDF) isWhiteSpace(s string) bool {
 	for _, r := range s {
@@ -1403,6 +3033,109 @@ func (*PDF) isWhiteSpace(s string) bool {
 	return len(s) > 0
 } //                                                                isWhiteSpace
 
+// lineBreaks returns the byte offsets in 's' where a line can be broken,
+// using the line breaking plugin. Without the plugin, lines can only be
+// broken after white-spaces.
+func (*PDF) lineBreaks(s string) (ret []int) {
+	if pdfLineBreaks != nil {
+		return pdfLineBreaks(s)
+	}
+	prev := 'x'
+	for i, r := range s {
+		if i > 0 && unicode.IsSpace(prev) && !unicode.IsSpace(r) {
+			ret = append(ret, i)
+		}
+		prev = r
+	}
+	return ret
+} //                                                                  lineBreaks
+
+// linesEnd returns the byte offset in 'para' after 'lines', which are
+// the first lines of 'para' wrapped by WrapTextLines(). The lines have
+// no soft hyphens, and can end with a hyphen that is not in 'para'.
+func (*PDF) linesEnd(para string, lines []string) int {
+	const softHyphen = "\u00AD"
+	at := 0
+	for _, line := range lines {
+		for _, r := range line {
+			for strings.HasPrefix(para[at:], softHyphen) {
+				at += len(softHyphen)
+			}
+			if strings.HasPrefix(para[at:], string(r)) {
+				at += len(string(r))
+			} // otherwise 'r' is a hyphen added at the end of the line
+		}
+	}
+	return at
+} //                                                                    linesEnd
+
+// newFontHandler returns a new handler to read 'font': a Type 1 font
+// handler for .pfb and .pfa file names or data, otherwise a TrueType
+// font handler. Returns nil if the handler's plugin is not available.
+func (*PDF) newFontHandler(font interface{}) pdfFontHandler {
+	isType1 := false
+	switch arg := font.(type) {
+	case string:
+		ext := strings.ToLower(arg[strings.LastIndex(arg, ".")+1:])
+		isType1 = ext == "pfb" || ext == "pfa"
+	case []byte:
+		isType1 = bytes.HasPrefix(arg, []byte{0x80, 0x01}) ||
+			bytes.HasPrefix(arg, []byte("%!PS-AdobeFont")) ||
+			bytes.HasPrefix(arg, []byte("%!FontType1"))
+	}
+	if isType1 && pdfNewType1FontHandler != nil {
+		return pdfNewType1FontHandler()
+	} else if !isType1 && pdfNewFontHandler != nil {
+		return pdfNewFontHandler()
+	}
+	return nil
+} //                                                              newFontHandler
+
+// parseFeatures parses OpenType feature settings, e.g. "smcp -liga salt=2"
+// and returns the value of each feature: 0 if it is off, 1 if it is on,
+// or the number of an alternate glyph. Also returns the first invalid
+// setting, if any.
+func (*PDF) parseFeatures(s string) (ret map[string]int, invalid string) {
+	ret = make(map[string]int)
+	isSep := func(r rune) bool { return r == ',' || unicode.IsSpace(r) }
+	for _, it := range strings.FieldsFunc(s, isSep) {
+		tag, val := strings.TrimPrefix(it, "+"), 1
+		if strings.HasPrefix(tag, "-") {
+			tag, val = tag[1:], 0
+		} else if i := strings.Index(tag, "="); i != -1 {
+			n, err := strconv.Atoi(tag[i+1:])
+			if err != nil || n < 0 {
+				return ret, it
+			}
+			tag, val = tag[:i], n
+		}
+		valid := len(tag) == 4
+		for _, c := range tag {
+			valid = valid && c < 128 && (unicode.IsLetter(c) ||
+				unicode.IsDigit(c))
+		}
+		if !valid {
+			return ret, it
+		}
+		ret[tag] = val
+	}
+	return ret, ""
+} //                                                               parseFeatures
+
+// softHyphens returns 's' as it is shown at the end of a line: without
+// soft hyphens (U+00AD), but with a hyphen if the line ends with one
+func (*PDF) softHyphens(s string) string {
+	const softHyphen = "\u00AD"
+	if !strings.Contains(s, softHyphen) {
+		return s
+	}
+	ret := strings.ReplaceAll(s, softHyphen, "")
+	if strings.HasSuffix(s, softHyphen) {
+		ret += "-"
+	}
+	return ret
+} //                                                                 softHyphens
+
 // splitLines splits 's' into several lines using line breaks in 's'
 func (*PDF) splitLines(s string) []string {
 	split := func(lines []string, sep string) (ret []string) {
@@ -1697,6 +3430,184 @@ var PDFColorNames = map[string]color.RGBA{
 
 var pdfBlack = color.RGBA{A: 255}
 
+// pdfBuiltInGlyphs lists the characters of built-in fonts (except Symbol
+// and ZapfDingbats) that are not in WinAnsiEncoding, which are written
+// using the font's /Differences encoding. Each has the glyph's name and
+// a character of the same width, or the widths of the glyph in the fonts
+// with the same indexes as pdfFontWidths columns
+var pdfBuiltInGlyphs = map[rune]struct {
+	name   string
+	like   rune
+	widths []int
+}{
+	'Ā': {"Amacron", 'A', nil},
+	'ā': {"amacron", 'a', nil},
+	'Ă': {"Abreve", 'A', nil},
+	'ă': {"abreve", 'a', nil},
+	'Ą': {"Aogonek", 'A', nil},
+	'ą': {"aogonek", 'a', nil},
+	'Ć': {"Cacute", 'C', nil},
+	'ć': {"cacute", 'c', nil},
+	'Č': {"Ccaron", 'C', nil},
+	'č': {"ccaron", 'c', nil},
+	'Ď': {"Dcaron", 'D', nil},
+	'ď': {"dcaron", 0, []int{643, 743, 743, 643, 0, 672, 608, 544, 588}},
+	'Đ': {"Dcroat", 'Ð', nil},
+	'đ': {"dcroat", 'd', nil},
+	'Ē': {"Emacron", 'E', nil},
+	'ē': {"emacron", 'e', nil},
+	'Ė': {"Edotaccent", 'E', nil},
+	'ė': {"edotaccent", 'e', nil},
+	'Ę': {"Eogonek", 'E', nil},
+	'ę': {"eogonek", 'e', nil},
+	'Ě': {"Ecaron", 'E', nil},
+	'ě': {"ecaron", 'e', nil},
+	'Ğ': {"Gbreve", 'G', nil},
+	'ğ': {"gbreve", 'g', nil},
+	'Ģ': {"Gcommaaccent", 'G', nil},
+	'ģ': {"gcommaaccent", 'g', nil},
+	'Ī': {"Imacron", 'I', nil},
+	'ī': {"imacron", 'i', nil},
+	'Į': {"Iogonek", 'I', nil},
+	'į': {"iogonek", 'i', nil},
+	'İ': {"Idotaccent", 'I', nil},
+	'ı': {"dotlessi", 'i', nil},
+	'Ķ': {"Kcommaaccent", 'K', nil},
+	'ķ': {"kcommaaccent", 'k', nil},
+	'Ĺ': {"Lacute", 'L', nil},
+	'ĺ': {"lacute", 'l', nil},
+	'Ļ': {"Lcommaaccent", 'L', nil},
+	'ļ': {"lcommaaccent", 'l', nil},
+	'Ľ': {"Lcaron", 'L', nil},
+	'ľ': {"lcaron", 0, []int{299, 400, 400, 299, 0, 394, 382, 300, 344}},
+	'Ł': {"Lslash", 'L', nil},
+	'ł': {"lslash", 'l', nil},
+	'Ń': {"Nacute", 'N', nil},
+	'ń': {"nacute", 'n', nil},
+	'Ņ': {"Ncommaaccent", 'N', nil},
+	'ņ': {"ncommaaccent", 'n', nil},
+	'Ň': {"Ncaron", 'N', nil},
+	'ň': {"ncaron", 'n', nil},
+	'Ō': {"Omacron", 'O', nil},
+	'ō': {"omacron", 'o', nil},
+	'Ő': {"Ohungarumlaut", 'O', nil},
+	'ő': {"ohungarumlaut", 'o', nil},
+	'Ŕ': {"Racute", 'R', nil},
+	'ŕ': {"racute", 'r', nil},
+	'Ŗ': {"Rcommaaccent", 'R', nil},
+	'ŗ': {"rcommaaccent", 'r', nil},
+	'Ř': {"Rcaron", 'R', nil},
+	'ř': {"rcaron", 'r', nil},
+	'Ś': {"Sacute", 'S', nil},
+	'ś': {"sacute", 's', nil},
+	'Ş': {"Scedilla", 'S', nil},
+	'ş': {"scedilla", 's', nil},
+	'Ţ': {"Tcommaaccent", 'T', nil},
+	'ţ': {"tcommaaccent", 't', nil},
+	'Ť': {"Tcaron", 'T', nil},
+	'ť': {"tcaron", 0, []int{317, 389, 389, 317, 0, 416, 366, 300, 326}},
+	'Ū': {"Umacron", 'U', nil},
+	'ū': {"umacron", 'u', nil},
+	'Ů': {"Uring", 'U', nil},
+	'ů': {"uring", 'u', nil},
+	'Ű': {"Uhungarumlaut", 'U', nil},
+	'ű': {"uhungarumlaut", 'u', nil},
+	'Ų': {"Uogonek", 'U', nil},
+	'ų': {"uogonek", 'u', nil},
+	'Ź': {"Zacute", 'Z', nil},
+	'ź': {"zacute", 'z', nil},
+	'Ż': {"Zdotaccent", 'Z', nil},
+	'ż': {"zdotaccent", 'z', nil},
+	'Ș': {"Scommaaccent", 'S', nil},
+	'ș': {"scommaaccent", 's', nil},
+	'ˇ': {"caron", '´', nil},
+	'˘': {"breve", '´', nil},
+	'˙': {"dotaccent", '´', nil},
+	'˚': {"ring", '´', nil},
+	'˛': {"ogonek", '´', nil},
+	'˝': {"hungarumlaut", '´', nil},
+	'⁄': {"fraction", 0, []int{167, 167, 167, 167, 0, 167, 167, 167, 167}},
+	'∂': {"partialdiff", 0, []int{476, 494, 494, 476, 0, 494, 494, 476, 476}},
+	'∆': {"Delta", 0, []int{612, 612, 612, 612, 0, 612, 612, 612, 612}},
+	'∑': {"summation", 0, []int{600, 600, 600, 600, 0, 600, 600, 600, 600}},
+	'−': {"minus", '+', nil},
+	'√': {"radical", 0, []int{453, 549, 549, 453, 0, 549, 549, 453, 453}},
+	'≠': {"notequal", 0, []int{549, 549, 549, 549, 0, 549, 549, 549, 549}},
+	'≤': {"lessequal", 0, []int{549, 549, 549, 549, 0, 549, 549, 549, 549}},
+	'≥': {"greaterequal", 0, []int{549, 549, 549, 549, 0, 549, 549, 549, 549}},
+	'◊': {"lozenge", 0, []int{471, 494, 494, 471, 0, 494, 494, 471, 471}},
+	'ﬁ': {"fi", 0, []int{500, 611, 611, 500, 0, 556, 556, 500, 556}},
+	'ﬂ': {"fl", 0, []int{500, 611, 611, 500, 0, 556, 556, 500, 556}},
+} //                                                            pdfBuiltInGlyphs
+
+// pdfBuiltInMetrics specifies the vertical metrics of built-in fonts, by
+// builtInIndex: ascent, descent, leading, cap height, x-height and
+// underline position. From Adobe's AFM files; the leading is the height
+// of the font's bounding box. Symbol and ZapfDingbats have no ascender
+// and descender, so the bounding box is used, and their cap height and
+// x-height are the heights of 'A' and 'α', and a typical dingbat.
+var pdfBuiltInMetrics = []pdfFontMetrics{
+	{718, -207, 1156, 718, 523, -100},  // 0 Helvetica
+	{718, -207, 1190, 718, 532, -100},  // 1 Helvetica-Bold
+	{718, -207, 1190, 718, 532, -100},  // 2 Helvetica-BoldOblique
+	{718, -207, 1156, 718, 523, -100},  // 3 Helvetica-Oblique
+	{1010, -293, 1303, 673, 500, -100}, // 4 Symbol
+	{683, -217, 1153, 676, 461, -100},  // 5 Times-Bold
+	{683, -217, 1139, 669, 462, -100},  // 6 Times-BoldItalic
+	{683, -217, 1100, 653, 441, -100},  // 7 Times-Italic
+	{683, -217, 1116, 662, 450, -100},  // 8 Times-Roman
+	{820, -143, 963, 700, 0, -100},     // 9 ZapfDingbats
+	{629, -157, 1055, 562, 426, -100},  // 10 Courier
+	{629, -157, 1051, 562, 439, -100},  // 11 Courier-Bold
+	{629, -157, 1051, 562, 439, -100},  // 12 Courier-BoldOblique
+	{629, -157, 1055, 562, 426, -100},  // 13 Courier-Oblique
+} //                                                           pdfBuiltInMetrics
+
+// pdfDifferenceCodes are the codes that WinAnsiEncoding doesn't use,
+// which /Differences encodings of built-in fonts assign to other glyphs
+var pdfDifferenceCodes = []byte{
+	1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+	21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 127, 129, 141, 143, 144, 157,
+} //                                                          pdfDifferenceCodes
+
+// pdfFontFamilies maps the names of built-in font families to their
+// regular, bold, italic and bold-italic fonts, for SetFontStyle()
+var pdfFontFamilies = map[string][4]string{
+	"COURIER": {
+		"Courier", "Courier-Bold", "Courier-Oblique", "Courier-BoldOblique",
+	},
+	"HELVETICA": {
+		"Helvetica", "Helvetica-Bold", "Helvetica-Oblique",
+		"Helvetica-BoldOblique",
+	},
+	"TIMES": {
+		"Times-Roman", "Times-Bold", "Times-Italic", "Times-BoldItalic",
+	},
+	"TIMESROMAN": {
+		"Times-Roman", "Times-Bold", "Times-Italic", "Times-BoldItalic",
+	},
+} //                                                             pdfFontFamilies
+
+// pdfFontKerning specifies kerning of built-in fonts, by builtInIndex.
+// Each key is a pair of characters, and each value adjusts the space
+// between them, in thousandths of a point per point of height.
+// The tables are generated from the KPX pairs of Adobe's AFM files by
+// kerngen (see the go:generate line above pdfKernHelvetica). Oblique
+// fonts are kerned like upright ones. Courier, Symbol and ZapfDingbats
+// have no kerning.
+var pdfFontKerning = []map[string]int{
+	pdfKernHelvetica,       // 0 Helvetica
+	pdfKernHelveticaBold,   // 1 Helvetica-Bold
+	pdfKernHelveticaBold,   // 2 Helvetica-BoldOblique
+	pdfKernHelvetica,       // 3 Helvetica-Oblique
+	nil,                    // 4 Symbol
+	pdfKernTimesBold,       // 5 Times-Bold
+	pdfKernTimesBoldItalic, // 6 Times-BoldItalic
+	pdfKernTimesItalic,     // 7 Times-Italic
+	pdfKernTimesRoman,      // 8 Times-Roman
+	nil,                    // 9 ZapfDingbats
+} //                                                              pdfFontKerning
+
 // pdfFontNames contains font names available on all PDF implementations
 var pdfFontNames = []string{
 	"Helvetica", "Helvetica-Bold", // 0 1
@@ -1969,6 +3880,2702 @@ var pdfFontWidths = [][]int{
 	{500, 556, 556, 500, 000, 500, 444, 444, 500, 000},         // 255
 } //                                                               pdfFontWidths
 
+//go:generate go run ./kerngen -afm $AFM_DIR
+
+// pdfKernHelvetica is the kerning of Helvetica and Helvetica-Oblique,
+// from Helvetica.afm version 002.000 (UniqueID 43054)
+var pdfKernHelvetica = map[string]int{
+	"AC": -30, "AĆ": -30, "AČ": -30, "AÇ": -30, "AG": -30, "AĞ": -30,
+	"AĢ": -30, "AO": -30, "AÓ": -30, "AÔ": -30, "AÖ": -30, "AÒ": -30,
+	"AŐ": -30, "AŌ": -30, "AØ": -30, "AÕ": -30, "AQ": -30, "AT": -120,
+	"AŤ": -120, "AŢ": -120, "AU": -50, "AÚ": -50, "AÛ": -50, "AÜ": -50,
+	"AÙ": -50, "AŰ": -50, "AŪ": -50, "AŲ": -50, "AŮ": -50, "AV": -70,
+	"AW": -50, "AY": -100, "AÝ": -100, "AŸ": -100, "Au": -30, "Aú": -30,
+	"Aû": -30, "Aü": -30, "Aù": -30, "Aű": -30, "Aū": -30, "Aų": -30,
+	"Aů": -30, "Av": -40, "Aw": -40, "Ay": -40, "Aý": -40, "Aÿ": -40,
+	"ÁC": -30, "ÁĆ": -30, "ÁČ": -30, "ÁÇ": -30, "ÁG": -30, "ÁĞ": -30,
+	"ÁĢ": -30, "ÁO": -30, "ÁÓ": -30, "ÁÔ": -30, "ÁÖ": -30, "ÁÒ": -30,
+	"ÁŐ": -30, "ÁŌ": -30, "ÁØ": -30, "ÁÕ": -30, "ÁQ": -30, "ÁT": -120,
+	"ÁŤ": -120, "ÁŢ": -120, "ÁU": -50, "ÁÚ": -50, "ÁÛ": -50, "ÁÜ": -50,
+	"ÁÙ": -50, "ÁŰ": -50, "ÁŪ": -50, "ÁŲ": -50, "ÁŮ": -50, "ÁV": -70,
+	"ÁW": -50, "ÁY": -100, "ÁÝ": -100, "ÁŸ": -100, "Áu": -30, "Áú": -30,
+	"Áû": -30, "Áü": -30, "Áù": -30, "Áű": -30, "Áū": -30, "Áų": -30,
+	"Áů": -30, "Áv": -40, "Áw": -40, "Áy": -40, "Áý": -40, "Áÿ": -40,
+	"ĂC": -30, "ĂĆ": -30, "ĂČ": -30, "ĂÇ": -30, "ĂG": -30, "ĂĞ": -30,
+	"ĂĢ": -30, "ĂO": -30, "ĂÓ": -30, "ĂÔ": -30, "ĂÖ": -30, "ĂÒ": -30,
+	"ĂŐ": -30, "ĂŌ": -30, "ĂØ": -30, "ĂÕ": -30, "ĂQ": -30, "ĂT": -120,
+	"ĂŤ": -120, "ĂŢ": -120, "ĂU": -50, "ĂÚ": -50, "ĂÛ": -50, "ĂÜ": -50,
+	"ĂÙ": -50, "ĂŰ": -50, "ĂŪ": -50, "ĂŲ": -50, "ĂŮ": -50, "ĂV": -70,
+	"ĂW": -50, "ĂY": -100, "ĂÝ": -100, "ĂŸ": -100, "Ău": -30, "Ăú": -30,
+	"Ăû": -30, "Ăü": -30, "Ăù": -30, "Ăű": -30, "Ăū": -30, "Ăų": -30,
+	"Ăů": -30, "Ăv": -40, "Ăw": -40, "Ăy": -40, "Ăý": -40, "Ăÿ": -40,
+	"ÂC": -30, "ÂĆ": -30, "ÂČ": -30, "ÂÇ": -30, "ÂG": -30, "ÂĞ": -30,
+	"ÂĢ": -30, "ÂO": -30, "ÂÓ": -30, "ÂÔ": -30, "ÂÖ": -30, "ÂÒ": -30,
+	"ÂŐ": -30, "ÂŌ": -30, "ÂØ": -30, "ÂÕ": -30, "ÂQ": -30, "ÂT": -120,
+	"ÂŤ": -120, "ÂŢ": -120, "ÂU": -50, "ÂÚ": -50, "ÂÛ": -50, "ÂÜ": -50,
+	"ÂÙ": -50, "ÂŰ": -50, "ÂŪ": -50, "ÂŲ": -50, "ÂŮ": -50, "ÂV": -70,
+	"ÂW": -50, "ÂY": -100, "ÂÝ": -100, "ÂŸ": -100, "Âu": -30, "Âú": -30,
+	"Âû": -30, "Âü": -30, "Âù": -30, "Âű": -30, "Âū": -30, "Âų": -30,
+	"Âů": -30, "Âv": -40, "Âw": -40, "Ây": -40, "Âý": -40, "Âÿ": -40,
+	"ÄC": -30, "ÄĆ": -30, "ÄČ": -30, "ÄÇ": -30, "ÄG": -30, "ÄĞ": -30,
+	"ÄĢ": -30, "ÄO": -30, "ÄÓ": -30, "ÄÔ": -30, "ÄÖ": -30, "ÄÒ": -30,
+	"ÄŐ": -30, "ÄŌ": -30, "ÄØ": -30, "ÄÕ": -30, "ÄQ": -30, "ÄT": -120,
+	"ÄŤ": -120, "ÄŢ": -120, "ÄU": -50, "ÄÚ": -50, "ÄÛ": -50, "ÄÜ": -50,
+	"ÄÙ": -50, "ÄŰ": -50, "ÄŪ": -50, "ÄŲ": -50, "ÄŮ": -50, "ÄV": -70,
+	"ÄW": -50, "ÄY": -100, "ÄÝ": -100, "ÄŸ": -100, "Äu": -30, "Äú": -30,
+	"Äû": -30, "Äü": -30, "Äù": -30, "Äű": -30, "Äū": -30, "Äų": -30,
+	"Äů": -30, "Äv": -40, "Äw": -40, "Äy": -40, "Äý": -40, "Äÿ": -40,
+	"ÀC": -30, "ÀĆ": -30, "ÀČ": -30, "ÀÇ": -30, "ÀG": -30, "ÀĞ": -30,
+	"ÀĢ": -30, "ÀO": -30, "ÀÓ": -30, "ÀÔ": -30, "ÀÖ": -30, "ÀÒ": -30,
+	"ÀŐ": -30, "ÀŌ": -30, "ÀØ": -30, "ÀÕ": -30, "ÀQ": -30, "ÀT": -120,
+	"ÀŤ": -120, "ÀŢ": -120, "ÀU": -50, "ÀÚ": -50, "ÀÛ": -50, "ÀÜ": -50,
+	"ÀÙ": -50, "ÀŰ": -50, "ÀŪ": -50, "ÀŲ": -50, "ÀŮ": -50, "ÀV": -70,
+	"ÀW": -50, "ÀY": -100, "ÀÝ": -100, "ÀŸ": -100, "Àu": -30, "Àú": -30,
+	"Àû": -30, "Àü": -30, "Àù": -30, "Àű": -30, "Àū": -30, "Àų": -30,
+	"Àů": -30, "Àv": -40, "Àw": -40, "Ày": -40, "Àý": -40, "Àÿ": -40,
+	"ĀC": -30, "ĀĆ": -30, "ĀČ": -30, "ĀÇ": -30, "ĀG": -30, "ĀĞ": -30,
+	"ĀĢ": -30, "ĀO": -30, "ĀÓ": -30, "ĀÔ": -30, "ĀÖ": -30, "ĀÒ": -30,
+	"ĀŐ": -30, "ĀŌ": -30, "ĀØ": -30, "ĀÕ": -30, "ĀQ": -30, "ĀT": -120,
+	"ĀŤ": -120, "ĀŢ": -120, "ĀU": -50, "ĀÚ": -50, "ĀÛ": -50, "ĀÜ": -50,
+	"ĀÙ": -50, "ĀŰ": -50, "ĀŪ": -50, "ĀŲ": -50, "ĀŮ": -50, "ĀV": -70,
+	"ĀW": -50, "ĀY": -100, "ĀÝ": -100, "ĀŸ": -100, "Āu": -30, "Āú": -30,
+	"Āû": -30, "Āü": -30, "Āù": -30, "Āű": -30, "Āū": -30, "Āų": -30,
+	"Āů": -30, "Āv": -40, "Āw": -40, "Āy": -40, "Āý": -40, "Āÿ": -40,
+	"ĄC": -30, "ĄĆ": -30, "ĄČ": -30, "ĄÇ": -30, "ĄG": -30, "ĄĞ": -30,
+	"ĄĢ": -30, "ĄO": -30, "ĄÓ": -30, "ĄÔ": -30, "ĄÖ": -30, "ĄÒ": -30,
+	"ĄŐ": -30, "ĄŌ": -30, "ĄØ": -30, "ĄÕ": -30, "ĄQ": -30, "ĄT": -120,
+	"ĄŤ": -120, "ĄŢ": -120, "ĄU": -50, "ĄÚ": -50, "ĄÛ": -50, "ĄÜ": -50,
+	"ĄÙ": -50, "ĄŰ": -50, "ĄŪ": -50, "ĄŲ": -50, "ĄŮ": -50, "ĄV": -70,
+	"ĄW": -50, "ĄY": -100, "ĄÝ": -100, "ĄŸ": -100, "Ąu": -30, "Ąú": -30,
+	"Ąû": -30, "Ąü": -30, "Ąù": -30, "Ąű": -30, "Ąū": -30, "Ąų": -30,
+	"Ąů": -30, "Ąv": -40, "Ąw": -40, "Ąy": -40, "Ąý": -40, "Ąÿ": -40,
+	"ÅC": -30, "ÅĆ": -30, "ÅČ": -30, "ÅÇ": -30, "ÅG": -30, "ÅĞ": -30,
+	"ÅĢ": -30, "ÅO": -30, "ÅÓ": -30, "ÅÔ": -30, "ÅÖ": -30, "ÅÒ": -30,
+	"ÅŐ": -30, "ÅŌ": -30, "ÅØ": -30, "ÅÕ": -30, "ÅQ": -30, "ÅT": -120,
+	"ÅŤ": -120, "ÅŢ": -120, "ÅU": -50, "ÅÚ": -50, "ÅÛ": -50, "ÅÜ": -50,
+	"ÅÙ": -50, "ÅŰ": -50, "ÅŪ": -50, "ÅŲ": -50, "ÅŮ": -50, "ÅV": -70,
+	"ÅW": -50, "ÅY": -100, "ÅÝ": -100, "ÅŸ": -100, "Åu": -30, "Åú": -30,
+	"Åû": -30, "Åü": -30, "Åù": -30, "Åű": -30, "Åū": -30, "Åų": -30,
+	"Åů": -30, "Åv": -40, "Åw": -40, "Åy": -40, "Åý": -40, "Åÿ": -40,
+	"ÃC": -30, "ÃĆ": -30, "ÃČ": -30, "ÃÇ": -30, "ÃG": -30, "ÃĞ": -30,
+	"ÃĢ": -30, "ÃO": -30, "ÃÓ": -30, "ÃÔ": -30, "ÃÖ": -30, "ÃÒ": -30,
+	"ÃŐ": -30, "ÃŌ": -30, "ÃØ": -30, "ÃÕ": -30, "ÃQ": -30, "ÃT": -120,
+	"ÃŤ": -120, "ÃŢ": -120, "ÃU": -50, "ÃÚ": -50, "ÃÛ": -50, "ÃÜ": -50,
+	"ÃÙ": -50, "ÃŰ": -50, "ÃŪ": -50, "ÃŲ": -50, "ÃŮ": -50, "ÃV": -70,
+	"ÃW": -50, "ÃY": -100, "ÃÝ": -100, "ÃŸ": -100, "Ãu": -30, "Ãú": -30,
+	"Ãû": -30, "Ãü": -30, "Ãù": -30, "Ãű": -30, "Ãū": -30, "Ãų": -30,
+	"Ãů": -30, "Ãv": -40, "Ãw": -40, "Ãy": -40, "Ãý": -40, "Ãÿ": -40,
+	"BU": -10, "BÚ": -10, "BÛ": -10, "BÜ": -10, "BÙ": -10, "BŰ": -10,
+	"BŪ": -10, "BŲ": -10, "BŮ": -10, "B,": -20, "B.": -20,
+	"C,": -30, "C.": -30,
+	"Ć,": -30, "Ć.": -30,
+	"Č,": -30, "Č.": -30,
+	"Ç,": -30, "Ç.": -30,
+	"DA": -40, "DÁ": -40, "DĂ": -40, "DÂ": -40, "DÄ": -40, "DÀ": -40,
+	"DĀ": -40, "DĄ": -40, "DÅ": -40, "DÃ": -40, "DV": -70, "DW": -40,
+	"DY": -90, "DÝ": -90, "DŸ": -90, "D,": -70, "D.": -70,
+	"ĎA": -40, "ĎÁ": -40, "ĎĂ": -40, "ĎÂ": -40, "ĎÄ": -40, "ĎÀ": -40,
+	"ĎĀ": -40, "ĎĄ": -40, "ĎÅ": -40, "ĎÃ": -40, "ĎV": -70, "ĎW": -40,
+	"ĎY": -90, "ĎÝ": -90, "ĎŸ": -90, "Ď,": -70, "Ď.": -70,
+	"ĐA": -40, "ĐÁ": -40, "ĐĂ": -40, "ĐÂ": -40, "ĐÄ": -40, "ĐÀ": -40,
+	"ĐĀ": -40, "ĐĄ": -40, "ĐÅ": -40, "ĐÃ": -40, "ĐV": -70, "ĐW": -40,
+	"ĐY": -90, "ĐÝ": -90, "ĐŸ": -90, "Đ,": -70, "Đ.": -70,
+	"FA": -80, "FÁ": -80, "FĂ": -80, "FÂ": -80, "FÄ": -80, "FÀ": -80,
+	"FĀ": -80, "FĄ": -80, "FÅ": -80, "FÃ": -80, "Fa": -50, "Fá": -50,
+	"Fă": -50, "Fâ": -50, "Fä": -50, "Fà": -50, "Fā": -50, "Fą": -50,
+	"Få": -50, "Fã": -50, "F,": -150, "Fe": -30, "Fé": -30, "Fě": -30,
+	"Fê": -30, "Fë": -30, "Fė": -30, "Fè": -30, "Fē": -30, "Fę": -30,
+	"Fo": -30, "Fó": -30, "Fô": -30, "Fö": -30, "Fò": -30, "Fő": -30,
+	"Fō": -30, "Fø": -30, "Fõ": -30, "F.": -150, "Fr": -45, "Fŕ": -45,
+	"Fř": -45, "Fŗ": -45,
+	"JA": -20, "JÁ": -20, "JĂ": -20, "JÂ": -20, "JÄ": -20, "JÀ": -20,
+	"JĀ": -20, "JĄ": -20, "JÅ": -20, "JÃ": -20, "Ja": -20, "Já": -20,
+	"Jă": -20, "Jâ": -20, "Jä": -20, "Jà": -20, "Jā": -20, "Ją": -20,
+	"Jå": -20, "Jã": -20, "J,": -30, "J.": -30, "Ju": -20, "Jú": -20,
+	"Jû": -20, "Jü": -20, "Jù": -20, "Jű": -20, "Jū": -20, "Jų": -20,
+	"Jů": -20,
+	"KO": -50, "KÓ": -50, "KÔ": -50, "KÖ": -50, "KÒ": -50, "KŐ": -50,
+	"KŌ": -50, "KØ": -50, "KÕ": -50, "Ke": -40, "Ké": -40, "Kě": -40,
+	"Kê": -40, "Kë": -40, "Kė": -40, "Kè": -40, "Kē": -40, "Kę": -40,
+	"Ko": -40, "Kó": -40, "Kô": -40, "Kö": -40, "Kò": -40, "Kő": -40,
+	"Kō": -40, "Kø": -40, "Kõ": -40, "Ku": -30, "Kú": -30, "Kû": -30,
+	"Kü": -30, "Kù": -30, "Kű": -30, "Kū": -30, "Kų": -30, "Ků": -30,
+	"Ky": -50, "Ký": -50, "Kÿ": -50,
+	"ĶO": -50, "ĶÓ": -50, "ĶÔ": -50, "ĶÖ": -50, "ĶÒ": -50, "ĶŐ": -50,
+	"ĶŌ": -50, "ĶØ": -50, "ĶÕ": -50, "Ķe": -40, "Ķé": -40, "Ķě": -40,
+	"Ķê": -40, "Ķë": -40, "Ķė": -40, "Ķè": -40, "Ķē": -40, "Ķę": -40,
+	"Ķo": -40, "Ķó": -40, "Ķô": -40, "Ķö": -40, "Ķò": -40, "Ķő": -40,
+	"Ķō": -40, "Ķø": -40, "Ķõ": -40, "Ķu": -30, "Ķú": -30, "Ķû": -30,
+	"Ķü": -30, "Ķù": -30, "Ķű": -30, "Ķū": -30, "Ķų": -30, "Ķů": -30,
+	"Ķy": -50, "Ķý": -50, "Ķÿ": -50,
+	"LT": -110, "LŤ": -110, "LŢ": -110, "LV": -110, "LW": -70, "LY": -140,
+	"LÝ": -140, "LŸ": -140, "L”": -140, "L’": -160, "Ly": -30, "Lý": -30,
+	"Lÿ": -30,
+	"ĹT": -110, "ĹŤ": -110, "ĹŢ": -110, "ĹV": -110, "ĹW": -70, "ĹY": -140,
+	"ĹÝ": -140, "ĹŸ": -140, "Ĺ”": -140, "Ĺ’": -160, "Ĺy": -30, "Ĺý": -30,
+	"Ĺÿ": -30,
+	"ĽT": -110, "ĽŤ": -110, "ĽŢ": -110, "ĽV": -110, "ĽW": -70, "ĽY": -140,
+	"ĽÝ": -140, "ĽŸ": -140, "Ľ”": -140, "Ľ’": -160, "Ľy": -30, "Ľý": -30,
+	"Ľÿ": -30,
+	"ĻT": -110, "ĻŤ": -110, "ĻŢ": -110, "ĻV": -110, "ĻW": -70, "ĻY": -140,
+	"ĻÝ": -140, "ĻŸ": -140, "Ļ”": -140, "Ļ’": -160, "Ļy": -30, "Ļý": -30,
+	"Ļÿ": -30,
+	"ŁT": -110, "ŁŤ": -110, "ŁŢ": -110, "ŁV": -110, "ŁW": -70, "ŁY": -140,
+	"ŁÝ": -140, "ŁŸ": -140, "Ł”": -140, "Ł’": -160, "Ły": -30, "Łý": -30,
+	"Łÿ": -30,
+	"OA": -20, "OÁ": -20, "OĂ": -20, "OÂ": -20, "OÄ": -20, "OÀ": -20,
+	"OĀ": -20, "OĄ": -20, "OÅ": -20, "OÃ": -20, "OT": -40, "OŤ": -40,
+	"OŢ": -40, "OV": -50, "OW": -30, "OX": -60, "OY": -70, "OÝ": -70,
+	"OŸ": -70, "O,": -40, "O.": -40,
+	"ÓA": -20, "ÓÁ": -20, "ÓĂ": -20, "ÓÂ": -20, "ÓÄ": -20, "ÓÀ": -20,
+	"ÓĀ": -20, "ÓĄ": -20, "ÓÅ": -20, "ÓÃ": -20, "ÓT": -40, "ÓŤ": -40,
+	"ÓŢ": -40, "ÓV": -50, "ÓW": -30, "ÓX": -60, "ÓY": -70, "ÓÝ": -70,
+	"ÓŸ": -70, "Ó,": -40, "Ó.": -40,
+	"ÔA": -20, "ÔÁ": -20, "ÔĂ": -20, "ÔÂ": -20, "ÔÄ": -20, "ÔÀ": -20,
+	"ÔĀ": -20, "ÔĄ": -20, "ÔÅ": -20, "ÔÃ": -20, "ÔT": -40, "ÔŤ": -40,
+	"ÔŢ": -40, "ÔV": -50, "ÔW": -30, "ÔX": -60, "ÔY": -70, "ÔÝ": -70,
+	"ÔŸ": -70, "Ô,": -40, "Ô.": -40,
+	"ÖA": -20, "ÖÁ": -20, "ÖĂ": -20, "ÖÂ": -20, "ÖÄ": -20, "ÖÀ": -20,
+	"ÖĀ": -20, "ÖĄ": -20, "ÖÅ": -20, "ÖÃ": -20, "ÖT": -40, "ÖŤ": -40,
+	"ÖŢ": -40, "ÖV": -50, "ÖW": -30, "ÖX": -60, "ÖY": -70, "ÖÝ": -70,
+	"ÖŸ": -70, "Ö,": -40, "Ö.": -40,
+	"ÒA": -20, "ÒÁ": -20, "ÒĂ": -20, "ÒÂ": -20, "ÒÄ": -20, "ÒÀ": -20,
+	"ÒĀ": -20, "ÒĄ": -20, "ÒÅ": -20, "ÒÃ": -20, "ÒT": -40, "ÒŤ": -40,
+	"ÒŢ": -40, "ÒV": -50, "ÒW": -30, "ÒX": -60, "ÒY": -70, "ÒÝ": -70,
+	"ÒŸ": -70, "Ò,": -40, "Ò.": -40,
+	"ŐA": -20, "ŐÁ": -20, "ŐĂ": -20, "ŐÂ": -20, "ŐÄ": -20, "ŐÀ": -20,
+	"ŐĀ": -20, "ŐĄ": -20, "ŐÅ": -20, "ŐÃ": -20, "ŐT": -40, "ŐŤ": -40,
+	"ŐŢ": -40, "ŐV": -50, "ŐW": -30, "ŐX": -60, "ŐY": -70, "ŐÝ": -70,
+	"ŐŸ": -70, "Ő,": -40, "Ő.": -40,
+	"ŌA": -20, "ŌÁ": -20, "ŌĂ": -20, "ŌÂ": -20, "ŌÄ": -20, "ŌÀ": -20,
+	"ŌĀ": -20, "ŌĄ": -20, "ŌÅ": -20, "ŌÃ": -20, "ŌT": -40, "ŌŤ": -40,
+	"ŌŢ": -40, "ŌV": -50, "ŌW": -30, "ŌX": -60, "ŌY": -70, "ŌÝ": -70,
+	"ŌŸ": -70, "Ō,": -40, "Ō.": -40,
+	"ØA": -20, "ØÁ": -20, "ØĂ": -20, "ØÂ": -20, "ØÄ": -20, "ØÀ": -20,
+	"ØĀ": -20, "ØĄ": -20, "ØÅ": -20, "ØÃ": -20, "ØT": -40, "ØŤ": -40,
+	"ØŢ": -40, "ØV": -50, "ØW": -30, "ØX": -60, "ØY": -70, "ØÝ": -70,
+	"ØŸ": -70, "Ø,": -40, "Ø.": -40,
+	"ÕA": -20, "ÕÁ": -20, "ÕĂ": -20, "ÕÂ": -20, "ÕÄ": -20, "ÕÀ": -20,
+	"ÕĀ": -20, "ÕĄ": -20, "ÕÅ": -20, "ÕÃ": -20, "ÕT": -40, "ÕŤ": -40,
+	"ÕŢ": -40, "ÕV": -50, "ÕW": -30, "ÕX": -60, "ÕY": -70, "ÕÝ": -70,
+	"ÕŸ": -70, "Õ,": -40, "Õ.": -40,
+	"PA": -120, "PÁ": -120, "PĂ": -120, "PÂ": -120, "PÄ": -120, "PÀ": -120,
+	"PĀ": -120, "PĄ": -120, "PÅ": -120, "PÃ": -120, "Pa": -40, "Pá": -40,
+	"Pă": -40, "Pâ": -40, "Pä": -40, "Pà": -40, "Pā": -40, "Pą": -40,
+	"På": -40, "Pã": -40, "P,": -180, "Pe": -50, "Pé": -50, "Pě": -50,
+	"Pê": -50, "Pë": -50, "Pė": -50, "Pè": -50, "Pē": -50, "Pę": -50,
+	"Po": -50, "Pó": -50, "Pô": -50, "Pö": -50, "Pò": -50, "Pő": -50,
+	"Pō": -50, "Pø": -50, "Põ": -50, "P.": -180,
+	"QU": -10, "QÚ": -10, "QÛ": -10, "QÜ": -10, "QÙ": -10, "QŰ": -10,
+	"QŪ": -10, "QŲ": -10, "QŮ": -10,
+	"RO": -20, "RÓ": -20, "RÔ": -20, "RÖ": -20, "RÒ": -20, "RŐ": -20,
+	"RŌ": -20, "RØ": -20, "RÕ": -20, "RT": -30, "RŤ": -30, "RŢ": -30,
+	"RU": -40, "RÚ": -40, "RÛ": -40, "RÜ": -40, "RÙ": -40, "RŰ": -40,
+	"RŪ": -40, "RŲ": -40, "RŮ": -40, "RV": -50, "RW": -30, "RY": -50,
+	"RÝ": -50, "RŸ": -50,
+	"ŔO": -20, "ŔÓ": -20, "ŔÔ": -20, "ŔÖ": -20, "ŔÒ": -20, "ŔŐ": -20,
+	"ŔŌ": -20, "ŔØ": -20, "ŔÕ": -20, "ŔT": -30, "ŔŤ": -30, "ŔŢ": -30,
+	"ŔU": -40, "ŔÚ": -40, "ŔÛ": -40, "ŔÜ": -40, "ŔÙ": -40, "ŔŰ": -40,
+	"ŔŪ": -40, "ŔŲ": -40, "ŔŮ": -40, "ŔV": -50, "ŔW": -30, "ŔY": -50,
+	"ŔÝ": -50, "ŔŸ": -50,
+	"ŘO": -20, "ŘÓ": -20, "ŘÔ": -20, "ŘÖ": -20, "ŘÒ": -20, "ŘŐ": -20,
+	"ŘŌ": -20, "ŘØ": -20, "ŘÕ": -20, "ŘT": -30, "ŘŤ": -30, "ŘŢ": -30,
+	"ŘU": -40, "ŘÚ": -40, "ŘÛ": -40, "ŘÜ": -40, "ŘÙ": -40, "ŘŰ": -40,
+	"ŘŪ": -40, "ŘŲ": -40, "ŘŮ": -40, "ŘV": -50, "ŘW": -30, "ŘY": -50,
+	"ŘÝ": -50, "ŘŸ": -50,
+	"ŖO": -20, "ŖÓ": -20, "ŖÔ": -20, "ŖÖ": -20, "ŖÒ": -20, "ŖŐ": -20,
+	"ŖŌ": -20, "ŖØ": -20, "ŖÕ": -20, "ŖT": -30, "ŖŤ": -30, "ŖŢ": -30,
+	"ŖU": -40, "ŖÚ": -40, "ŖÛ": -40, "ŖÜ": -40, "ŖÙ": -40, "ŖŰ": -40,
+	"ŖŪ": -40, "ŖŲ": -40, "ŖŮ": -40, "ŖV": -50, "ŖW": -30, "ŖY": -50,
+	"ŖÝ": -50, "ŖŸ": -50,
+	"S,": -20, "S.": -20,
+	"Ś,": -20, "Ś.": -20,
+	"Š,": -20, "Š.": -20,
+	"Ş,": -20, "Ş.": -20,
+	"Ș,": -20, "Ș.": -20,
+	"TA": -120, "TÁ": -120, "TĂ": -120, "TÂ": -120, "TÄ": -120, "TÀ": -120,
+	"TĀ": -120, "TĄ": -120, "TÅ": -120, "TÃ": -120, "TO": -40, "TÓ": -40,
+	"TÔ": -40, "TÖ": -40, "TÒ": -40, "TŐ": -40, "TŌ": -40, "TØ": -40,
+	"TÕ": -40, "Ta": -120, "Tá": -120, "Tă": -60, "Tâ": -120, "Tä": -120,
+	"Tà": -120, "Tā": -60, "Tą": -120, "Tå": -120, "Tã": -60, "T:": -20,
+	"T,": -120, "Te": -120, "Té": -120, "Tě": -120, "Tê": -120, "Të": -120,
+	"Tė": -120, "Tè": -60, "Tē": -60, "Tę": -120, "T-": -140, "To": -120,
+	"Tó": -120, "Tô": -120, "Tö": -120, "Tò": -120, "Tő": -120, "Tō": -60,
+	"Tø": -120, "Tõ": -60, "T.": -120, "Tr": -120, "Tŕ": -120, "Tř": -120,
+	"Tŗ": -120, "T;": -20, "Tu": -120, "Tú": -120, "Tû": -120, "Tü": -120,
+	"Tù": -120, "Tű": -120, "Tū": -60, "Tų": -120, "Tů": -120, "Tw": -120,
+	"Ty": -120, "Tý": -120, "Tÿ": -60,
+	"ŤA": -120, "ŤÁ": -120, "ŤĂ": -120, "ŤÂ": -120, "ŤÄ": -120, "ŤÀ": -120,
+	"ŤĀ": -120, "ŤĄ": -120, "ŤÅ": -120, "ŤÃ": -120, "ŤO": -40, "ŤÓ": -40,
+	"ŤÔ": -40, "ŤÖ": -40, "ŤÒ": -40, "ŤŐ": -40, "ŤŌ": -40, "ŤØ": -40,
+	"ŤÕ": -40, "Ťa": -120, "Ťá": -120, "Ťă": -60, "Ťâ": -120, "Ťä": -120,
+	"Ťà": -120, "Ťā": -60, "Ťą": -120, "Ťå": -120, "Ťã": -60, "Ť:": -20,
+	"Ť,": -120, "Ťe": -120, "Ťé": -120, "Ťě": -120, "Ťê": -120, "Ťë": -120,
+	"Ťė": -120, "Ťè": -60, "Ťē": -60, "Ťę": -120, "Ť-": -140, "Ťo": -120,
+	"Ťó": -120, "Ťô": -120, "Ťö": -120, "Ťò": -120, "Ťő": -120, "Ťō": -60,
+	"Ťø": -120, "Ťõ": -60, "Ť.": -120, "Ťr": -120, "Ťŕ": -120, "Ťř": -120,
+	"Ťŗ": -120, "Ť;": -20, "Ťu": -120, "Ťú": -120, "Ťû": -120, "Ťü": -120,
+	"Ťù": -120, "Ťű": -120, "Ťū": -60, "Ťų": -120, "Ťů": -120, "Ťw": -120,
+	"Ťy": -120, "Ťý": -120, "Ťÿ": -60,
+	"ŢA": -120, "ŢÁ": -120, "ŢĂ": -120, "ŢÂ": -120, "ŢÄ": -120, "ŢÀ": -120,
+	"ŢĀ": -120, "ŢĄ": -120, "ŢÅ": -120, "ŢÃ": -120, "ŢO": -40, "ŢÓ": -40,
+	"ŢÔ": -40, "ŢÖ": -40, "ŢÒ": -40, "ŢŐ": -40, "ŢŌ": -40, "ŢØ": -40,
+	"ŢÕ": -40, "Ţa": -120, "Ţá": -120, "Ţă": -60, "Ţâ": -120, "Ţä": -120,
+	"Ţà": -120, "Ţā": -60, "Ţą": -120, "Ţå": -120, "Ţã": -60, "Ţ:": -20,
+	"Ţ,": -120, "Ţe": -120, "Ţé": -120, "Ţě": -120, "Ţê": -120, "Ţë": -120,
+	"Ţė": -120, "Ţè": -60, "Ţē": -60, "Ţę": -120, "Ţ-": -140, "Ţo": -120,
+	"Ţó": -120, "Ţô": -120, "Ţö": -120, "Ţò": -120, "Ţő": -120, "Ţō": -60,
+	"Ţø": -120, "Ţõ": -60, "Ţ.": -120, "Ţr": -120, "Ţŕ": -120, "Ţř": -120,
+	"Ţŗ": -120, "Ţ;": -20, "Ţu": -120, "Ţú": -120, "Ţû": -120, "Ţü": -120,
+	"Ţù": -120, "Ţű": -120, "Ţū": -60, "Ţų": -120, "Ţů": -120, "Ţw": -120,
+	"Ţy": -120, "Ţý": -120, "Ţÿ": -60,
+	"UA": -40, "UÁ": -40, "UĂ": -40, "UÂ": -40, "UÄ": -40, "UÀ": -40,
+	"UĀ": -40, "UĄ": -40, "UÅ": -40, "UÃ": -40, "U,": -40, "U.": -40,
+	"ÚA": -40, "ÚÁ": -40, "ÚĂ": -40, "ÚÂ": -40, "ÚÄ": -40, "ÚÀ": -40,
+	"ÚĀ": -40, "ÚĄ": -40, "ÚÅ": -40, "ÚÃ": -40, "Ú,": -40, "Ú.": -40,
+	"ÛA": -40, "ÛÁ": -40, "ÛĂ": -40, "ÛÂ": -40, "ÛÄ": -40, "ÛÀ": -40,
+	"ÛĀ": -40, "ÛĄ": -40, "ÛÅ": -40, "ÛÃ": -40, "Û,": -40, "Û.": -40,
+	"ÜA": -40, "ÜÁ": -40, "ÜĂ": -40, "ÜÂ": -40, "ÜÄ": -40, "ÜÀ": -40,
+	"ÜĀ": -40, "ÜĄ": -40, "ÜÅ": -40, "ÜÃ": -40, "Ü,": -40, "Ü.": -40,
+	"ÙA": -40, "ÙÁ": -40, "ÙĂ": -40, "ÙÂ": -40, "ÙÄ": -40, "ÙÀ": -40,
+	"ÙĀ": -40, "ÙĄ": -40, "ÙÅ": -40, "ÙÃ": -40, "Ù,": -40, "Ù.": -40,
+	"ŰA": -40, "ŰÁ": -40, "ŰĂ": -40, "ŰÂ": -40, "ŰÄ": -40, "ŰÀ": -40,
+	"ŰĀ": -40, "ŰĄ": -40, "ŰÅ": -40, "ŰÃ": -40, "Ű,": -40, "Ű.": -40,
+	"ŪA": -40, "ŪÁ": -40, "ŪĂ": -40, "ŪÂ": -40, "ŪÄ": -40, "ŪÀ": -40,
+	"ŪĀ": -40, "ŪĄ": -40, "ŪÅ": -40, "ŪÃ": -40, "Ū,": -40, "Ū.": -40,
+	"ŲA": -40, "ŲÁ": -40, "ŲĂ": -40, "ŲÂ": -40, "ŲÄ": -40, "ŲÀ": -40,
+	"ŲĀ": -40, "ŲĄ": -40, "ŲÅ": -40, "ŲÃ": -40, "Ų,": -40, "Ų.": -40,
+	"ŮA": -40, "ŮÁ": -40, "ŮĂ": -40, "ŮÂ": -40, "ŮÄ": -40, "ŮÀ": -40,
+	"ŮĀ": -40, "ŮĄ": -40, "ŮÅ": -40, "ŮÃ": -40, "Ů,": -40, "Ů.": -40,
+	"VA": -80, "VÁ": -80, "VĂ": -80, "VÂ": -80, "VÄ": -80, "VÀ": -80,
+	"VĀ": -80, "VĄ": -80, "VÅ": -80, "VÃ": -80, "VG": -40, "VĞ": -40,
+	"VĢ": -40, "VO": -40, "VÓ": -40, "VÔ": -40, "VÖ": -40, "VÒ": -40,
+	"VŐ": -40, "VŌ": -40, "VØ": -40, "VÕ": -40, "Va": -70, "Vá": -70,
+	"Vă": -70, "Vâ": -70, "Vä": -70, "Và": -70, "Vā": -70, "Vą": -70,
+	"Vå": -70, "Vã": -70, "V:": -40, "V,": -125, "Ve": -80, "Vé": -80,
+	"Vě": -80, "Vê": -80, "Vë": -80, "Vė": -80, "Vè": -80, "Vē": -80,
+	"Vę": -80, "V-": -80, "Vo": -80, "Vó": -80, "Vô": -80, "Vö": -80,
+	"Vò": -80, "Vő": -80, "Vō": -80, "Vø": -80, "Võ": -80, "V.": -125,
+	"V;": -40, "Vu": -70, "Vú": -70, "Vû": -70, "Vü": -70, "Vù": -70,
+	"Vű": -70, "Vū": -70, "Vų": -70, "Vů": -70,
+	"WA": -50, "WÁ": -50, "WĂ": -50, "WÂ": -50, "WÄ": -50, "WÀ": -50,
+	"WĀ": -50, "WĄ": -50, "WÅ": -50, "WÃ": -50, "WO": -20, "WÓ": -20,
+	"WÔ": -20, "WÖ": -20, "WÒ": -20, "WŐ": -20, "WŌ": -20, "WØ": -20,
+	"WÕ": -20, "Wa": -40, "Wá": -40, "Wă": -40, "Wâ": -40, "Wä": -40,
+	"Wà": -40, "Wā": -40, "Wą": -40, "Wå": -40, "Wã": -40, "W,": -80,
+	"We": -30, "Wé": -30, "Wě": -30, "Wê": -30, "Wë": -30, "Wė": -30,
+	"Wè": -30, "Wē": -30, "Wę": -30, "W-": -40, "Wo": -30, "Wó": -30,
+	"Wô": -30, "Wö": -30, "Wò": -30, "Wő": -30, "Wō": -30, "Wø": -30,
+	"Wõ": -30, "W.": -80, "Wu": -30, "Wú": -30, "Wû": -30, "Wü": -30,
+	"Wù": -30, "Wű": -30, "Wū": -30, "Wų": -30, "Wů": -30, "Wy": -20,
+	"Wý": -20, "Wÿ": -20,
+	"YA": -110, "YÁ": -110, "YĂ": -110, "YÂ": -110, "YÄ": -110, "YÀ": -110,
+	"YĀ": -110, "YĄ": -110, "YÅ": -110, "YÃ": -110, "YO": -85, "YÓ": -85,
+	"YÔ": -85, "YÖ": -85, "YÒ": -85, "YŐ": -85, "YŌ": -85, "YØ": -85,
+	"YÕ": -85, "Ya": -140, "Yá": -140, "Yă": -70, "Yâ": -140, "Yä": -140,
+	"Yà": -140, "Yā": -70, "Yą": -140, "Yå": -140, "Yã": -140, "Y:": -60,
+	"Y,": -140, "Ye": -140, "Yé": -140, "Yě": -140, "Yê": -140, "Yë": -140,
+	"Yė": -140, "Yè": -140, "Yē": -70, "Yę": -140, "Y-": -140, "Yi": -20,
+	"Yí": -20, "Yį": -20, "Yo": -140, "Yó": -140, "Yô": -140, "Yö": -140,
+	"Yò": -140, "Yő": -140, "Yō": -140, "Yø": -140, "Yõ": -140, "Y.": -140,
+	"Y;": -60, "Yu": -110, "Yú": -110, "Yû": -110, "Yü": -110, "Yù": -110,
+	"Yű": -110, "Yū": -110, "Yų": -110, "Yů": -110,
+	"ÝA": -110, "ÝÁ": -110, "ÝĂ": -110, "ÝÂ": -110, "ÝÄ": -110, "ÝÀ": -110,
+	"ÝĀ": -110, "ÝĄ": -110, "ÝÅ": -110, "ÝÃ": -110, "ÝO": -85, "ÝÓ": -85,
+	"ÝÔ": -85, "ÝÖ": -85, "ÝÒ": -85, "ÝŐ": -85, "ÝŌ": -85, "ÝØ": -85,
+	"ÝÕ": -85, "Ýa": -140, "Ýá": -140, "Ýă": -70, "Ýâ": -140, "Ýä": -140,
+	"Ýà": -140, "Ýā": -70, "Ýą": -140, "Ýå": -140, "Ýã": -70, "Ý:": -60,
+	"Ý,": -140, "Ýe": -140, "Ýé": -140, "Ýě": -140, "Ýê": -140, "Ýë": -140,
+	"Ýė": -140, "Ýè": -140, "Ýē": -70, "Ýę": -140, "Ý-": -140, "Ýi": -20,
+	"Ýí": -20, "Ýį": -20, "Ýo": -140, "Ýó": -140, "Ýô": -140, "Ýö": -140,
+	"Ýò": -140, "Ýő": -140, "Ýō": -70, "Ýø": -140, "Ýõ": -140, "Ý.": -140,
+	"Ý;": -60, "Ýu": -110, "Ýú": -110, "Ýû": -110, "Ýü": -110, "Ýù": -110,
+	"Ýű": -110, "Ýū": -110, "Ýų": -110, "Ýů": -110,
+	"ŸA": -110, "ŸÁ": -110, "ŸĂ": -110, "ŸÂ": -110, "ŸÄ": -110, "ŸÀ": -110,
+	"ŸĀ": -110, "ŸĄ": -110, "ŸÅ": -110, "ŸÃ": -110, "ŸO": -85, "ŸÓ": -85,
+	"ŸÔ": -85, "ŸÖ": -85, "ŸÒ": -85, "ŸŐ": -85, "ŸŌ": -85, "ŸØ": -85,
+	"ŸÕ": -85, "Ÿa": -140, "Ÿá": -140, "Ÿă": -70, "Ÿâ": -140, "Ÿä": -140,
+	"Ÿà": -140, "Ÿā": -70, "Ÿą": -140, "Ÿå": -140, "Ÿã": -70, "Ÿ:": -60,
+	"Ÿ,": -140, "Ÿe": -140, "Ÿé": -140, "Ÿě": -140, "Ÿê": -140, "Ÿë": -140,
+	"Ÿė": -140, "Ÿè": -140, "Ÿē": -70, "Ÿę": -140, "Ÿ-": -140, "Ÿi": -20,
+	"Ÿí": -20, "Ÿį": -20, "Ÿo": -140, "Ÿó": -140, "Ÿô": -140, "Ÿö": -140,
+	"Ÿò": -140, "Ÿő": -140, "Ÿō": -140, "Ÿø": -140, "Ÿõ": -140, "Ÿ.": -140,
+	"Ÿ;": -60, "Ÿu": -110, "Ÿú": -110, "Ÿû": -110, "Ÿü": -110, "Ÿù": -110,
+	"Ÿű": -110, "Ÿū": -110, "Ÿų": -110, "Ÿů": -110,
+	"av": -20, "aw": -20, "ay": -30, "aý": -30, "aÿ": -30,
+	"áv": -20, "áw": -20, "áy": -30, "áý": -30, "áÿ": -30,
+	"ăv": -20, "ăw": -20, "ăy": -30, "ăý": -30, "ăÿ": -30,
+	"âv": -20, "âw": -20, "ây": -30, "âý": -30, "âÿ": -30,
+	"äv": -20, "äw": -20, "äy": -30, "äý": -30, "äÿ": -30,
+	"àv": -20, "àw": -20, "ày": -30, "àý": -30, "àÿ": -30,
+	"āv": -20, "āw": -20, "āy": -30, "āý": -30, "āÿ": -30,
+	"ąv": -20, "ąw": -20, "ąy": -30, "ąý": -30, "ąÿ": -30,
+	"åv": -20, "åw": -20, "åy": -30, "åý": -30, "åÿ": -30,
+	"ãv": -20, "ãw": -20, "ãy": -30, "ãý": -30, "ãÿ": -30,
+	"bb": -10, "b,": -40, "bl": -20, "bĺ": -20, "bļ": -20, "bł": -20,
+	"b.": -40, "bu": -20, "bú": -20, "bû": -20, "bü": -20, "bù": -20,
+	"bű": -20, "bū": -20, "bų": -20, "bů": -20, "bv": -20, "by": -20,
+	"bý": -20, "bÿ": -20,
+	"c,": -15, "ck": -20, "cķ": -20,
+	"ć,": -15, "ćk": -20, "ćķ": -20,
+	"č,": -15, "čk": -20, "čķ": -20,
+	"ç,": -15, "çk": -20, "çķ": -20,
+	": ": -50,
+	",”": -100, ",’": -100,
+	"e,": -15, "e.": -15, "ev": -30, "ew": -20, "ex": -30, "ey": -20,
+	"eý": -20, "eÿ": -20,
+	"é,": -15, "é.": -15, "év": -30, "éw": -20, "éx": -30, "éy": -20,
+	"éý": -20, "éÿ": -20,
+	"ě,": -15, "ě.": -15, "ěv": -30, "ěw": -20, "ěx": -30, "ěy": -20,
+	"ěý": -20, "ěÿ": -20,
+	"ê,": -15, "ê.": -15, "êv": -30, "êw": -20, "êx": -30, "êy": -20,
+	"êý": -20, "êÿ": -20,
+	"ë,": -15, "ë.": -15, "ëv": -30, "ëw": -20, "ëx": -30, "ëy": -20,
+	"ëý": -20, "ëÿ": -20,
+	"ė,": -15, "ė.": -15, "ėv": -30, "ėw": -20, "ėx": -30, "ėy": -20,
+	"ėý": -20, "ėÿ": -20,
+	"è,": -15, "è.": -15, "èv": -30, "èw": -20, "èx": -30, "èy": -20,
+	"èý": -20, "èÿ": -20,
+	"ē,": -15, "ē.": -15, "ēv": -30, "ēw": -20, "ēx": -30, "ēy": -20,
+	"ēý": -20, "ēÿ": -20,
+	"ę,": -15, "ę.": -15, "ęv": -30, "ęw": -20, "ęx": -30, "ęy": -20,
+	"ęý": -20, "ęÿ": -20,
+	"fa": -30, "fá": -30, "fă": -30, "fâ": -30, "fä": -30, "fà": -30,
+	"fā": -30, "fą": -30, "få": -30, "fã": -30, "f,": -30, "fı": -28,
+	"fe": -30, "fé": -30, "fě": -30, "fê": -30, "fë": -30, "fė": -30,
+	"fè": -30, "fē": -30, "fę": -30, "fo": -30, "fó": -30, "fô": -30,
+	"fö": -30, "fò": -30, "fő": -30, "fō": -30, "fø": -30, "fõ": -30,
+	"f.": -30, "f”": 60, "f’": 50,
+	"gr": -10, "gŕ": -10, "gř": -10, "gŗ": -10,
+	"ğr": -10, "ğŕ": -10, "ğř": -10, "ğŗ": -10,
+	"ģr": -10, "ģŕ": -10, "ģř": -10, "ģŗ": -10,
+	"hy": -30, "hý": -30, "hÿ": -30,
+	"ke": -20, "ké": -20, "kě": -20, "kê": -20, "kë": -20, "kė": -20,
+	"kè": -20, "kē": -20, "kę": -20, "ko": -20, "kó": -20, "kô": -20,
+	"kö": -20, "kò": -20, "kő": -20, "kō": -20, "kø": -20, "kõ": -20,
+	"ķe": -20, "ķé": -20, "ķě": -20, "ķê": -20, "ķë": -20, "ķė": -20,
+	"ķè": -20, "ķē": -20, "ķę": -20, "ķo": -20, "ķó": -20, "ķô": -20,
+	"ķö": -20, "ķò": -20, "ķő": -20, "ķō": -20, "ķø": -20, "ķõ": -20,
+	"mu": -10, "mú": -10, "mû": -10, "mü": -10, "mù": -10, "mű": -10,
+	"mū": -10, "mų": -10, "mů": -10, "my": -15, "mý": -15, "mÿ": -15,
+	"nu": -10, "nú": -10, "nû": -10, "nü": -10, "nù": -10, "nű": -10,
+	"nū": -10, "nų": -10, "nů": -10, "nv": -20, "ny": -15, "ný": -15,
+	"nÿ": -15,
+	"ńu": -10, "ńú": -10, "ńû": -10, "ńü": -10, "ńù": -10, "ńű": -10,
+	"ńū": -10, "ńų": -10, "ńů": -10, "ńv": -20, "ńy": -15, "ńý": -15,
+	"ńÿ": -15,
+	"ňu": -10, "ňú": -10, "ňû": -10, "ňü": -10, "ňù": -10, "ňű": -10,
+	"ňū": -10, "ňų": -10, "ňů": -10, "ňv": -20, "ňy": -15, "ňý": -15,
+	"ňÿ": -15,
+	"ņu": -10, "ņú": -10, "ņû": -10, "ņü": -10, "ņù": -10, "ņű": -10,
+	"ņū": -10, "ņų": -10, "ņů": -10, "ņv": -20, "ņy": -15, "ņý": -15,
+	"ņÿ": -15,
+	"ñu": -10, "ñú": -10, "ñû": -10, "ñü": -10, "ñù": -10, "ñű": -10,
+	"ñū": -10, "ñų": -10, "ñů": -10, "ñv": -20, "ñy": -15, "ñý": -15,
+	"ñÿ": -15,
+	"o,": -40, "o.": -40, "ov": -15, "ow": -15, "ox": -30, "oy": -30,
+	"oý": -30, "oÿ": -30,
+	"ó,": -40, "ó.": -40, "óv": -15, "ów": -15, "óx": -30, "óy": -30,
+	"óý": -30, "óÿ": -30,
+	"ô,": -40, "ô.": -40, "ôv": -15, "ôw": -15, "ôx": -30, "ôy": -30,
+	"ôý": -30, "ôÿ": -30,
+	"ö,": -40, "ö.": -40, "öv": -15, "öw": -15, "öx": -30, "öy": -30,
+	"öý": -30, "öÿ": -30,
+	"ò,": -40, "ò.": -40, "òv": -15, "òw": -15, "òx": -30, "òy": -30,
+	"òý": -30, "òÿ": -30,
+	"ő,": -40, "ő.": -40, "őv": -15, "őw": -15, "őx": -30, "őy": -30,
+	"őý": -30, "őÿ": -30,
+	"ō,": -40, "ō.": -40, "ōv": -15, "ōw": -15, "ōx": -30, "ōy": -30,
+	"ōý": -30, "ōÿ": -30,
+	"øa": -55, "øá": -55, "øă": -55, "øâ": -55, "øä": -55, "øà": -55,
+	"øā": -55, "øą": -55, "øå": -55, "øã": -55, "øb": -55, "øc": -55,
+	"øć": -55, "øč": -55, "øç": -55, "ø,": -95, "ød": -55, "øđ": -55,
+	"øe": -55, "øé": -55, "øě": -55, "øê": -55, "øë": -55, "øė": -55,
+	"øè": -55, "øē": -55, "øę": -55, "øf": -55, "øg": -55, "øğ": -55,
+	"øģ": -55, "øh": -55, "øi": -55, "øí": -55, "øî": -55, "øï": -55,
+	"øì": -55, "øī": -55, "øį": -55, "øj": -55, "øk": -55, "øķ": -55,
+	"øl": -55, "øĺ": -55, "øļ": -55, "øł": -55, "øm": -55, "øn": -55,
+	"øń": -55, "øň": -55, "øņ": -55, "øñ": -55, "øo": -55, "øó": -55,
+	"øô": -55, "øö": -55, "øò": -55, "øő": -55, "øō": -55, "øø": -55,
+	"øõ": -55, "øp": -55, "ø.": -95, "øq": -55, "ør": -55, "øŕ": -55,
+	"øř": -55, "øŗ": -55, "øs": -55, "øś": -55, "øš": -55, "øş": -55,
+	"øș": -55, "øt": -55, "øţ": -55, "øu": -55, "øú": -55, "øû": -55,
+	"øü": -55, "øù": -55, "øű": -55, "øū": -55, "øų": -55, "øů": -55,
+	"øv": -70, "øw": -70, "øx": -85, "øy": -70, "øý": -70, "øÿ": -70,
+	"øz": -55, "øź": -55, "øž": -55, "øż": -55,
+	"õ,": -40, "õ.": -40, "õv": -15, "õw": -15, "õx": -30, "õy": -30,
+	"õý": -30, "õÿ": -30,
+	"p,": -35, "p.": -35, "py": -30, "pý": -30, "pÿ": -30,
+	".”": -100, ".’": -100, ". ": -60,
+	"” ": -40,
+	"‘‘": -57,
+	"’d": -50, "’đ": -50, "’’": -57, "’r": -50, "’ŕ": -50, "’ř": -50,
+	"’ŗ": -50, "’s": -50, "’ś": -50, "’š": -50, "’ş": -50, "’ș": -50,
+	"’ ": -70,
+	"ra": -10, "rá": -10, "ră": -10, "râ": -10, "rä": -10, "rà": -10,
+	"rā": -10, "rą": -10, "rå": -10, "rã": -10, "r:": 30, "r,": -50,
+	"ri": 15, "rí": 15, "rî": 15, "rï": 15, "rì": 15, "rī": 15,
+	"rį": 15, "rk": 15, "rķ": 15, "rl": 15, "rĺ": 15, "rļ": 15,
+	"rł": 15, "rm": 25, "rn": 25, "rń": 25, "rň": 25, "rņ": 25,
+	"rñ": 25, "rp": 30, "r.": -50, "r;": 30, "rt": 40, "rţ": 40,
+	"ru": 15, "rú": 15, "rû": 15, "rü": 15, "rù": 15, "rű": 15,
+	"rū": 15, "rų": 15, "rů": 15, "rv": 30, "ry": 30, "rý": 30,
+	"rÿ": 30,
+	"ŕa": -10, "ŕá": -10, "ŕă": -10, "ŕâ": -10, "ŕä": -10, "ŕà": -10,
+	"ŕā": -10, "ŕą": -10, "ŕå": -10, "ŕã": -10, "ŕ:": 30, "ŕ,": -50,
+	"ŕi": 15, "ŕí": 15, "ŕî": 15, "ŕï": 15, "ŕì": 15, "ŕī": 15,
+	"ŕį": 15, "ŕk": 15, "ŕķ": 15, "ŕl": 15, "ŕĺ": 15, "ŕļ": 15,
+	"ŕł": 15, "ŕm": 25, "ŕn": 25, "ŕń": 25, "ŕň": 25, "ŕņ": 25,
+	"ŕñ": 25, "ŕp": 30, "ŕ.": -50, "ŕ;": 30, "ŕt": 40, "ŕţ": 40,
+	"ŕu": 15, "ŕú": 15, "ŕû": 15, "ŕü": 15, "ŕù": 15, "ŕű": 15,
+	"ŕū": 15, "ŕų": 15, "ŕů": 15, "ŕv": 30, "ŕy": 30, "ŕý": 30,
+	"ŕÿ": 30,
+	"řa": -10, "řá": -10, "řă": -10, "řâ": -10, "řä": -10, "řà": -10,
+	"řā": -10, "řą": -10, "řå": -10, "řã": -10, "ř:": 30, "ř,": -50,
+	"ři": 15, "ří": 15, "řî": 15, "řï": 15, "řì": 15, "řī": 15,
+	"řį": 15, "řk": 15, "řķ": 15, "řl": 15, "řĺ": 15, "řļ": 15,
+	"řł": 15, "řm": 25, "řn": 25, "řń": 25, "řň": 25, "řņ": 25,
+	"řñ": 25, "řp": 30, "ř.": -50, "ř;": 30, "řt": 40, "řţ": 40,
+	"řu": 15, "řú": 15, "řû": 15, "řü": 15, "řù": 15, "řű": 15,
+	"řū": 15, "řų": 15, "řů": 15, "řv": 30, "řy": 30, "řý": 30,
+	"řÿ": 30,
+	"ŗa": -10, "ŗá": -10, "ŗă": -10, "ŗâ": -10, "ŗä": -10, "ŗà": -10,
+	"ŗā": -10, "ŗą": -10, "ŗå": -10, "ŗã": -10, "ŗ:": 30, "ŗ,": -50,
+	"ŗi": 15, "ŗí": 15, "ŗî": 15, "ŗï": 15, "ŗì": 15, "ŗī": 15,
+	"ŗį": 15, "ŗk": 15, "ŗķ": 15, "ŗl": 15, "ŗĺ": 15, "ŗļ": 15,
+	"ŗł": 15, "ŗm": 25, "ŗn": 25, "ŗń": 25, "ŗň": 25, "ŗņ": 25,
+	"ŗñ": 25, "ŗp": 30, "ŗ.": -50, "ŗ;": 30, "ŗt": 40, "ŗţ": 40,
+	"ŗu": 15, "ŗú": 15, "ŗû": 15, "ŗü": 15, "ŗù": 15, "ŗű": 15,
+	"ŗū": 15, "ŗų": 15, "ŗů": 15, "ŗv": 30, "ŗy": 30, "ŗý": 30,
+	"ŗÿ": 30,
+	"s,": -15, "s.": -15, "sw": -30,
+	"ś,": -15, "ś.": -15, "św": -30,
+	"š,": -15, "š.": -15, "šw": -30,
+	"ş,": -15, "ş.": -15, "şw": -30,
+	"ș,": -15, "ș.": -15, "șw": -30,
+	"; ": -50,
+	" T": -50, " Ť": -50, " Ţ": -50, " V": -50, " W": -40, " Y": -90,
+	" Ý": -90, " Ÿ": -90, " “": -30, " ‘": -60,
+	"va": -25, "vá": -25, "vă": -25, "vâ": -25, "vä": -25, "và": -25,
+	"vā": -25, "vą": -25, "vå": -25, "vã": -25, "v,": -80, "ve": -25,
+	"vé": -25, "vě": -25, "vê": -25, "vë": -25, "vė": -25, "vè": -25,
+	"vē": -25, "vę": -25, "vo": -25, "vó": -25, "vô": -25, "vö": -25,
+	"vò": -25, "vő": -25, "vō": -25, "vø": -25, "võ": -25, "v.": -80,
+	"wa": -15, "wá": -15, "wă": -15, "wâ": -15, "wä": -15, "wà": -15,
+	"wā": -15, "wą": -15, "wå": -15, "wã": -15, "w,": -60, "we": -10,
+	"wé": -10, "wě": -10, "wê": -10, "wë": -10, "wė": -10, "wè": -10,
+	"wē": -10, "wę": -10, "wo": -10, "wó": -10, "wô": -10, "wö": -10,
+	"wò": -10, "wő": -10, "wō": -10, "wø": -10, "wõ": -10, "w.": -60,
+	"xe": -30, "xé": -30, "xě": -30, "xê": -30, "xë": -30, "xė": -30,
+	"xè": -30, "xē": -30, "xę": -30,
+	"ya": -20, "yá": -20, "yă": -20, "yâ": -20, "yä": -20, "yà": -20,
+	"yā": -20, "yą": -20, "yå": -20, "yã": -20, "y,": -100, "ye": -20,
+	"yé": -20, "yě": -20, "yê": -20, "yë": -20, "yė": -20, "yè": -20,
+	"yē": -20, "yę": -20, "yo": -20, "yó": -20, "yô": -20, "yö": -20,
+	"yò": -20, "yő": -20, "yō": -20, "yø": -20, "yõ": -20, "y.": -100,
+	"ýa": -20, "ýá": -20, "ýă": -20, "ýâ": -20, "ýä": -20, "ýà": -20,
+	"ýā": -20, "ýą": -20, "ýå": -20, "ýã": -20, "ý,": -100, "ýe": -20,
+	"ýé": -20, "ýě": -20, "ýê": -20, "ýë": -20, "ýė": -20, "ýè": -20,
+	"ýē": -20, "ýę": -20, "ýo": -20, "ýó": -20, "ýô": -20, "ýö": -20,
+	"ýò": -20, "ýő": -20, "ýō": -20, "ýø": -20, "ýõ": -20, "ý.": -100,
+	"ÿa": -20, "ÿá": -20, "ÿă": -20, "ÿâ": -20, "ÿä": -20, "ÿà": -20,
+	"ÿā": -20, "ÿą": -20, "ÿå": -20, "ÿã": -20, "ÿ,": -100, "ÿe": -20,
+	"ÿé": -20, "ÿě": -20, "ÿê": -20, "ÿë": -20, "ÿė": -20, "ÿè": -20,
+	"ÿē": -20, "ÿę": -20, "ÿo": -20, "ÿó": -20, "ÿô": -20, "ÿö": -20,
+	"ÿò": -20, "ÿő": -20, "ÿō": -20, "ÿø": -20, "ÿõ": -20, "ÿ.": -100,
+	"ze": -15, "zé": -15, "zě": -15, "zê": -15, "zë": -15, "zė": -15,
+	"zè": -15, "zē": -15, "zę": -15, "zo": -15, "zó": -15, "zô": -15,
+	"zö": -15, "zò": -15, "ző": -15, "zō": -15, "zø": -15, "zõ": -15,
+	"źe": -15, "źé": -15, "źě": -15, "źê": -15, "źë": -15, "źė": -15,
+	"źè": -15, "źē": -15, "źę": -15, "źo": -15, "źó": -15, "źô": -15,
+	"źö": -15, "źò": -15, "źő": -15, "źō": -15, "źø": -15, "źõ": -15,
+	"že": -15, "žé": -15, "žě": -15, "žê": -15, "žë": -15, "žė": -15,
+	"žè": -15, "žē": -15, "žę": -15, "žo": -15, "žó": -15, "žô": -15,
+	"žö": -15, "žò": -15, "žő": -15, "žō": -15, "žø": -15, "žõ": -15,
+	"że": -15, "żé": -15, "żě": -15, "żê": -15, "żë": -15, "żė": -15,
+	"żè": -15, "żē": -15, "żę": -15, "żo": -15, "żó": -15, "żô": -15,
+	"żö": -15, "żò": -15, "żő": -15, "żō": -15, "żø": -15, "żõ": -15,
+} //                                                            pdfKernHelvetica
+
+// pdfKernHelveticaBold is the kerning of Helvetica-Bold and
+// Helvetica-BoldOblique, from Helvetica-Bold.afm version 002.000
+// (UniqueID 43052)
+var pdfKernHelveticaBold = map[string]int{
+	"AC": -40, "AĆ": -40, "AČ": -40, "AÇ": -40, "AG": -50, "AĞ": -50,
+	"AĢ": -50, "AO": -40, "AÓ": -40, "AÔ": -40, "AÖ": -40, "AÒ": -40,
+	"AŐ": -40, "AŌ": -40, "AØ": -40, "AÕ": -40, "AQ": -40, "AT": -90,
+	"AŤ": -90, "AŢ": -90, "AU": -50, "AÚ": -50, "AÛ": -50, "AÜ": -50,
+	"AÙ": -50, "AŰ": -50, "AŪ": -50, "AŲ": -50, "AŮ": -50, "AV": -80,
+	"AW": -60, "AY": -110, "AÝ": -110, "AŸ": -110, "Au": -30, "Aú": -30,
+	"Aû": -30, "Aü": -30, "Aù": -30, "Aű": -30, "Aū": -30, "Aų": -30,
+	"Aů": -30, "Av": -40, "Aw": -30, "Ay": -30, "Aý": -30, "Aÿ": -30,
+	"ÁC": -40, "ÁĆ": -40, "ÁČ": -40, "ÁÇ": -40, "ÁG": -50, "ÁĞ": -50,
+	"ÁĢ": -50, "ÁO": -40, "ÁÓ": -40, "ÁÔ": -40, "ÁÖ": -40, "ÁÒ": -40,
+	"ÁŐ": -40, "ÁŌ": -40, "ÁØ": -40, "ÁÕ": -40, "ÁQ": -40, "ÁT": -90,
+	"ÁŤ": -90, "ÁŢ": -90, "ÁU": -50, "ÁÚ": -50, "ÁÛ": -50, "ÁÜ": -50,
+	"ÁÙ": -50, "ÁŰ": -50, "ÁŪ": -50, "ÁŲ": -50, "ÁŮ": -50, "ÁV": -80,
+	"ÁW": -60, "ÁY": -110, "ÁÝ": -110, "ÁŸ": -110, "Áu": -30, "Áú": -30,
+	"Áû": -30, "Áü": -30, "Áù": -30, "Áű": -30, "Áū": -30, "Áų": -30,
+	"Áů": -30, "Áv": -40, "Áw": -30, "Áy": -30, "Áý": -30, "Áÿ": -30,
+	"ĂC": -40, "ĂĆ": -40, "ĂČ": -40, "ĂÇ": -40, "ĂG": -50, "ĂĞ": -50,
+	"ĂĢ": -50, "ĂO": -40, "ĂÓ": -40, "ĂÔ": -40, "ĂÖ": -40, "ĂÒ": -40,
+	"ĂŐ": -40, "ĂŌ": -40, "ĂØ": -40, "ĂÕ": -40, "ĂQ": -40, "ĂT": -90,
+	"ĂŤ": -90, "ĂŢ": -90, "ĂU": -50, "ĂÚ": -50, "ĂÛ": -50, "ĂÜ": -50,
+	"ĂÙ": -50, "ĂŰ": -50, "ĂŪ": -50, "ĂŲ": -50, "ĂŮ": -50, "ĂV": -80,
+	"ĂW": -60, "ĂY": -110, "ĂÝ": -110, "ĂŸ": -110, "Ău": -30, "Ăú": -30,
+	"Ăû": -30, "Ăü": -30, "Ăù": -30, "Ăű": -30, "Ăū": -30, "Ăų": -30,
+	"Ăů": -30, "Ăv": -40, "Ăw": -30, "Ăy": -30, "Ăý": -30, "Ăÿ": -30,
+	"ÂC": -40, "ÂĆ": -40, "ÂČ": -40, "ÂÇ": -40, "ÂG": -50, "ÂĞ": -50,
+	"ÂĢ": -50, "ÂO": -40, "ÂÓ": -40, "ÂÔ": -40, "ÂÖ": -40, "ÂÒ": -40,
+	"ÂŐ": -40, "ÂŌ": -40, "ÂØ": -40, "ÂÕ": -40, "ÂQ": -40, "ÂT": -90,
+	"ÂŤ": -90, "ÂŢ": -90, "ÂU": -50, "ÂÚ": -50, "ÂÛ": -50, "ÂÜ": -50,
+	"ÂÙ": -50, "ÂŰ": -50, "ÂŪ": -50, "ÂŲ": -50, "ÂŮ": -50, "ÂV": -80,
+	"ÂW": -60, "ÂY": -110, "ÂÝ": -110, "ÂŸ": -110, "Âu": -30, "Âú": -30,
+	"Âû": -30, "Âü": -30, "Âù": -30, "Âű": -30, "Âū": -30, "Âų": -30,
+	"Âů": -30, "Âv": -40, "Âw": -30, "Ây": -30, "Âý": -30, "Âÿ": -30,
+	"ÄC": -40, "ÄĆ": -40, "ÄČ": -40, "ÄÇ": -40, "ÄG": -50, "ÄĞ": -50,
+	"ÄĢ": -50, "ÄO": -40, "ÄÓ": -40, "ÄÔ": -40, "ÄÖ": -40, "ÄÒ": -40,
+	"ÄŐ": -40, "ÄŌ": -40, "ÄØ": -40, "ÄÕ": -40, "ÄQ": -40, "ÄT": -90,
+	"ÄŤ": -90, "ÄŢ": -90, "ÄU": -50, "ÄÚ": -50, "ÄÛ": -50, "ÄÜ": -50,
+	"ÄÙ": -50, "ÄŰ": -50, "ÄŪ": -50, "ÄŲ": -50, "ÄŮ": -50, "ÄV": -80,
+	"ÄW": -60, "ÄY": -110, "ÄÝ": -110, "ÄŸ": -110, "Äu": -30, "Äú": -30,
+	"Äû": -30, "Äü": -30, "Äù": -30, "Äű": -30, "Äū": -30, "Äų": -30,
+	"Äů": -30, "Äv": -40, "Äw": -30, "Äy": -30, "Äý": -30, "Äÿ": -30,
+	"ÀC": -40, "ÀĆ": -40, "ÀČ": -40, "ÀÇ": -40, "ÀG": -50, "ÀĞ": -50,
+	"ÀĢ": -50, "ÀO": -40, "ÀÓ": -40, "ÀÔ": -40, "ÀÖ": -40, "ÀÒ": -40,
+	"ÀŐ": -40, "ÀŌ": -40, "ÀØ": -40, "ÀÕ": -40, "ÀQ": -40, "ÀT": -90,
+	"ÀŤ": -90, "ÀŢ": -90, "ÀU": -50, "ÀÚ": -50, "ÀÛ": -50, "ÀÜ": -50,
+	"ÀÙ": -50, "ÀŰ": -50, "ÀŪ": -50, "ÀŲ": -50, "ÀŮ": -50, "ÀV": -80,
+	"ÀW": -60, "ÀY": -110, "ÀÝ": -110, "ÀŸ": -110, "Àu": -30, "Àú": -30,
+	"Àû": -30, "Àü": -30, "Àù": -30, "Àű": -30, "Àū": -30, "Àų": -30,
+	"Àů": -30, "Àv": -40, "Àw": -30, "Ày": -30, "Àý": -30, "Àÿ": -30,
+	"ĀC": -40, "ĀĆ": -40, "ĀČ": -40, "ĀÇ": -40, "ĀG": -50, "ĀĞ": -50,
+	"ĀĢ": -50, "ĀO": -40, "ĀÓ": -40, "ĀÔ": -40, "ĀÖ": -40, "ĀÒ": -40,
+	"ĀŐ": -40, "ĀŌ": -40, "ĀØ": -40, "ĀÕ": -40, "ĀQ": -40, "ĀT": -90,
+	"ĀŤ": -90, "ĀŢ": -90, "ĀU": -50, "ĀÚ": -50, "ĀÛ": -50, "ĀÜ": -50,
+	"ĀÙ": -50, "ĀŰ": -50, "ĀŪ": -50, "ĀŲ": -50, "ĀŮ": -50, "ĀV": -80,
+	"ĀW": -60, "ĀY": -110, "ĀÝ": -110, "ĀŸ": -110, "Āu": -30, "Āú": -30,
+	"Āû": -30, "Āü": -30, "Āù": -30, "Āű": -30, "Āū": -30, "Āų": -30,
+	"Āů": -30, "Āv": -40, "Āw": -30, "Āy": -30, "Āý": -30, "Āÿ": -30,
+	"ĄC": -40, "ĄĆ": -40, "ĄČ": -40, "ĄÇ": -40, "ĄG": -50, "ĄĞ": -50,
+	"ĄĢ": -50, "ĄO": -40, "ĄÓ": -40, "ĄÔ": -40, "ĄÖ": -40, "ĄÒ": -40,
+	"ĄŐ": -40, "ĄŌ": -40, "ĄØ": -40, "ĄÕ": -40, "ĄQ": -40, "ĄT": -90,
+	"ĄŤ": -90, "ĄŢ": -90, "ĄU": -50, "ĄÚ": -50, "ĄÛ": -50, "ĄÜ": -50,
+	"ĄÙ": -50, "ĄŰ": -50, "ĄŪ": -50, "ĄŲ": -50, "ĄŮ": -50, "ĄV": -80,
+	"ĄW": -60, "ĄY": -110, "ĄÝ": -110, "ĄŸ": -110, "Ąu": -30, "Ąú": -30,
+	"Ąû": -30, "Ąü": -30, "Ąù": -30, "Ąű": -30, "Ąū": -30, "Ąų": -30,
+	"Ąů": -30, "Ąv": -40, "Ąw": -30, "Ąy": -30, "Ąý": -30, "Ąÿ": -30,
+	"ÅC": -40, "ÅĆ": -40, "ÅČ": -40, "ÅÇ": -40, "ÅG": -50, "ÅĞ": -50,
+	"ÅĢ": -50, "ÅO": -40, "ÅÓ": -40, "ÅÔ": -40, "ÅÖ": -40, "ÅÒ": -40,
+	"ÅŐ": -40, "ÅŌ": -40, "ÅØ": -40, "ÅÕ": -40, "ÅQ": -40, "ÅT": -90,
+	"ÅŤ": -90, "ÅŢ": -90, "ÅU": -50, "ÅÚ": -50, "ÅÛ": -50, "ÅÜ": -50,
+	"ÅÙ": -50, "ÅŰ": -50, "ÅŪ": -50, "ÅŲ": -50, "ÅŮ": -50, "ÅV": -80,
+	"ÅW": -60, "ÅY": -110, "ÅÝ": -110, "ÅŸ": -110, "Åu": -30, "Åú": -30,
+	"Åû": -30, "Åü": -30, "Åù": -30, "Åű": -30, "Åū": -30, "Åų": -30,
+	"Åů": -30, "Åv": -40, "Åw": -30, "Åy": -30, "Åý": -30, "Åÿ": -30,
+	"ÃC": -40, "ÃĆ": -40, "ÃČ": -40, "ÃÇ": -40, "ÃG": -50, "ÃĞ": -50,
+	"ÃĢ": -50, "ÃO": -40, "ÃÓ": -40, "ÃÔ": -40, "ÃÖ": -40, "ÃÒ": -40,
+	"ÃŐ": -40, "ÃŌ": -40, "ÃØ": -40, "ÃÕ": -40, "ÃQ": -40, "ÃT": -90,
+	"ÃŤ": -90, "ÃŢ": -90, "ÃU": -50, "ÃÚ": -50, "ÃÛ": -50, "ÃÜ": -50,
+	"ÃÙ": -50, "ÃŰ": -50, "ÃŪ": -50, "ÃŲ": -50, "ÃŮ": -50, "ÃV": -80,
+	"ÃW": -60, "ÃY": -110, "ÃÝ": -110, "ÃŸ": -110, "Ãu": -30, "Ãú": -30,
+	"Ãû": -30, "Ãü": -30, "Ãù": -30, "Ãű": -30, "Ãū": -30, "Ãų": -30,
+	"Ãů": -30, "Ãv": -40, "Ãw": -30, "Ãy": -30, "Ãý": -30, "Ãÿ": -30,
+	"BA": -30, "BÁ": -30, "BĂ": -30, "BÂ": -30, "BÄ": -30, "BÀ": -30,
+	"BĀ": -30, "BĄ": -30, "BÅ": -30, "BÃ": -30, "BU": -10, "BÚ": -10,
+	"BÛ": -10, "BÜ": -10, "BÙ": -10, "BŰ": -10, "BŪ": -10, "BŲ": -10,
+	"BŮ": -10,
+	"DA": -40, "DÁ": -40, "DĂ": -40, "DÂ": -40, "DÄ": -40, "DÀ": -40,
+	"DĀ": -40, "DĄ": -40, "DÅ": -40, "DÃ": -40, "DV": -40, "DW": -40,
+	"DY": -70, "DÝ": -70, "DŸ": -70, "D,": -30, "D.": -30,
+	"ĎA": -40, "ĎÁ": -40, "ĎĂ": -40, "ĎÂ": -40, "ĎÄ": -40, "ĎÀ": -40,
+	"ĎĀ": -40, "ĎĄ": -40, "ĎÅ": -40, "ĎÃ": -40, "ĎV": -40, "ĎW": -40,
+	"ĎY": -70, "ĎÝ": -70, "ĎŸ": -70, "Ď,": -30, "Ď.": -30,
+	"ĐA": -40, "ĐÁ": -40, "ĐĂ": -40, "ĐÂ": -40, "ĐÄ": -40, "ĐÀ": -40,
+	"ĐĀ": -40, "ĐĄ": -40, "ĐÅ": -40, "ĐÃ": -40, "ĐV": -40, "ĐW": -40,
+	"ĐY": -70, "ĐÝ": -70, "ĐŸ": -70, "Đ,": -30, "Đ.": -30,
+	"FA": -80, "FÁ": -80, "FĂ": -80, "FÂ": -80, "FÄ": -80, "FÀ": -80,
+	"FĀ": -80, "FĄ": -80, "FÅ": -80, "FÃ": -80, "Fa": -20, "Fá": -20,
+	"Fă": -20, "Fâ": -20, "Fä": -20, "Fà": -20, "Fā": -20, "Fą": -20,
+	"Få": -20, "Fã": -20, "F,": -100, "F.": -100,
+	"JA": -20, "JÁ": -20, "JĂ": -20, "JÂ": -20, "JÄ": -20, "JÀ": -20,
+	"JĀ": -20, "JĄ": -20, "JÅ": -20, "JÃ": -20, "J,": -20, "J.": -20,
+	"Ju": -20, "Jú": -20, "Jû": -20, "Jü": -20, "Jù": -20, "Jű": -20,
+	"Jū": -20, "Jų": -20, "Jů": -20,
+	"KO": -30, "KÓ": -30, "KÔ": -30, "KÖ": -30, "KÒ": -30, "KŐ": -30,
+	"KŌ": -30, "KØ": -30, "KÕ": -30, "Ke": -15, "Ké": -15, "Kě": -15,
+	"Kê": -15, "Kë": -15, "Kė": -15, "Kè": -15, "Kē": -15, "Kę": -15,
+	"Ko": -35, "Kó": -35, "Kô": -35, "Kö": -35, "Kò": -35, "Kő": -35,
+	"Kō": -35, "Kø": -35, "Kõ": -35, "Ku": -30, "Kú": -30, "Kû": -30,
+	"Kü": -30, "Kù": -30, "Kű": -30, "Kū": -30, "Kų": -30, "Ků": -30,
+	"Ky": -40, "Ký": -40, "Kÿ": -40,
+	"ĶO": -30, "ĶÓ": -30, "ĶÔ": -30, "ĶÖ": -30, "ĶÒ": -30, "ĶŐ": -30,
+	"ĶŌ": -30, "ĶØ": -30, "ĶÕ": -30, "Ķe": -15, "Ķé": -15, "Ķě": -15,
+	"Ķê": -15, "Ķë": -15, "Ķė": -15, "Ķè": -15, "Ķē": -15, "Ķę": -15,
+	"Ķo": -35, "Ķó": -35, "Ķô": -35, "Ķö": -35, "Ķò": -35, "Ķő": -35,
+	"Ķō": -35, "Ķø": -35, "Ķõ": -35, "Ķu": -30, "Ķú": -30, "Ķû": -30,
+	"Ķü": -30, "Ķù": -30, "Ķű": -30, "Ķū": -30, "Ķų": -30, "Ķů": -30,
+	"Ķy": -40, "Ķý": -40, "Ķÿ": -40,
+	"LT": -90, "LŤ": -90, "LŢ": -90, "LV": -110, "LW": -80, "LY": -120,
+	"LÝ": -120, "LŸ": -120, "L”": -140, "L’": -140, "Ly": -30, "Lý": -30,
+	"Lÿ": -30,
+	"ĹT": -90, "ĹŤ": -90, "ĹŢ": -90, "ĹV": -110, "ĹW": -80, "ĹY": -120,
+	"ĹÝ": -120, "ĹŸ": -120, "Ĺ”": -140, "Ĺ’": -140, "Ĺy": -30, "Ĺý": -30,
+	"Ĺÿ": -30,
+	"ĻT": -90, "ĻŤ": -90, "ĻŢ": -90, "ĻV": -110, "ĻW": -80, "ĻY": -120,
+	"ĻÝ": -120, "ĻŸ": -120, "Ļ”": -140, "Ļ’": -140, "Ļy": -30, "Ļý": -30,
+	"Ļÿ": -30,
+	"ŁT": -90, "ŁŤ": -90, "ŁŢ": -90, "ŁV": -110, "ŁW": -80, "ŁY": -120,
+	"ŁÝ": -120, "ŁŸ": -120, "Ł”": -140, "Ł’": -140, "Ły": -30, "Łý": -30,
+	"Łÿ": -30,
+	"OA": -50, "OÁ": -50, "OĂ": -50, "OÂ": -50, "OÄ": -50, "OÀ": -50,
+	"OĀ": -50, "OĄ": -50, "OÅ": -50, "OÃ": -50, "OT": -40, "OŤ": -40,
+	"OŢ": -40, "OV": -50, "OW": -50, "OX": -50, "OY": -70, "OÝ": -70,
+	"OŸ": -70, "O,": -40, "O.": -40,
+	"ÓA": -50, "ÓÁ": -50, "ÓĂ": -50, "ÓÂ": -50, "ÓÄ": -50, "ÓÀ": -50,
+	"ÓĀ": -50, "ÓĄ": -50, "ÓÅ": -50, "ÓÃ": -50, "ÓT": -40, "ÓŤ": -40,
+	"ÓŢ": -40, "ÓV": -50, "ÓW": -50, "ÓX": -50, "ÓY": -70, "ÓÝ": -70,
+	"ÓŸ": -70, "Ó,": -40, "Ó.": -40,
+	"ÔA": -50, "ÔÁ": -50, "ÔĂ": -50, "ÔÂ": -50, "ÔÄ": -50, "ÔÀ": -50,
+	"ÔĀ": -50, "ÔĄ": -50, "ÔÅ": -50, "ÔÃ": -50, "ÔT": -40, "ÔŤ": -40,
+	"ÔŢ": -40, "ÔV": -50, "ÔW": -50, "ÔX": -50, "ÔY": -70, "ÔÝ": -70,
+	"ÔŸ": -70, "Ô,": -40, "Ô.": -40,
+	"ÖA": -50, "ÖÁ": -50, "ÖĂ": -50, "ÖÂ": -50, "ÖÄ": -50, "ÖÀ": -50,
+	"ÖĀ": -50, "ÖĄ": -50, "ÖÅ": -50, "ÖÃ": -50, "ÖT": -40, "ÖŤ": -40,
+	"ÖŢ": -40, "ÖV": -50, "ÖW": -50, "ÖX": -50, "ÖY": -70, "ÖÝ": -70,
+	"ÖŸ": -70, "Ö,": -40, "Ö.": -40,
+	"ÒA": -50, "ÒÁ": -50, "ÒĂ": -50, "ÒÂ": -50, "ÒÄ": -50, "ÒÀ": -50,
+	"ÒĀ": -50, "ÒĄ": -50, "ÒÅ": -50, "ÒÃ": -50, "ÒT": -40, "ÒŤ": -40,
+	"ÒŢ": -40, "ÒV": -50, "ÒW": -50, "ÒX": -50, "ÒY": -70, "ÒÝ": -70,
+	"ÒŸ": -70, "Ò,": -40, "Ò.": -40,
+	"ŐA": -50, "ŐÁ": -50, "ŐĂ": -50, "ŐÂ": -50, "ŐÄ": -50, "ŐÀ": -50,
+	"ŐĀ": -50, "ŐĄ": -50, "ŐÅ": -50, "ŐÃ": -50, "ŐT": -40, "ŐŤ": -40,
+	"ŐŢ": -40, "ŐV": -50, "ŐW": -50, "ŐX": -50, "ŐY": -70, "ŐÝ": -70,
+	"ŐŸ": -70, "Ő,": -40, "Ő.": -40,
+	"ŌA": -50, "ŌÁ": -50, "ŌĂ": -50, "ŌÂ": -50, "ŌÄ": -50, "ŌÀ": -50,
+	"ŌĀ": -50, "ŌĄ": -50, "ŌÅ": -50, "ŌÃ": -50, "ŌT": -40, "ŌŤ": -40,
+	"ŌŢ": -40, "ŌV": -50, "ŌW": -50, "ŌX": -50, "ŌY": -70, "ŌÝ": -70,
+	"ŌŸ": -70, "Ō,": -40, "Ō.": -40,
+	"ØA": -50, "ØÁ": -50, "ØĂ": -50, "ØÂ": -50, "ØÄ": -50, "ØÀ": -50,
+	"ØĀ": -50, "ØĄ": -50, "ØÅ": -50, "ØÃ": -50, "ØT": -40, "ØŤ": -40,
+	"ØŢ": -40, "ØV": -50, "ØW": -50, "ØX": -50, "ØY": -70, "ØÝ": -70,
+	"ØŸ": -70, "Ø,": -40, "Ø.": -40,
+	"ÕA": -50, "ÕÁ": -50, "ÕĂ": -50, "ÕÂ": -50, "ÕÄ": -50, "ÕÀ": -50,
+	"ÕĀ": -50, "ÕĄ": -50, "ÕÅ": -50, "ÕÃ": -50, "ÕT": -40, "ÕŤ": -40,
+	"ÕŢ": -40, "ÕV": -50, "ÕW": -50, "ÕX": -50, "ÕY": -70, "ÕÝ": -70,
+	"ÕŸ": -70, "Õ,": -40, "Õ.": -40,
+	"PA": -100, "PÁ": -100, "PĂ": -100, "PÂ": -100, "PÄ": -100, "PÀ": -100,
+	"PĀ": -100, "PĄ": -100, "PÅ": -100, "PÃ": -100, "Pa": -30, "Pá": -30,
+	"Pă": -30, "Pâ": -30, "Pä": -30, "Pà": -30, "Pā": -30, "Pą": -30,
+	"På": -30, "Pã": -30, "P,": -120, "Pe": -30, "Pé": -30, "Pě": -30,
+	"Pê": -30, "Pë": -30, "Pė": -30, "Pè": -30, "Pē": -30, "Pę": -30,
+	"Po": -40, "Pó": -40, "Pô": -40, "Pö": -40, "Pò": -40, "Pő": -40,
+	"Pō": -40, "Pø": -40, "Põ": -40, "P.": -120,
+	"QU": -10, "QÚ": -10, "QÛ": -10, "QÜ": -10, "QÙ": -10, "QŰ": -10,
+	"QŪ": -10, "QŲ": -10, "QŮ": -10, "Q,": 20, "Q.": 20,
+	"RO": -20, "RÓ": -20, "RÔ": -20, "RÖ": -20, "RÒ": -20, "RŐ": -20,
+	"RŌ": -20, "RØ": -20, "RÕ": -20, "RT": -20, "RŤ": -20, "RŢ": -20,
+	"RU": -20, "RÚ": -20, "RÛ": -20, "RÜ": -20, "RÙ": -20, "RŰ": -20,
+	"RŪ": -20, "RŲ": -20, "RŮ": -20, "RV": -50, "RW": -40, "RY": -50,
+	"RÝ": -50, "RŸ": -50,
+	"ŔO": -20, "ŔÓ": -20, "ŔÔ": -20, "ŔÖ": -20, "ŔÒ": -20, "ŔŐ": -20,
+	"ŔŌ": -20, "ŔØ": -20, "ŔÕ": -20, "ŔT": -20, "ŔŤ": -20, "ŔŢ": -20,
+	"ŔU": -20, "ŔÚ": -20, "ŔÛ": -20, "ŔÜ": -20, "ŔÙ": -20, "ŔŰ": -20,
+	"ŔŪ": -20, "ŔŲ": -20, "ŔŮ": -20, "ŔV": -50, "ŔW": -40, "ŔY": -50,
+	"ŔÝ": -50, "ŔŸ": -50,
+	"ŘO": -20, "ŘÓ": -20, "ŘÔ": -20, "ŘÖ": -20, "ŘÒ": -20, "ŘŐ": -20,
+	"ŘŌ": -20, "ŘØ": -20, "ŘÕ": -20, "ŘT": -20, "ŘŤ": -20, "ŘŢ": -20,
+	"ŘU": -20, "ŘÚ": -20, "ŘÛ": -20, "ŘÜ": -20, "ŘÙ": -20, "ŘŰ": -20,
+	"ŘŪ": -20, "ŘŲ": -20, "ŘŮ": -20, "ŘV": -50, "ŘW": -40, "ŘY": -50,
+	"ŘÝ": -50, "ŘŸ": -50,
+	"ŖO": -20, "ŖÓ": -20, "ŖÔ": -20, "ŖÖ": -20, "ŖÒ": -20, "ŖŐ": -20,
+	"ŖŌ": -20, "ŖØ": -20, "ŖÕ": -20, "ŖT": -20, "ŖŤ": -20, "ŖŢ": -20,
+	"ŖU": -20, "ŖÚ": -20, "ŖÛ": -20, "ŖÜ": -20, "ŖÙ": -20, "ŖŰ": -20,
+	"ŖŪ": -20, "ŖŲ": -20, "ŖŮ": -20, "ŖV": -50, "ŖW": -40, "ŖY": -50,
+	"ŖÝ": -50, "ŖŸ": -50,
+	"TA": -90, "TÁ": -90, "TĂ": -90, "TÂ": -90, "TÄ": -90, "TÀ": -90,
+	"TĀ": -90, "TĄ": -90, "TÅ": -90, "TÃ": -90, "TO": -40, "TÓ": -40,
+	"TÔ": -40, "TÖ": -40, "TÒ": -40, "TŐ": -40, "TŌ": -40, "TØ": -40,
+	"TÕ": -40, "Ta": -80, "Tá": -80, "Tă": -80, "Tâ": -80, "Tä": -80,
+	"Tà": -80, "Tā": -80, "Tą": -80, "Tå": -80, "Tã": -80, "T:": -40,
+	"T,": -80, "Te": -60, "Té": -60, "Tě": -60, "Tê": -60, "Të": -60,
+	"Tė": -60, "Tè": -60, "Tē": -60, "Tę": -60, "T-": -120, "To": -80,
+	"Tó": -80, "Tô": -80, "Tö": -80, "Tò": -80, "Tő": -80, "Tō": -80,
+	"Tø": -80, "Tõ": -80, "T.": -80, "Tr": -80, "Tŕ": -80, "Tŗ": -80,
+	"T;": -40, "Tu": -90, "Tú": -90, "Tû": -90, "Tü": -90, "Tù": -90,
+	"Tű": -90, "Tū": -90, "Tų": -90, "Tů": -90, "Tw": -60, "Ty": -60,
+	"Tý": -60, "Tÿ": -60,
+	"ŤA": -90, "ŤÁ": -90, "ŤĂ": -90, "ŤÂ": -90, "ŤÄ": -90, "ŤÀ": -90,
+	"ŤĀ": -90, "ŤĄ": -90, "ŤÅ": -90, "ŤÃ": -90, "ŤO": -40, "ŤÓ": -40,
+	"ŤÔ": -40, "ŤÖ": -40, "ŤÒ": -40, "ŤŐ": -40, "ŤŌ": -40, "ŤØ": -40,
+	"ŤÕ": -40, "Ťa": -80, "Ťá": -80, "Ťă": -80, "Ťâ": -80, "Ťä": -80,
+	"Ťà": -80, "Ťā": -80, "Ťą": -80, "Ťå": -80, "Ťã": -80, "Ť:": -40,
+	"Ť,": -80, "Ťe": -60, "Ťé": -60, "Ťě": -60, "Ťê": -60, "Ťë": -60,
+	"Ťė": -60, "Ťè": -60, "Ťē": -60, "Ťę": -60, "Ť-": -120, "Ťo": -80,
+	"Ťó": -80, "Ťô": -80, "Ťö": -80, "Ťò": -80, "Ťő": -80, "Ťō": -80,
+	"Ťø": -80, "Ťõ": -80, "Ť.": -80, "Ťr": -80, "Ťŕ": -80, "Ťŗ": -80,
+	"Ť;": -40, "Ťu": -90, "Ťú": -90, "Ťû": -90, "Ťü": -90, "Ťù": -90,
+	"Ťű": -90, "Ťū": -90, "Ťų": -90, "Ťů": -90, "Ťw": -60, "Ťy": -60,
+	"Ťý": -60, "Ťÿ": -60,
+	"ŢA": -90, "ŢÁ": -90, "ŢĂ": -90, "ŢÂ": -90, "ŢÄ": -90, "ŢÀ": -90,
+	"ŢĀ": -90, "ŢĄ": -90, "ŢÅ": -90, "ŢÃ": -90, "ŢO": -40, "ŢÓ": -40,
+	"ŢÔ": -40, "ŢÖ": -40, "ŢÒ": -40, "ŢŐ": -40, "ŢŌ": -40, "ŢØ": -40,
+	"ŢÕ": -40, "Ţa": -80, "Ţá": -80, "Ţă": -80, "Ţâ": -80, "Ţä": -80,
+	"Ţà": -80, "Ţā": -80, "Ţą": -80, "Ţå": -80, "Ţã": -80, "Ţ:": -40,
+	"Ţ,": -80, "Ţe": -60, "Ţé": -60, "Ţě": -60, "Ţê": -60, "Ţë": -60,
+	"Ţė": -60, "Ţè": -60, "Ţē": -60, "Ţę": -60, "Ţ-": -120, "Ţo": -80,
+	"Ţó": -80, "Ţô": -80, "Ţö": -80, "Ţò": -80, "Ţő": -80, "Ţō": -80,
+	"Ţø": -80, "Ţõ": -80, "Ţ.": -80, "Ţr": -80, "Ţŕ": -80, "Ţŗ": -80,
+	"Ţ;": -40, "Ţu": -90, "Ţú": -90, "Ţû": -90, "Ţü": -90, "Ţù": -90,
+	"Ţű": -90, "Ţū": -90, "Ţų": -90, "Ţů": -90, "Ţw": -60, "Ţy": -60,
+	"Ţý": -60, "Ţÿ": -60,
+	"UA": -50, "UÁ": -50, "UĂ": -50, "UÂ": -50, "UÄ": -50, "UÀ": -50,
+	"UĀ": -50, "UĄ": -50, "UÅ": -50, "UÃ": -50, "U,": -30, "U.": -30,
+	"ÚA": -50, "ÚÁ": -50, "ÚĂ": -50, "ÚÂ": -50, "ÚÄ": -50, "ÚÀ": -50,
+	"ÚĀ": -50, "ÚĄ": -50, "ÚÅ": -50, "ÚÃ": -50, "Ú,": -30, "Ú.": -30,
+	"ÛA": -50, "ÛÁ": -50, "ÛĂ": -50, "ÛÂ": -50, "ÛÄ": -50, "ÛÀ": -50,
+	"ÛĀ": -50, "ÛĄ": -50, "ÛÅ": -50, "ÛÃ": -50, "Û,": -30, "Û.": -30,
+	"ÜA": -50, "ÜÁ": -50, "ÜĂ": -50, "ÜÂ": -50, "ÜÄ": -50, "ÜÀ": -50,
+	"ÜĀ": -50, "ÜĄ": -50, "ÜÅ": -50, "ÜÃ": -50, "Ü,": -30, "Ü.": -30,
+	"ÙA": -50, "ÙÁ": -50, "ÙĂ": -50, "ÙÂ": -50, "ÙÄ": -50, "ÙÀ": -50,
+	"ÙĀ": -50, "ÙĄ": -50, "ÙÅ": -50, "ÙÃ": -50, "Ù,": -30, "Ù.": -30,
+	"ŰA": -50, "ŰÁ": -50, "ŰĂ": -50, "ŰÂ": -50, "ŰÄ": -50, "ŰÀ": -50,
+	"ŰĀ": -50, "ŰĄ": -50, "ŰÅ": -50, "ŰÃ": -50, "Ű,": -30, "Ű.": -30,
+	"ŪA": -50, "ŪÁ": -50, "ŪĂ": -50, "ŪÂ": -50, "ŪÄ": -50, "ŪÀ": -50,
+	"ŪĀ": -50, "ŪĄ": -50, "ŪÅ": -50, "ŪÃ": -50, "Ū,": -30, "Ū.": -30,
+	"ŲA": -50, "ŲÁ": -50, "ŲĂ": -50, "ŲÂ": -50, "ŲÄ": -50, "ŲÀ": -50,
+	"ŲĀ": -50, "ŲĄ": -50, "ŲÅ": -50, "ŲÃ": -50, "Ų,": -30, "Ų.": -30,
+	"ŮA": -50, "ŮÁ": -50, "ŮĂ": -50, "ŮÂ": -50, "ŮÄ": -50, "ŮÀ": -50,
+	"ŮĀ": -50, "ŮĄ": -50, "ŮÅ": -50, "ŮÃ": -50, "Ů,": -30, "Ů.": -30,
+	"VA": -80, "VÁ": -80, "VĂ": -80, "VÂ": -80, "VÄ": -80, "VÀ": -80,
+	"VĀ": -80, "VĄ": -80, "VÅ": -80, "VÃ": -80, "VG": -50, "VĞ": -50,
+	"VĢ": -50, "VO": -50, "VÓ": -50, "VÔ": -50, "VÖ": -50, "VÒ": -50,
+	"VŐ": -50, "VŌ": -50, "VØ": -50, "VÕ": -50, "Va": -60, "Vá": -60,
+	"Vă": -60, "Vâ": -60, "Vä": -60, "Và": -60, "Vā": -60, "Vą": -60,
+	"Vå": -60, "Vã": -60, "V:": -40, "V,": -120, "Ve": -50, "Vé": -50,
+	"Vě": -50, "Vê": -50, "Vë": -50, "Vė": -50, "Vè": -50, "Vē": -50,
+	"Vę": -50, "V-": -80, "Vo": -90, "Vó": -90, "Vô": -90, "Vö": -90,
+	"Vò": -90, "Vő": -90, "Vō": -90, "Vø": -90, "Võ": -90, "V.": -120,
+	"V;": -40, "Vu": -60, "Vú": -60, "Vû": -60, "Vü": -60, "Vù": -60,
+	"Vű": -60, "Vū": -60, "Vų": -60, "Vů": -60,
+	"WA": -60, "WÁ": -60, "WĂ": -60, "WÂ": -60, "WÄ": -60, "WÀ": -60,
+	"WĀ": -60, "WĄ": -60, "WÅ": -60, "WÃ": -60, "WO": -20, "WÓ": -20,
+	"WÔ": -20, "WÖ": -20, "WÒ": -20, "WŐ": -20, "WŌ": -20, "WØ": -20,
+	"WÕ": -20, "Wa": -40, "Wá": -40, "Wă": -40, "Wâ": -40, "Wä": -40,
+	"Wà": -40, "Wā": -40, "Wą": -40, "Wå": -40, "Wã": -40, "W:": -10,
+	"W,": -80, "We": -35, "Wé": -35, "Wě": -35, "Wê": -35, "Wë": -35,
+	"Wė": -35, "Wè": -35, "Wē": -35, "Wę": -35, "W-": -40, "Wo": -60,
+	"Wó": -60, "Wô": -60, "Wö": -60, "Wò": -60, "Wő": -60, "Wō": -60,
+	"Wø": -60, "Wõ": -60, "W.": -80, "W;": -10, "Wu": -45, "Wú": -45,
+	"Wû": -45, "Wü": -45, "Wù": -45, "Wű": -45, "Wū": -45, "Wų": -45,
+	"Wů": -45, "Wy": -20, "Wý": -20, "Wÿ": -20,
+	"YA": -110, "YÁ": -110, "YĂ": -110, "YÂ": -110, "YÄ": -110, "YÀ": -110,
+	"YĀ": -110, "YĄ": -110, "YÅ": -110, "YÃ": -110, "YO": -70, "YÓ": -70,
+	"YÔ": -70, "YÖ": -70, "YÒ": -70, "YŐ": -70, "YŌ": -70, "YØ": -70,
+	"YÕ": -70, "Ya": -90, "Yá": -90, "Yă": -90, "Yâ": -90, "Yä": -90,
+	"Yà": -90, "Yā": -90, "Yą": -90, "Yå": -90, "Yã": -90, "Y:": -50,
+	"Y,": -100, "Ye": -80, "Yé": -80, "Yě": -80, "Yê": -80, "Yë": -80,
+	"Yė": -80, "Yè": -80, "Yē": -80, "Yę": -80, "Yo": -100, "Yó": -100,
+	"Yô": -100, "Yö": -100, "Yò": -100, "Yő": -100, "Yō": -100, "Yø": -100,
+	"Yõ": -100, "Y.": -100, "Y;": -50, "Yu": -100, "Yú": -100, "Yû": -100,
+	"Yü": -100, "Yù": -100, "Yű": -100, "Yū": -100, "Yų": -100, "Yů": -100,
+	"ÝA": -110, "ÝÁ": -110, "ÝĂ": -110, "ÝÂ": -110, "ÝÄ": -110, "ÝÀ": -110,
+	"ÝĀ": -110, "ÝĄ": -110, "ÝÅ": -110, "ÝÃ": -110, "ÝO": -70, "ÝÓ": -70,
+	"ÝÔ": -70, "ÝÖ": -70, "ÝÒ": -70, "ÝŐ": -70, "ÝŌ": -70, "ÝØ": -70,
+	"ÝÕ": -70, "Ýa": -90, "Ýá": -90, "Ýă": -90, "Ýâ": -90, "Ýä": -90,
+	"Ýà": -90, "Ýā": -90, "Ýą": -90, "Ýå": -90, "Ýã": -90, "Ý:": -50,
+	"Ý,": -100, "Ýe": -80, "Ýé": -80, "Ýě": -80, "Ýê": -80, "Ýë": -80,
+	"Ýė": -80, "Ýè": -80, "Ýē": -80, "Ýę": -80, "Ýo": -100, "Ýó": -100,
+	"Ýô": -100, "Ýö": -100, "Ýò": -100, "Ýő": -100, "Ýō": -100, "Ýø": -100,
+	"Ýõ": -100, "Ý.": -100, "Ý;": -50, "Ýu": -100, "Ýú": -100, "Ýû": -100,
+	"Ýü": -100, "Ýù": -100, "Ýű": -100, "Ýū": -100, "Ýų": -100, "Ýů": -100,
+	"ŸA": -110, "ŸÁ": -110, "ŸĂ": -110, "ŸÂ": -110, "ŸÄ": -110, "ŸÀ": -110,
+	"ŸĀ": -110, "ŸĄ": -110, "ŸÅ": -110, "ŸÃ": -110, "ŸO": -70, "ŸÓ": -70,
+	"ŸÔ": -70, "ŸÖ": -70, "ŸÒ": -70, "ŸŐ": -70, "ŸŌ": -70, "ŸØ": -70,
+	"ŸÕ": -70, "Ÿa": -90, "Ÿá": -90, "Ÿă": -90, "Ÿâ": -90, "Ÿä": -90,
+	"Ÿà": -90, "Ÿā": -90, "Ÿą": -90, "Ÿå": -90, "Ÿã": -90, "Ÿ:": -50,
+	"Ÿ,": -100, "Ÿe": -80, "Ÿé": -80, "Ÿě": -80, "Ÿê": -80, "Ÿë": -80,
+	"Ÿė": -80, "Ÿè": -80, "Ÿē": -80, "Ÿę": -80, "Ÿo": -100, "Ÿó": -100,
+	"Ÿô": -100, "Ÿö": -100, "Ÿò": -100, "Ÿő": -100, "Ÿō": -100, "Ÿø": -100,
+	"Ÿõ": -100, "Ÿ.": -100, "Ÿ;": -50, "Ÿu": -100, "Ÿú": -100, "Ÿû": -100,
+	"Ÿü": -100, "Ÿù": -100, "Ÿű": -100, "Ÿū": -100, "Ÿų": -100, "Ÿů": -100,
+	"ag": -10, "ağ": -10, "aģ": -10, "av": -15, "aw": -15, "ay": -20,
+	"aý": -20, "aÿ": -20,
+	"ág": -10, "áğ": -10, "áģ": -10, "áv": -15, "áw": -15, "áy": -20,
+	"áý": -20, "áÿ": -20,
+	"ăg": -10, "ăğ": -10, "ăģ": -10, "ăv": -15, "ăw": -15, "ăy": -20,
+	"ăý": -20, "ăÿ": -20,
+	"âg": -10, "âğ": -10, "âģ": -10, "âv": -15, "âw": -15, "ây": -20,
+	"âý": -20, "âÿ": -20,
+	"äg": -10, "äğ": -10, "äģ": -10, "äv": -15, "äw": -15, "äy": -20,
+	"äý": -20, "äÿ": -20,
+	"àg": -10, "àğ": -10, "àģ": -10, "àv": -15, "àw": -15, "ày": -20,
+	"àý": -20, "àÿ": -20,
+	"āg": -10, "āğ": -10, "āģ": -10, "āv": -15, "āw": -15, "āy": -20,
+	"āý": -20, "āÿ": -20,
+	"ąg": -10, "ąğ": -10, "ąģ": -10, "ąv": -15, "ąw": -15, "ąy": -20,
+	"ąý": -20, "ąÿ": -20,
+	"åg": -10, "åğ": -10, "åģ": -10, "åv": -15, "åw": -15, "åy": -20,
+	"åý": -20, "åÿ": -20,
+	"ãg": -10, "ãğ": -10, "ãģ": -10, "ãv": -15, "ãw": -15, "ãy": -20,
+	"ãý": -20, "ãÿ": -20,
+	"bl": -10, "bĺ": -10, "bļ": -10, "bł": -10, "bu": -20, "bú": -20,
+	"bû": -20, "bü": -20, "bù": -20, "bű": -20, "bū": -20, "bų": -20,
+	"bů": -20, "bv": -20, "by": -20, "bý": -20, "bÿ": -20,
+	"ch": -10, "ck": -20, "cķ": -20, "cl": -20, "cĺ": -20, "cļ": -20,
+	"cł": -20, "cy": -10, "cý": -10, "cÿ": -10,
+	"ćh": -10, "ćk": -20, "ćķ": -20, "ćl": -20, "ćĺ": -20, "ćļ": -20,
+	"ćł": -20, "ćy": -10, "ćý": -10, "ćÿ": -10,
+	"čh": -10, "čk": -20, "čķ": -20, "čl": -20, "čĺ": -20, "čļ": -20,
+	"čł": -20, "čy": -10, "čý": -10, "čÿ": -10,
+	"çh": -10, "çk": -20, "çķ": -20, "çl": -20, "çĺ": -20, "çļ": -20,
+	"çł": -20, "çy": -10, "çý": -10, "çÿ": -10,
+	": ": -40,
+	",”": -120, ",’": -120, ", ": -40,
+	"dd": -10, "dđ": -10, "dv": -15, "dw": -15, "dy": -15, "dý": -15,
+	"dÿ": -15,
+	"đd": -10, "đđ": -10, "đv": -15, "đw": -15, "đy": -15, "đý": -15,
+	"đÿ": -15,
+	"e,": 10, "e.": 20, "ev": -15, "ew": -15, "ex": -15, "ey": -15,
+	"eý": -15, "eÿ": -15,
+	"é,": 10, "é.": 20, "év": -15, "éw": -15, "éx": -15, "éy": -15,
+	"éý": -15, "éÿ": -15,
+	"ě,": 10, "ě.": 20, "ěv": -15, "ěw": -15, "ěx": -15, "ěy": -15,
+	"ěý": -15, "ěÿ": -15,
+	"ê,": 10, "ê.": 20, "êv": -15, "êw": -15, "êx": -15, "êy": -15,
+	"êý": -15, "êÿ": -15,
+	"ë,": 10, "ë.": 20, "ëv": -15, "ëw": -15, "ëx": -15, "ëy": -15,
+	"ëý": -15, "ëÿ": -15,
+	"ė,": 10, "ė.": 20, "ėv": -15, "ėw": -15, "ėx": -15, "ėy": -15,
+	"ėý": -15, "ėÿ": -15,
+	"è,": 10, "è.": 20, "èv": -15, "èw": -15, "èx": -15, "èy": -15,
+	"èý": -15, "èÿ": -15,
+	"ē,": 10, "ē.": 20, "ēv": -15, "ēw": -15, "ēx": -15, "ēy": -15,
+	"ēý": -15, "ēÿ": -15,
+	"ę,": 10, "ę.": 20, "ęv": -15, "ęw": -15, "ęx": -15, "ęy": -15,
+	"ęý": -15, "ęÿ": -15,
+	"f,": -10, "fe": -10, "fé": -10, "fě": -10, "fê": -10, "fë": -10,
+	"fė": -10, "fè": -10, "fē": -10, "fę": -10, "fo": -20, "fó": -20,
+	"fô": -20, "fö": -20, "fò": -20, "fő": -20, "fō": -20, "fø": -20,
+	"fõ": -20, "f.": -10, "f”": 30, "f’": 30,
+	"ge": 10, "gé": 10, "gě": 10, "gê": 10, "gë": 10, "gė": 10,
+	"gè": 10, "gē": 10, "gę": 10, "gg": -10, "gğ": -10, "gģ": -10,
+	"ğe": 10, "ğé": 10, "ğě": 10, "ğê": 10, "ğë": 10, "ğė": 10,
+	"ğè": 10, "ğē": 10, "ğę": 10, "ğg": -10, "ğğ": -10, "ğģ": -10,
+	"ģe": 10, "ģé": 10, "ģě": 10, "ģê": 10, "ģë": 10, "ģė": 10,
+	"ģè": 10, "ģē": 10, "ģę": 10, "ģg": -10, "ģğ": -10, "ģģ": -10,
+	"hy": -20, "hý": -20, "hÿ": -20,
+	"ko": -15, "kó": -15, "kô": -15, "kö": -15, "kò": -15, "kő": -15,
+	"kō": -15, "kø": -15, "kõ": -15,
+	"ķo": -15, "ķó": -15, "ķô": -15, "ķö": -15, "ķò": -15, "ķő": -15,
+	"ķō": -15, "ķø": -15, "ķõ": -15,
+	"lw": -15, "ly": -15, "lý": -15, "lÿ": -15,
+	"ĺw": -15, "ĺy": -15, "ĺý": -15, "ĺÿ": -15,
+	"ļw": -15, "ļy": -15, "ļý": -15, "ļÿ": -15,
+	"łw": -15, "ły": -15, "łý": -15, "łÿ": -15,
+	"mu": -20, "mú": -20, "mû": -20, "mü": -20, "mù": -20, "mű": -20,
+	"mū": -20, "mų": -20, "mů": -20, "my": -30, "mý": -30, "mÿ": -30,
+	"nu": -10, "nú": -10, "nû": -10, "nü": -10, "nù": -10, "nű": -10,
+	"nū": -10, "nų": -10, "nů": -10, "nv": -40, "ny": -20, "ný": -20,
+	"nÿ": -20,
+	"ńu": -10, "ńú": -10, "ńû": -10, "ńü": -10, "ńù": -10, "ńű": -10,
+	"ńū": -10, "ńų": -10, "ńů": -10, "ńv": -40, "ńy": -20, "ńý": -20,
+	"ńÿ": -20,
+	"ňu": -10, "ňú": -10, "ňû": -10, "ňü": -10, "ňù": -10, "ňű": -10,
+	"ňū": -10, "ňų": -10, "ňů": -10, "ňv": -40, "ňy": -20, "ňý": -20,
+	"ňÿ": -20,
+	"ņu": -10, "ņú": -10, "ņû": -10, "ņü": -10, "ņù": -10, "ņű": -10,
+	"ņū": -10, "ņų": -10, "ņů": -10, "ņv": -40, "ņy": -20, "ņý": -20,
+	"ņÿ": -20,
+	"ñu": -10, "ñú": -10, "ñû": -10, "ñü": -10, "ñù": -10, "ñű": -10,
+	"ñū": -10, "ñų": -10, "ñů": -10, "ñv": -40, "ñy": -20, "ñý": -20,
+	"ñÿ": -20,
+	"ov": -20, "ow": -15, "ox": -30, "oy": -20, "oý": -20, "oÿ": -20,
+	"óv": -20, "ów": -15, "óx": -30, "óy": -20, "óý": -20, "óÿ": -20,
+	"ôv": -20, "ôw": -15, "ôx": -30, "ôy": -20, "ôý": -20, "ôÿ": -20,
+	"öv": -20, "öw": -15, "öx": -30, "öy": -20, "öý": -20, "öÿ": -20,
+	"òv": -20, "òw": -15, "òx": -30, "òy": -20, "òý": -20, "òÿ": -20,
+	"őv": -20, "őw": -15, "őx": -30, "őy": -20, "őý": -20, "őÿ": -20,
+	"ōv": -20, "ōw": -15, "ōx": -30, "ōy": -20, "ōý": -20, "ōÿ": -20,
+	"øv": -20, "øw": -15, "øx": -30, "øy": -20, "øý": -20, "øÿ": -20,
+	"õv": -20, "õw": -15, "õx": -30, "õy": -20, "õý": -20, "õÿ": -20,
+	"py": -15, "pý": -15, "pÿ": -15,
+	".”": -120, ".’": -120, ". ": -40,
+	"” ": -80,
+	"‘‘": -46,
+	"’d": -80, "’đ": -80, "’l": -20, "’ĺ": -20, "’ļ": -20, "’ł": -20,
+	"’’": -46, "’r": -40, "’ŕ": -40, "’ř": -40, "’ŗ": -40, "’s": -60,
+	"’ś": -60, "’š": -60, "’ş": -60, "’ș": -60, "’ ": -80, "’v": -20,
+	"rc": -20, "rć": -20, "rč": -20, "rç": -20, "r,": -60, "rd": -20,
+	"rđ": -20, "rg": -15, "rğ": -15, "rģ": -15, "r-": -20, "ro": -20,
+	"ró": -20, "rô": -20, "rö": -20, "rò": -20, "rő": -20, "rō": -20,
+	"rø": -20, "rõ": -20, "r.": -60, "rq": -20, "rs": -15, "rś": -15,
+	"rš": -15, "rş": -15, "rș": -15, "rt": 20, "rţ": 20, "rv": 10,
+	"ry": 10, "rý": 10, "rÿ": 10,
+	"ŕc": -20, "ŕć": -20, "ŕč": -20, "ŕç": -20, "ŕ,": -60, "ŕd": -20,
+	"ŕđ": -20, "ŕg": -15, "ŕğ": -15, "ŕģ": -15, "ŕ-": -20, "ŕo": -20,
+	"ŕó": -20, "ŕô": -20, "ŕö": -20, "ŕò": -20, "ŕő": -20, "ŕō": -20,
+	"ŕø": -20, "ŕõ": -20, "ŕ.": -60, "ŕq": -20, "ŕs": -15, "ŕś": -15,
+	"ŕš": -15, "ŕş": -15, "ŕș": -15, "ŕt": 20, "ŕţ": 20, "ŕv": 10,
+	"ŕy": 10, "ŕý": 10, "ŕÿ": 10,
+	"řc": -20, "řć": -20, "řč": -20, "řç": -20, "ř,": -60, "řd": -20,
+	"řđ": -20, "řg": -15, "řğ": -15, "řģ": -15, "ř-": -20, "řo": -20,
+	"řó": -20, "řô": -20, "řö": -20, "řò": -20, "řő": -20, "řō": -20,
+	"řø": -20, "řõ": -20, "ř.": -60, "řq": -20, "řs": -15, "řś": -15,
+	"řš": -15, "řş": -15, "řș": -15, "řt": 20, "řţ": 20, "řv": 10,
+	"řy": 10, "řý": 10, "řÿ": 10,
+	"ŗc": -20, "ŗć": -20, "ŗč": -20, "ŗç": -20, "ŗ,": -60, "ŗd": -20,
+	"ŗđ": -20, "ŗg": -15, "ŗğ": -15, "ŗģ": -15, "ŗ-": -20, "ŗo": -20,
+	"ŗó": -20, "ŗô": -20, "ŗö": -20, "ŗò": -20, "ŗő": -20, "ŗō": -20,
+	"ŗø": -20, "ŗõ": -20, "ŗ.": -60, "ŗq": -20, "ŗs": -15, "ŗś": -15,
+	"ŗš": -15, "ŗş": -15, "ŗș": -15, "ŗt": 20, "ŗţ": 20, "ŗv": 10,
+	"ŗy": 10, "ŗý": 10, "ŗÿ": 10,
+	"sw": -15,
+	"św": -15,
+	"šw": -15,
+	"şw": -15,
+	"șw": -15,
+	"; ": -40,
+	" T": -100, " Ť": -100, " Ţ": -100, " V": -80, " W": -80, " Y": -120,
+	" Ý": -120, " Ÿ": -120, " “": -80, " ‘": -60,
+	"va": -20, "vá": -20, "vă": -20, "vâ": -20, "vä": -20, "và": -20,
+	"vā": -20, "vą": -20, "vå": -20, "vã": -20, "v,": -80, "vo": -30,
+	"vó": -30, "vô": -30, "vö": -30, "vò": -30, "vő": -30, "vō": -30,
+	"vø": -30, "võ": -30, "v.": -80,
+	"w,": -40, "wo": -20, "wó": -20, "wô": -20, "wö": -20, "wò": -20,
+	"wő": -20, "wō": -20, "wø": -20, "wõ": -20, "w.": -40,
+	"xe": -10, "xé": -10, "xě": -10, "xê": -10, "xë": -10, "xė": -10,
+	"xè": -10, "xē": -10, "xę": -10,
+	"ya": -30, "yá": -30, "yă": -30, "yâ": -30, "yä": -30, "yà": -30,
+	"yā": -30, "yą": -30, "yå": -30, "yã": -30, "y,": -80, "ye": -10,
+	"yé": -10, "yě": -10, "yê": -10, "yë": -10, "yė": -10, "yè": -10,
+	"yē": -10, "yę": -10, "yo": -25, "yó": -25, "yô": -25, "yö": -25,
+	"yò": -25, "yő": -25, "yō": -25, "yø": -25, "yõ": -25, "y.": -80,
+	"ýa": -30, "ýá": -30, "ýă": -30, "ýâ": -30, "ýä": -30, "ýà": -30,
+	"ýā": -30, "ýą": -30, "ýå": -30, "ýã": -30, "ý,": -80, "ýe": -10,
+	"ýé": -10, "ýě": -10, "ýê": -10, "ýë": -10, "ýė": -10, "ýè": -10,
+	"ýē": -10, "ýę": -10, "ýo": -25, "ýó": -25, "ýô": -25, "ýö": -25,
+	"ýò": -25, "ýő": -25, "ýō": -25, "ýø": -25, "ýõ": -25, "ý.": -80,
+	"ÿa": -30, "ÿá": -30, "ÿă": -30, "ÿâ": -30, "ÿä": -30, "ÿà": -30,
+	"ÿā": -30, "ÿą": -30, "ÿå": -30, "ÿã": -30, "ÿ,": -80, "ÿe": -10,
+	"ÿé": -10, "ÿě": -10, "ÿê": -10, "ÿë": -10, "ÿė": -10, "ÿè": -10,
+	"ÿē": -10, "ÿę": -10, "ÿo": -25, "ÿó": -25, "ÿô": -25, "ÿö": -25,
+	"ÿò": -25, "ÿő": -25, "ÿō": -25, "ÿø": -25, "ÿõ": -25, "ÿ.": -80,
+	"ze": 10, "zé": 10, "zě": 10, "zê": 10, "zë": 10, "zė": 10,
+	"zè": 10, "zē": 10, "zę": 10,
+	"źe": 10, "źé": 10, "źě": 10, "źê": 10, "źë": 10, "źė": 10,
+	"źè": 10, "źē": 10, "źę": 10,
+	"že": 10, "žé": 10, "žě": 10, "žê": 10, "žë": 10, "žė": 10,
+	"žè": 10, "žē": 10, "žę": 10,
+	"że": 10, "żé": 10, "żě": 10, "żê": 10, "żë": 10, "żė": 10,
+	"żè": 10, "żē": 10, "żę": 10,
+} //                                                        pdfKernHelveticaBold
+
+// pdfKernTimesBold is the kerning of Times-Bold, from Times-Bold.afm
+// version 002.000 (UniqueID 43065)
+var pdfKernTimesBold = map[string]int{
+	"AC": -55, "AĆ": -55, "AČ": -55, "AÇ": -55, "AG": -55, "AĞ": -55,
+	"AĢ": -55, "AO": -45, "AÓ": -45, "AÔ": -45, "AÖ": -45, "AÒ": -45,
+	"AŐ": -45, "AŌ": -45, "AØ": -45, "AÕ": -45, "AQ": -45, "AT": -95,
+	"AŤ": -95, "AŢ": -95, "AU": -50, "AÚ": -50, "AÛ": -50, "AÜ": -50,
+	"AÙ": -50, "AŰ": -50, "AŪ": -50, "AŲ": -50, "AŮ": -50, "AV": -145,
+	"AW": -130, "AY": -100, "AÝ": -100, "AŸ": -100, "Ap": -25, "A’": -74,
+	"Au": -50, "Aú": -50, "Aû": -50, "Aü": -50, "Aù": -50, "Aű": -50,
+	"Aū": -50, "Aų": -50, "Aů": -50, "Av": -100, "Aw": -90, "Ay": -74,
+	"Aý": -74, "Aÿ": -74,
+	"ÁC": -55, "ÁĆ": -55, "ÁČ": -55, "ÁÇ": -55, "ÁG": -55, "ÁĞ": -55,
+	"ÁĢ": -55, "ÁO": -45, "ÁÓ": -45, "ÁÔ": -45, "ÁÖ": -45, "ÁÒ": -45,
+	"ÁŐ": -45, "ÁŌ": -45, "ÁØ": -45, "ÁÕ": -45, "ÁQ": -45, "ÁT": -95,
+	"ÁŤ": -95, "ÁŢ": -95, "ÁU": -50, "ÁÚ": -50, "ÁÛ": -50, "ÁÜ": -50,
+	"ÁÙ": -50, "ÁŰ": -50, "ÁŪ": -50, "ÁŲ": -50, "ÁŮ": -50, "ÁV": -145,
+	"ÁW": -130, "ÁY": -100, "ÁÝ": -100, "ÁŸ": -100, "Áp": -25, "Á’": -74,
+	"Áu": -50, "Áú": -50, "Áû": -50, "Áü": -50, "Áù": -50, "Áű": -50,
+	"Áū": -50, "Áų": -50, "Áů": -50, "Áv": -100, "Áw": -90, "Áy": -74,
+	"Áý": -74, "Áÿ": -74,
+	"ĂC": -55, "ĂĆ": -55, "ĂČ": -55, "ĂÇ": -55, "ĂG": -55, "ĂĞ": -55,
+	"ĂĢ": -55, "ĂO": -45, "ĂÓ": -45, "ĂÔ": -45, "ĂÖ": -45, "ĂÒ": -45,
+	"ĂŐ": -45, "ĂŌ": -45, "ĂØ": -45, "ĂÕ": -45, "ĂQ": -45, "ĂT": -95,
+	"ĂŤ": -95, "ĂŢ": -95, "ĂU": -50, "ĂÚ": -50, "ĂÛ": -50, "ĂÜ": -50,
+	"ĂÙ": -50, "ĂŰ": -50, "ĂŪ": -50, "ĂŲ": -50, "ĂŮ": -50, "ĂV": -145,
+	"ĂW": -130, "ĂY": -100, "ĂÝ": -100, "ĂŸ": -100, "Ăp": -25, "Ă’": -74,
+	"Ău": -50, "Ăú": -50, "Ăû": -50, "Ăü": -50, "Ăù": -50, "Ăű": -50,
+	"Ăū": -50, "Ăų": -50, "Ăů": -50, "Ăv": -100, "Ăw": -90, "Ăy": -74,
+	"Ăý": -74, "Ăÿ": -74,
+	"ÂC": -55, "ÂĆ": -55, "ÂČ": -55, "ÂÇ": -55, "ÂG": -55, "ÂĞ": -55,
+	"ÂĢ": -55, "ÂO": -45, "ÂÓ": -45, "ÂÔ": -45, "ÂÖ": -45, "ÂÒ": -45,
+	"ÂŐ": -45, "ÂŌ": -45, "ÂØ": -45, "ÂÕ": -45, "ÂQ": -45, "ÂT": -95,
+	"ÂŤ": -95, "ÂŢ": -95, "ÂU": -50, "ÂÚ": -50, "ÂÛ": -50, "ÂÜ": -50,
+	"ÂÙ": -50, "ÂŰ": -50, "ÂŪ": -50, "ÂŲ": -50, "ÂŮ": -50, "ÂV": -145,
+	"ÂW": -130, "ÂY": -100, "ÂÝ": -100, "ÂŸ": -100, "Âp": -25, "Â’": -74,
+	"Âu": -50, "Âú": -50, "Âû": -50, "Âü": -50, "Âù": -50, "Âű": -50,
+	"Âū": -50, "Âų": -50, "Âů": -50, "Âv": -100, "Âw": -90, "Ây": -74,
+	"Âý": -74, "Âÿ": -74,
+	"ÄC": -55, "ÄĆ": -55, "ÄČ": -55, "ÄÇ": -55, "ÄG": -55, "ÄĞ": -55,
+	"ÄĢ": -55, "ÄO": -45, "ÄÓ": -45, "ÄÔ": -45, "ÄÖ": -45, "ÄÒ": -45,
+	"ÄŐ": -45, "ÄŌ": -45, "ÄØ": -45, "ÄÕ": -45, "ÄQ": -45, "ÄT": -95,
+	"ÄŤ": -95, "ÄŢ": -95, "ÄU": -50, "ÄÚ": -50, "ÄÛ": -50, "ÄÜ": -50,
+	"ÄÙ": -50, "ÄŰ": -50, "ÄŪ": -50, "ÄŲ": -50, "ÄŮ": -50, "ÄV": -145,
+	"ÄW": -130, "ÄY": -100, "ÄÝ": -100, "ÄŸ": -100, "Äp": -25, "Ä’": -74,
+	"Äu": -50, "Äú": -50, "Äû": -50, "Äü": -50, "Äù": -50, "Äű": -50,
+	"Äū": -50, "Äų": -50, "Äů": -50, "Äv": -100, "Äw": -90, "Äy": -74,
+	"Äý": -74, "Äÿ": -74,
+	"ÀC": -55, "ÀĆ": -55, "ÀČ": -55, "ÀÇ": -55, "ÀG": -55, "ÀĞ": -55,
+	"ÀĢ": -55, "ÀO": -45, "ÀÓ": -45, "ÀÔ": -45, "ÀÖ": -45, "ÀÒ": -45,
+	"ÀŐ": -45, "ÀŌ": -45, "ÀØ": -45, "ÀÕ": -45, "ÀQ": -45, "ÀT": -95,
+	"ÀŤ": -95, "ÀŢ": -95, "ÀU": -50, "ÀÚ": -50, "ÀÛ": -50, "ÀÜ": -50,
+	"ÀÙ": -50, "ÀŰ": -50, "ÀŪ": -50, "ÀŲ": -50, "ÀŮ": -50, "ÀV": -145,
+	"ÀW": -130, "ÀY": -100, "ÀÝ": -100, "ÀŸ": -100, "Àp": -25, "À’": -74,
+	"Àu": -50, "Àú": -50, "Àû": -50, "Àü": -50, "Àù": -50, "Àű": -50,
+	"Àū": -50, "Àų": -50, "Àů": -50, "Àv": -100, "Àw": -90, "Ày": -74,
+	"Àý": -74, "Àÿ": -74,
+	"ĀC": -55, "ĀĆ": -55, "ĀČ": -55, "ĀÇ": -55, "ĀG": -55, "ĀĞ": -55,
+	"ĀĢ": -55, "ĀO": -45, "ĀÓ": -45, "ĀÔ": -45, "ĀÖ": -45, "ĀÒ": -45,
+	"ĀŐ": -45, "ĀŌ": -45, "ĀØ": -45, "ĀÕ": -45, "ĀQ": -45, "ĀT": -95,
+	"ĀŤ": -95, "ĀŢ": -95, "ĀU": -50, "ĀÚ": -50, "ĀÛ": -50, "ĀÜ": -50,
+	"ĀÙ": -50, "ĀŰ": -50, "ĀŪ": -50, "ĀŲ": -50, "ĀŮ": -50, "ĀV": -145,
+	"ĀW": -130, "ĀY": -100, "ĀÝ": -100, "ĀŸ": -100, "Āp": -25, "Ā’": -74,
+	"Āu": -50, "Āú": -50, "Āû": -50, "Āü": -50, "Āù": -50, "Āű": -50,
+	"Āū": -50, "Āų": -50, "Āů": -50, "Āv": -100, "Āw": -90, "Āy": -74,
+	"Āý": -74, "Āÿ": -74,
+	"ĄC": -55, "ĄĆ": -55, "ĄČ": -55, "ĄÇ": -55, "ĄG": -55, "ĄĞ": -55,
+	"ĄĢ": -55, "ĄO": -45, "ĄÓ": -45, "ĄÔ": -45, "ĄÖ": -45, "ĄÒ": -45,
+	"ĄŐ": -45, "ĄŌ": -45, "ĄØ": -45, "ĄÕ": -45, "ĄQ": -45, "ĄT": -95,
+	"ĄŤ": -95, "ĄŢ": -95, "ĄU": -50, "ĄÚ": -50, "ĄÛ": -50, "ĄÜ": -50,
+	"ĄÙ": -50, "ĄŰ": -50, "ĄŪ": -50, "ĄŲ": -50, "ĄŮ": -50, "ĄV": -145,
+	"ĄW": -130, "ĄY": -100, "ĄÝ": -100, "ĄŸ": -100, "Ąp": -25, "Ą’": -74,
+	"Ąu": -50, "Ąú": -50, "Ąû": -50, "Ąü": -50, "Ąù": -50, "Ąű": -50,
+	"Ąū": -50, "Ąų": -50, "Ąů": -50, "Ąv": -100, "Ąw": -90, "Ąy": -34,
+	"Ąý": -34, "Ąÿ": -34,
+	"ÅC": -55, "ÅĆ": -55, "ÅČ": -55, "ÅÇ": -55, "ÅG": -55, "ÅĞ": -55,
+	"ÅĢ": -55, "ÅO": -45, "ÅÓ": -45, "ÅÔ": -45, "ÅÖ": -45, "ÅÒ": -45,
+	"ÅŐ": -45, "ÅŌ": -45, "ÅØ": -45, "ÅÕ": -45, "ÅQ": -45, "ÅT": -95,
+	"ÅŤ": -95, "ÅŢ": -95, "ÅU": -50, "ÅÚ": -50, "ÅÛ": -50, "ÅÜ": -50,
+	"ÅÙ": -50, "ÅŰ": -50, "ÅŪ": -50, "ÅŲ": -50, "ÅŮ": -50, "ÅV": -145,
+	"ÅW": -130, "ÅY": -100, "ÅÝ": -100, "ÅŸ": -100, "Åp": -25, "Å’": -74,
+	"Åu": -50, "Åú": -50, "Åû": -50, "Åü": -50, "Åù": -50, "Åű": -50,
+	"Åū": -50, "Åų": -50, "Åů": -50, "Åv": -100, "Åw": -90, "Åy": -74,
+	"Åý": -74, "Åÿ": -74,
+	"ÃC": -55, "ÃĆ": -55, "ÃČ": -55, "ÃÇ": -55, "ÃG": -55, "ÃĞ": -55,
+	"ÃĢ": -55, "ÃO": -45, "ÃÓ": -45, "ÃÔ": -45, "ÃÖ": -45, "ÃÒ": -45,
+	"ÃŐ": -45, "ÃŌ": -45, "ÃØ": -45, "ÃÕ": -45, "ÃQ": -45, "ÃT": -95,
+	"ÃŤ": -95, "ÃŢ": -95, "ÃU": -50, "ÃÚ": -50, "ÃÛ": -50, "ÃÜ": -50,
+	"ÃÙ": -50, "ÃŰ": -50, "ÃŪ": -50, "ÃŲ": -50, "ÃŮ": -50, "ÃV": -145,
+	"ÃW": -130, "ÃY": -100, "ÃÝ": -100, "ÃŸ": -100, "Ãp": -25, "Ã’": -74,
+	"Ãu": -50, "Ãú": -50, "Ãû": -50, "Ãü": -50, "Ãù": -50, "Ãű": -50,
+	"Ãū": -50, "Ãų": -50, "Ãů": -50, "Ãv": -100, "Ãw": -90, "Ãy": -74,
+	"Ãý": -74, "Ãÿ": -74,
+	"BA": -30, "BÁ": -30, "BĂ": -30, "BÂ": -30, "BÄ": -30, "BÀ": -30,
+	"BĀ": -30, "BĄ": -30, "BÅ": -30, "BÃ": -30, "BU": -10, "BÚ": -10,
+	"BÛ": -10, "BÜ": -10, "BÙ": -10, "BŰ": -10, "BŪ": -10, "BŲ": -10,
+	"BŮ": -10,
+	"DA": -35, "DÁ": -35, "DĂ": -35, "DÂ": -35, "DÄ": -35, "DÀ": -35,
+	"DĀ": -35, "DĄ": -35, "DÅ": -35, "DÃ": -35, "DV": -40, "DW": -40,
+	"DY": -40, "DÝ": -40, "DŸ": -40, "D.": -20,
+	"ĎA": -35, "ĎÁ": -35, "ĎĂ": -35, "ĎÂ": -35, "ĎÄ": -35, "ĎÀ": -35,
+	"ĎĀ": -35, "ĎĄ": -35, "ĎÅ": -35, "ĎÃ": -35, "ĎV": -40, "ĎW": -40,
+	"ĎY": -40, "ĎÝ": -40, "ĎŸ": -40, "Ď.": -20,
+	"ĐA": -35, "ĐÁ": -35, "ĐĂ": -35, "ĐÂ": -35, "ĐÄ": -35, "ĐÀ": -35,
+	"ĐĀ": -35, "ĐĄ": -35, "ĐÅ": -35, "ĐÃ": -35, "ĐV": -40, "ĐW": -40,
+	"ĐY": -40, "ĐÝ": -40, "ĐŸ": -40, "Đ.": -20,
+	"FA": -90, "FÁ": -90, "FĂ": -90, "FÂ": -90, "FÄ": -90, "FÀ": -90,
+	"FĀ": -90, "FĄ": -90, "FÅ": -90, "FÃ": -90, "Fa": -25, "Fá": -25,
+	"Fă": -25, "Fâ": -25, "Fä": -25, "Fà": -25, "Fā": -25, "Fą": -25,
+	"Få": -25, "Fã": -25, "F,": -92, "Fe": -25, "Fé": -25, "Fě": -25,
+	"Fê": -25, "Fë": -25, "Fė": -25, "Fè": -25, "Fē": -25, "Fę": -25,
+	"Fo": -25, "Fó": -25, "Fô": -25, "Fö": -25, "Fò": -25, "Fő": -25,
+	"Fō": -25, "Fø": -25, "Fõ": -25, "F.": -110,
+	"JA": -30, "JÁ": -30, "JĂ": -30, "JÂ": -30, "JÄ": -30, "JÀ": -30,
+	"JĀ": -30, "JĄ": -30, "JÅ": -30, "JÃ": -30, "Ja": -15, "Já": -15,
+	"Jă": -15, "Jâ": -15, "Jä": -15, "Jà": -15, "Jā": -15, "Ją": -15,
+	"Jå": -15, "Jã": -15, "Je": -15, "Jé": -15, "Jě": -15, "Jê": -15,
+	"Jë": -15, "Jė": -15, "Jè": -15, "Jē": -15, "Ję": -15, "Jo": -15,
+	"Jó": -15, "Jô": -15, "Jö": -15, "Jò": -15, "Jő": -15, "Jō": -15,
+	"Jø": -15, "Jõ": -15, "J.": -20, "Ju": -15, "Jú": -15, "Jû": -15,
+	"Jü": -15, "Jù": -15, "Jű": -15, "Jū": -15, "Jų": -15, "Jů": -15,
+	"KO": -30, "KÓ": -30, "KÔ": -30, "KÖ": -30, "KÒ": -30, "KŐ": -30,
+	"KŌ": -30, "KØ": -30, "KÕ": -30, "Ke": -25, "Ké": -25, "Kě": -25,
+	"Kê": -25, "Kë": -25, "Kė": -25, "Kè": -25, "Kē": -25, "Kę": -25,
+	"Ko": -25, "Kó": -25, "Kô": -25, "Kö": -25, "Kò": -25, "Kő": -25,
+	"Kō": -25, "Kø": -25, "Kõ": -25, "Ku": -15, "Kú": -15, "Kû": -15,
+	"Kü": -15, "Kù": -15, "Kű": -15, "Kū": -15, "Kų": -15, "Ků": -15,
+	"Ky": -45, "Ký": -45, "Kÿ": -45,
+	"ĶO": -30, "ĶÓ": -30, "ĶÔ": -30, "ĶÖ": -30, "ĶÒ": -30, "ĶŐ": -30,
+	"ĶŌ": -30, "ĶØ": -30, "ĶÕ": -30, "Ķe": -25, "Ķé": -25, "Ķě": -25,
+	"Ķê": -25, "Ķë": -25, "Ķė": -25, "Ķè": -25, "Ķē": -25, "Ķę": -25,
+	"Ķo": -25, "Ķó": -25, "Ķô": -25, "Ķö": -25, "Ķò": -25, "Ķő": -25,
+	"Ķō": -25, "Ķø": -25, "Ķõ": -25, "Ķu": -15, "Ķú": -15, "Ķû": -15,
+	"Ķü": -15, "Ķù": -15, "Ķű": -15, "Ķū": -15, "Ķų": -15, "Ķů": -15,
+	"Ķy": -45, "Ķý": -45, "Ķÿ": -45,
+	"LT": -92, "LŤ": -92, "LŢ": -92, "LV": -92, "LW": -92, "LY": -92,
+	"LÝ": -92, "LŸ": -92, "L”": -20, "L’": -110, "Ly": -55, "Lý": -55,
+	"Lÿ": -55,
+	"ĹT": -92, "ĹŤ": -92, "ĹŢ": -92, "ĹV": -92, "ĹW": -92, "ĹY": -92,
+	"ĹÝ": -92, "ĹŸ": -92, "Ĺ”": -20, "Ĺ’": -110, "Ĺy": -55, "Ĺý": -55,
+	"Ĺÿ": -55,
+	"ĻT": -92, "ĻŤ": -92, "ĻŢ": -92, "ĻV": -92, "ĻW": -92, "ĻY": -92,
+	"ĻÝ": -92, "ĻŸ": -92, "Ļ”": -20, "Ļ’": -110, "Ļy": -55, "Ļý": -55,
+	"Ļÿ": -55,
+	"ŁT": -92, "ŁŤ": -92, "ŁŢ": -92, "ŁV": -92, "ŁW": -92, "ŁY": -92,
+	"ŁÝ": -92, "ŁŸ": -92, "Ł”": -20, "Ł’": -110, "Ły": -55, "Łý": -55,
+	"Łÿ": -55,
+	"NA": -20, "NÁ": -20, "NĂ": -20, "NÂ": -20, "NÄ": -20, "NÀ": -20,
+	"NĀ": -20, "NĄ": -20, "NÅ": -20, "NÃ": -20,
+	"ŃA": -20, "ŃÁ": -20, "ŃĂ": -20, "ŃÂ": -20, "ŃÄ": -20, "ŃÀ": -20,
+	"ŃĀ": -20, "ŃĄ": -20, "ŃÅ": -20, "ŃÃ": -20,
+	"ŇA": -20, "ŇÁ": -20, "ŇĂ": -20, "ŇÂ": -20, "ŇÄ": -20, "ŇÀ": -20,
+	"ŇĀ": -20, "ŇĄ": -20, "ŇÅ": -20, "ŇÃ": -20,
+	"ŅA": -20, "ŅÁ": -20, "ŅĂ": -20, "ŅÂ": -20, "ŅÄ": -20, "ŅÀ": -20,
+	"ŅĀ": -20, "ŅĄ": -20, "ŅÅ": -20, "ŅÃ": -20,
+	"ÑA": -20, "ÑÁ": -20, "ÑĂ": -20, "ÑÂ": -20, "ÑÄ": -20, "ÑÀ": -20,
+	"ÑĀ": -20, "ÑĄ": -20, "ÑÅ": -20, "ÑÃ": -20,
+	"OA": -40, "OÁ": -40, "OĂ": -40, "OÂ": -40, "OÄ": -40, "OÀ": -40,
+	"OĀ": -40, "OĄ": -40, "OÅ": -40, "OÃ": -40, "OT": -40, "OŤ": -40,
+	"OŢ": -40, "OV": -50, "OW": -50, "OX": -40, "OY": -50, "OÝ": -50,
+	"OŸ": -50,
+	"ÓA": -40, "ÓÁ": -40, "ÓĂ": -40, "ÓÂ": -40, "ÓÄ": -40, "ÓÀ": -40,
+	"ÓĀ": -40, "ÓĄ": -40, "ÓÅ": -40, "ÓÃ": -40, "ÓT": -40, "ÓŤ": -40,
+	"ÓŢ": -40, "ÓV": -50, "ÓW": -50, "ÓX": -40, "ÓY": -50, "ÓÝ": -50,
+	"ÓŸ": -50,
+	"ÔA": -40, "ÔÁ": -40, "ÔĂ": -40, "ÔÂ": -40, "ÔÄ": -40, "ÔÀ": -40,
+	"ÔĀ": -40, "ÔĄ": -40, "ÔÅ": -40, "ÔÃ": -40, "ÔT": -40, "ÔŤ": -40,
+	"ÔŢ": -40, "ÔV": -50, "ÔW": -50, "ÔX": -40, "ÔY": -50, "ÔÝ": -50,
+	"ÔŸ": -50,
+	"ÖA": -40, "ÖÁ": -40, "ÖĂ": -40, "ÖÂ": -40, "ÖÄ": -40, "ÖÀ": -40,
+	"ÖĀ": -40, "ÖĄ": -40, "ÖÅ": -40, "ÖÃ": -40, "ÖT": -40, "ÖŤ": -40,
+	"ÖŢ": -40, "ÖV": -50, "ÖW": -50, "ÖX": -40, "ÖY": -50, "ÖÝ": -50,
+	"ÖŸ": -50,
+	"ÒA": -40, "ÒÁ": -40, "ÒĂ": -40, "ÒÂ": -40, "ÒÄ": -40, "ÒÀ": -40,
+	"ÒĀ": -40, "ÒĄ": -40, "ÒÅ": -40, "ÒÃ": -40, "ÒT": -40, "ÒŤ": -40,
+	"ÒŢ": -40, "ÒV": -50, "ÒW": -50, "ÒX": -40, "ÒY": -50, "ÒÝ": -50,
+	"ÒŸ": -50,
+	"ŐA": -40, "ŐÁ": -40, "ŐĂ": -40, "ŐÂ": -40, "ŐÄ": -40, "ŐÀ": -40,
+	"ŐĀ": -40, "ŐĄ": -40, "ŐÅ": -40, "ŐÃ": -40, "ŐT": -40, "ŐŤ": -40,
+	"ŐŢ": -40, "ŐV": -50, "ŐW": -50, "ŐX": -40, "ŐY": -50, "ŐÝ": -50,
+	"ŐŸ": -50,
+	"ŌA": -40, "ŌÁ": -40, "ŌĂ": -40, "ŌÂ": -40, "ŌÄ": -40, "ŌÀ": -40,
+	"ŌĀ": -40, "ŌĄ": -40, "ŌÅ": -40, "ŌÃ": -40, "ŌT": -40, "ŌŤ": -40,
+	"ŌŢ": -40, "ŌV": -50, "ŌW": -50, "ŌX": -40, "ŌY": -50, "ŌÝ": -50,
+	"ŌŸ": -50,
+	"ØA": -40, "ØÁ": -40, "ØĂ": -40, "ØÂ": -40, "ØÄ": -40, "ØÀ": -40,
+	"ØĀ": -40, "ØĄ": -40, "ØÅ": -40, "ØÃ": -40, "ØT": -40, "ØŤ": -40,
+	"ØŢ": -40, "ØV": -50, "ØW": -50, "ØX": -40, "ØY": -50, "ØÝ": -50,
+	"ØŸ": -50,
+	"ÕA": -40, "ÕÁ": -40, "ÕĂ": -40, "ÕÂ": -40, "ÕÄ": -40, "ÕÀ": -40,
+	"ÕĀ": -40, "ÕĄ": -40, "ÕÅ": -40, "ÕÃ": -40, "ÕT": -40, "ÕŤ": -40,
+	"ÕŢ": -40, "ÕV": -50, "ÕW": -50, "ÕX": -40, "ÕY": -50, "ÕÝ": -50,
+	"ÕŸ": -50,
+	"PA": -74, "PÁ": -74, "PĂ": -74, "PÂ": -74, "PÄ": -74, "PÀ": -74,
+	"PĀ": -74, "PĄ": -74, "PÅ": -74, "PÃ": -74, "Pa": -10, "Pá": -10,
+	"Pă": -10, "Pâ": -10, "Pä": -10, "Pà": -10, "Pā": -10, "Pą": -10,
+	"På": -10, "Pã": -10, "P,": -92, "Pe": -20, "Pé": -20, "Pě": -20,
+	"Pê": -20, "Pë": -20, "Pė": -20, "Pè": -20, "Pē": -20, "Pę": -20,
+	"Po": -20, "Pó": -20, "Pô": -20, "Pö": -20, "Pò": -20, "Pő": -20,
+	"Pō": -20, "Pø": -20, "Põ": -20, "P.": -110,
+	"QU": -10, "QÚ": -10, "QÛ": -10, "QÜ": -10, "QÙ": -10, "QŰ": -10,
+	"QŪ": -10, "QŲ": -10, "QŮ": -10, "Q.": -20,
+	"RO": -30, "RÓ": -30, "RÔ": -30, "RÖ": -30, "RÒ": -30, "RŐ": -30,
+	"RŌ": -30, "RØ": -30, "RÕ": -30, "RT": -40, "RŤ": -40, "RŢ": -40,
+	"RU": -30, "RÚ": -30, "RÛ": -30, "RÜ": -30, "RÙ": -30, "RŰ": -30,
+	"RŪ": -30, "RŲ": -30, "RŮ": -30, "RV": -55, "RW": -35, "RY": -35,
+	"RÝ": -35, "RŸ": -35,
+	"ŔO": -30, "ŔÓ": -30, "ŔÔ": -30, "ŔÖ": -30, "ŔÒ": -30, "ŔŐ": -30,
+	"ŔŌ": -30, "ŔØ": -30, "ŔÕ": -30, "ŔT": -40, "ŔŤ": -40, "ŔŢ": -40,
+	"ŔU": -30, "ŔÚ": -30, "ŔÛ": -30, "ŔÜ": -30, "ŔÙ": -30, "ŔŰ": -30,
+	"ŔŪ": -30, "ŔŲ": -30, "ŔŮ": -30, "ŔV": -55, "ŔW": -35, "ŔY": -35,
+	"ŔÝ": -35, "ŔŸ": -35,
+	"ŘO": -30, "ŘÓ": -30, "ŘÔ": -30, "ŘÖ": -30, "ŘÒ": -30, "ŘŐ": -30,
+	"ŘŌ": -30, "ŘØ": -30, "ŘÕ": -30, "ŘT": -40, "ŘŤ": -40, "ŘŢ": -40,
+	"ŘU": -30, "ŘÚ": -30, "ŘÛ": -30, "ŘÜ": -30, "ŘÙ": -30, "ŘŰ": -30,
+	"ŘŪ": -30, "ŘŲ": -30, "ŘŮ": -30, "ŘV": -55, "ŘW": -35, "ŘY": -35,
+	"ŘÝ": -35, "ŘŸ": -35,
+	"ŖO": -30, "ŖÓ": -30, "ŖÔ": -30, "ŖÖ": -30, "ŖÒ": -30, "ŖŐ": -30,
+	"ŖŌ": -30, "ŖØ": -30, "ŖÕ": -30, "ŖT": -40, "ŖŤ": -40, "ŖŢ": -40,
+	"ŖU": -30, "ŖÚ": -30, "ŖÛ": -30, "ŖÜ": -30, "ŖÙ": -30, "ŖŰ": -30,
+	"ŖŪ": -30, "ŖŲ": -30, "ŖŮ": -30, "ŖV": -55, "ŖW": -35, "ŖY": -35,
+	"ŖÝ": -35, "ŖŸ": -35,
+	"TA": -90, "TÁ": -90, "TĂ": -90, "TÂ": -90, "TÄ": -90, "TÀ": -90,
+	"TĀ": -90, "TĄ": -90, "TÅ": -90, "TÃ": -90, "TO": -18, "TÓ": -18,
+	"TÔ": -18, "TÖ": -18, "TÒ": -18, "TŐ": -18, "TŌ": -18, "TØ": -18,
+	"TÕ": -18, "Ta": -92, "Tá": -92, "Tă": -52, "Tâ": -52, "Tä": -52,
+	"Tà": -52, "Tā": -52, "Tą": -92, "Tå": -92, "Tã": -52, "T:": -74,
+	"T,": -74, "Te": -92, "Té": -92, "Tě": -92, "Tê": -92, "Të": -52,
+	"Tė": -92, "Tè": -52, "Tē": -52, "Tę": -92, "T-": -92, "Ti": -18,
+	"Tí": -18, "Tį": -18, "To": -92, "Tó": -92, "Tô": -92, "Tö": -92,
+	"Tò": -92, "Tő": -92, "Tō": -92, "Tø": -92, "Tõ": -92, "T.": -90,
+	"Tr": -74, "Tŕ": -74, "Tř": -74, "Tŗ": -74, "T;": -74, "Tu": -92,
+	"Tú": -92, "Tû": -92, "Tü": -92, "Tù": -92, "Tű": -92, "Tū": -92,
+	"Tų": -92, "Tů": -92, "Tw": -74, "Ty": -34, "Tý": -34, "Tÿ": -34,
+	"ŤA": -90, "ŤÁ": -90, "ŤĂ": -90, "ŤÂ": -90, "ŤÄ": -90, "ŤÀ": -90,
+	"ŤĀ": -90, "ŤĄ": -90, "ŤÅ": -90, "ŤÃ": -90, "ŤO": -18, "ŤÓ": -18,
+	"ŤÔ": -18, "ŤÖ": -18, "ŤÒ": -18, "ŤŐ": -18, "ŤŌ": -18, "ŤØ": -18,
+	"ŤÕ": -18, "Ťa": -92, "Ťá": -92, "Ťă": -52, "Ťâ": -52, "Ťä": -52,
+	"Ťà": -52, "Ťā": -52, "Ťą": -92, "Ťå": -92, "Ťã": -52, "Ť:": -74,
+	"Ť,": -74, "Ťe": -92, "Ťé": -92, "Ťě": -92, "Ťê": -92, "Ťë": -52,
+	"Ťė": -92, "Ťè": -52, "Ťē": -52, "Ťę": -92, "Ť-": -92, "Ťi": -18,
+	"Ťí": -18, "Ťį": -18, "Ťo": -92, "Ťó": -92, "Ťô": -92, "Ťö": -92,
+	"Ťò": -92, "Ťő": -92, "Ťō": -92, "Ťø": -92, "Ťõ": -92, "Ť.": -90,
+	"Ťr": -74, "Ťŕ": -74, "Ťř": -74, "Ťŗ": -74, "Ť;": -74, "Ťu": -92,
+	"Ťú": -92, "Ťû": -92, "Ťü": -92, "Ťù": -92, "Ťű": -92, "Ťū": -92,
+	"Ťų": -92, "Ťů": -92, "Ťw": -74, "Ťy": -34, "Ťý": -34, "Ťÿ": -34,
+	"ŢA": -90, "ŢÁ": -90, "ŢĂ": -90, "ŢÂ": -90, "ŢÄ": -90, "ŢÀ": -90,
+	"ŢĀ": -90, "ŢĄ": -90, "ŢÅ": -90, "ŢÃ": -90, "ŢO": -18, "ŢÓ": -18,
+	"ŢÔ": -18, "ŢÖ": -18, "ŢÒ": -18, "ŢŐ": -18, "ŢŌ": -18, "ŢØ": -18,
+	"ŢÕ": -18, "Ţa": -92, "Ţá": -92, "Ţă": -52, "Ţâ": -52, "Ţä": -52,
+	"Ţà": -52, "Ţā": -52, "Ţą": -92, "Ţå": -92, "Ţã": -52, "Ţ:": -74,
+	"Ţ,": -74, "Ţe": -92, "Ţé": -92, "Ţě": -92, "Ţê": -92, "Ţë": -52,
+	"Ţė": -92, "Ţè": -52, "Ţē": -52, "Ţę": -92, "Ţ-": -92, "Ţi": -18,
+	"Ţí": -18, "Ţį": -18, "Ţo": -92, "Ţó": -92, "Ţô": -92, "Ţö": -92,
+	"Ţò": -92, "Ţő": -92, "Ţō": -92, "Ţø": -92, "Ţõ": -92, "Ţ.": -90,
+	"Ţr": -74, "Ţŕ": -74, "Ţř": -74, "Ţŗ": -74, "Ţ;": -74, "Ţu": -92,
+	"Ţú": -92, "Ţû": -92, "Ţü": -92, "Ţù": -92, "Ţű": -92, "Ţū": -92,
+	"Ţų": -92, "Ţů": -92, "Ţw": -74, "Ţy": -34, "Ţý": -34, "Ţÿ": -34,
+	"UA": -60, "UÁ": -60, "UĂ": -60, "UÂ": -60, "UÄ": -60, "UÀ": -60,
+	"UĀ": -60, "UĄ": -60, "UÅ": -60, "UÃ": -60, "U,": -50, "U.": -50,
+	"ÚA": -60, "ÚÁ": -60, "ÚĂ": -60, "ÚÂ": -60, "ÚÄ": -60, "ÚÀ": -60,
+	"ÚĀ": -60, "ÚĄ": -60, "ÚÅ": -60, "ÚÃ": -60, "Ú,": -50, "Ú.": -50,
+	"ÛA": -60, "ÛÁ": -60, "ÛĂ": -60, "ÛÂ": -60, "ÛÄ": -60, "ÛÀ": -60,
+	"ÛĀ": -60, "ÛĄ": -60, "ÛÅ": -60, "ÛÃ": -60, "Û,": -50, "Û.": -50,
+	"ÜA": -60, "ÜÁ": -60, "ÜĂ": -60, "ÜÂ": -60, "ÜÄ": -60, "ÜÀ": -60,
+	"ÜĀ": -60, "ÜĄ": -60, "ÜÅ": -60, "ÜÃ": -60, "Ü,": -50, "Ü.": -50,
+	"ÙA": -60, "ÙÁ": -60, "ÙĂ": -60, "ÙÂ": -60, "ÙÄ": -60, "ÙÀ": -60,
+	"ÙĀ": -60, "ÙĄ": -60, "ÙÅ": -60, "ÙÃ": -60, "Ù,": -50, "Ù.": -50,
+	"ŰA": -60, "ŰÁ": -60, "ŰĂ": -60, "ŰÂ": -60, "ŰÄ": -60, "ŰÀ": -60,
+	"ŰĀ": -60, "ŰĄ": -60, "ŰÅ": -60, "ŰÃ": -60, "Ű,": -50, "Ű.": -50,
+	"ŪA": -60, "ŪÁ": -60, "ŪĂ": -60, "ŪÂ": -60, "ŪÄ": -60, "ŪÀ": -60,
+	"ŪĀ": -60, "ŪĄ": -60, "ŪÅ": -60, "ŪÃ": -60, "Ū,": -50, "Ū.": -50,
+	"ŲA": -60, "ŲÁ": -60, "ŲĂ": -60, "ŲÂ": -60, "ŲÄ": -60, "ŲÀ": -60,
+	"ŲĀ": -60, "ŲĄ": -60, "ŲÅ": -60, "ŲÃ": -60, "Ų,": -50, "Ų.": -50,
+	"ŮA": -60, "ŮÁ": -60, "ŮĂ": -60, "ŮÂ": -60, "ŮÄ": -60, "ŮÀ": -60,
+	"ŮĀ": -60, "ŮĄ": -60, "ŮÅ": -60, "ŮÃ": -60, "Ů,": -50, "Ů.": -50,
+	"VA": -135, "VÁ": -135, "VĂ": -135, "VÂ": -135, "VÄ": -135, "VÀ": -135,
+	"VĀ": -135, "VĄ": -135, "VÅ": -135, "VÃ": -135, "VG": -30, "VĞ": -30,
+	"VĢ": -30, "VO": -45, "VÓ": -45, "VÔ": -45, "VÖ": -45, "VÒ": -45,
+	"VŐ": -45, "VŌ": -45, "VØ": -45, "VÕ": -45, "Va": -92, "Vá": -92,
+	"Vă": -92, "Vâ": -92, "Vä": -92, "Và": -92, "Vā": -92, "Vą": -92,
+	"Vå": -92, "Vã": -92, "V:": -92, "V,": -129, "Ve": -100, "Vé": -100,
+	"Vě": -100, "Vê": -100, "Vë": -100, "Vė": -100, "Vè": -100, "Vē": -100,
+	"Vę": -100, "V-": -74, "Vi": -37, "Ví": -37, "Vî": -37, "Vï": -37,
+	"Vì": -37, "Vī": -37, "Vį": -37, "Vo": -100, "Vó": -100, "Vô": -100,
+	"Vö": -100, "Vò": -100, "Vő": -100, "Vō": -100, "Vø": -100, "Võ": -100,
+	"V.": -145, "V;": -92, "Vu": -92, "Vú": -92, "Vû": -92, "Vü": -92,
+	"Vù": -92, "Vű": -92, "Vū": -92, "Vų": -92, "Vů": -92,
+	"WA": -120, "WÁ": -120, "WĂ": -120, "WÂ": -120, "WÄ": -120, "WÀ": -120,
+	"WĀ": -120, "WĄ": -120, "WÅ": -120, "WÃ": -120, "WO": -10, "WÓ": -10,
+	"WÔ": -10, "WÖ": -10, "WÒ": -10, "WŐ": -10, "WŌ": -10, "WØ": -10,
+	"WÕ": -10, "Wa": -65, "Wá": -65, "Wă": -65, "Wâ": -65, "Wä": -65,
+	"Wà": -65, "Wā": -65, "Wą": -65, "Wå": -65, "Wã": -65, "W:": -55,
+	"W,": -92, "We": -65, "Wé": -65, "Wě": -65, "Wê": -65, "Wë": -65,
+	"Wė": -65, "Wè": -65, "Wē": -65, "Wę": -65, "W-": -37, "Wi": -18,
+	"Wí": -18, "Wį": -18, "Wo": -75, "Wó": -75, "Wô": -75, "Wö": -75,
+	"Wò": -75, "Wő": -75, "Wō": -75, "Wø": -75, "Wõ": -75, "W.": -92,
+	"W;": -55, "Wu": -50, "Wú": -50, "Wû": -50, "Wü": -50, "Wù": -50,
+	"Wű": -50, "Wū": -50, "Wų": -50, "Wů": -50, "Wy": -60, "Wý": -60,
+	"Wÿ": -60,
+	"YA": -110, "YÁ": -110, "YĂ": -110, "YÂ": -110, "YÄ": -110, "YÀ": -110,
+	"YĀ": -110, "YĄ": -110, "YÅ": -110, "YÃ": -110, "YO": -35, "YÓ": -35,
+	"YÔ": -35, "YÖ": -35, "YÒ": -35, "YŐ": -35, "YŌ": -35, "YØ": -35,
+	"YÕ": -35, "Ya": -85, "Yá": -85, "Yă": -85, "Yâ": -85, "Yä": -85,
+	"Yà": -85, "Yā": -85, "Yą": -85, "Yå": -85, "Yã": -85, "Y:": -92,
+	"Y,": -92, "Ye": -111, "Yé": -111, "Yě": -111, "Yê": -111, "Yë": -71,
+	"Yė": -111, "Yè": -71, "Yē": -71, "Yę": -111, "Y-": -92, "Yi": -37,
+	"Yí": -37, "Yį": -37, "Yo": -111, "Yó": -111, "Yô": -111, "Yö": -111,
+	"Yò": -111, "Yő": -111, "Yō": -111, "Yø": -111, "Yõ": -111, "Y.": -92,
+	"Y;": -92, "Yu": -92, "Yú": -92, "Yû": -92, "Yü": -92, "Yù": -92,
+	"Yű": -92, "Yū": -92, "Yų": -92, "Yů": -92,
+	"ÝA": -110, "ÝÁ": -110, "ÝĂ": -110, "ÝÂ": -110, "ÝÄ": -110, "ÝÀ": -110,
+	"ÝĀ": -110, "ÝĄ": -110, "ÝÅ": -110, "ÝÃ": -110, "ÝO": -35, "ÝÓ": -35,
+	"ÝÔ": -35, "ÝÖ": -35, "ÝÒ": -35, "ÝŐ": -35, "ÝŌ": -35, "ÝØ": -35,
+	"ÝÕ": -35, "Ýa": -85, "Ýá": -85, "Ýă": -85, "Ýâ": -85, "Ýä": -85,
+	"Ýà": -85, "Ýā": -85, "Ýą": -85, "Ýå": -85, "Ýã": -85, "Ý:": -92,
+	"Ý,": -92, "Ýe": -111, "Ýé": -111, "Ýě": -111, "Ýê": -111, "Ýë": -71,
+	"Ýė": -111, "Ýè": -71, "Ýē": -71, "Ýę": -111, "Ý-": -92, "Ýi": -37,
+	"Ýí": -37, "Ýį": -37, "Ýo": -111, "Ýó": -111, "Ýô": -111, "Ýö": -111,
+	"Ýò": -111, "Ýő": -111, "Ýō": -111, "Ýø": -111, "Ýõ": -111, "Ý.": -92,
+	"Ý;": -92, "Ýu": -92, "Ýú": -92, "Ýû": -92, "Ýü": -92, "Ýù": -92,
+	"Ýű": -92, "Ýū": -92, "Ýų": -92, "Ýů": -92,
+	"ŸA": -110, "ŸÁ": -110, "ŸĂ": -110, "ŸÂ": -110, "ŸÄ": -110, "ŸÀ": -110,
+	"ŸĀ": -110, "ŸĄ": -110, "ŸÅ": -110, "ŸÃ": -110, "ŸO": -35, "ŸÓ": -35,
+	"ŸÔ": -35, "ŸÖ": -35, "ŸÒ": -35, "ŸŐ": -35, "ŸŌ": -35, "ŸØ": -35,
+	"ŸÕ": -35, "Ÿa": -85, "Ÿá": -85, "Ÿă": -85, "Ÿâ": -85, "Ÿä": -85,
+	"Ÿà": -85, "Ÿā": -85, "Ÿą": -85, "Ÿå": -85, "Ÿã": -85, "Ÿ:": -92,
+	"Ÿ,": -92, "Ÿe": -111, "Ÿé": -111, "Ÿě": -111, "Ÿê": -111, "Ÿë": -71,
+	"Ÿė": -111, "Ÿè": -71, "Ÿē": -71, "Ÿę": -111, "Ÿ-": -92, "Ÿi": -37,
+	"Ÿí": -37, "Ÿį": -37, "Ÿo": -111, "Ÿó": -111, "Ÿô": -111, "Ÿö": -111,
+	"Ÿò": -111, "Ÿő": -111, "Ÿō": -111, "Ÿø": -111, "Ÿõ": -111, "Ÿ.": -92,
+	"Ÿ;": -92, "Ÿu": -92, "Ÿú": -92, "Ÿû": -92, "Ÿü": -92, "Ÿù": -92,
+	"Ÿű": -92, "Ÿū": -92, "Ÿų": -92, "Ÿů": -92,
+	"av": -25,
+	"áv": -25,
+	"ăv": -25,
+	"âv": -25,
+	"äv": -25,
+	"àv": -25,
+	"āv": -25,
+	"ąv": -25,
+	"åv": -25,
+	"ãv": -25,
+	"bb": -10, "b.": -40, "bu": -20, "bú": -20, "bû": -20, "bü": -20,
+	"bù": -20, "bű": -20, "bū": -20, "bų": -20, "bů": -20, "bv": -15,
+	",”": -45, ",’": -55,
+	"dw": -15,
+	"đw": -15,
+	"ev": -15,
+	"év": -15,
+	"ěv": -15,
+	"êv": -15,
+	"ëv": -15,
+	"ėv": -15,
+	"èv": -15,
+	"ēv": -15,
+	"ęv": -15,
+	"f,": -15, "fı": -35, "fi": -25, "fo": -25, "fó": -25, "fô": -25,
+	"fö": -25, "fò": -25, "fő": -25, "fō": -25, "fø": -25, "fõ": -25,
+	"f.": -15, "f”": 50, "f’": 55,
+	"g.": -15,
+	"ğ.": -15,
+	"ģ.": -15,
+	"hy": -15, "hý": -15, "hÿ": -15,
+	"iv": -10,
+	"ív": -10,
+	"îv": -10,
+	"ïv": -10,
+	"ìv": -10,
+	"īv": -10,
+	"įv": -10,
+	"ke": -10, "ké": -10, "kě": -10, "kê": -10, "kë": -10, "kė": -10,
+	"kè": -10, "kē": -10, "kę": -10, "ko": -15, "kó": -15, "kô": -15,
+	"kö": -15, "kò": -15, "kő": -15, "kō": -15, "kø": -15, "kõ": -15,
+	"ky": -15, "ký": -15, "kÿ": -15,
+	"ķe": -10, "ķé": -10, "ķě": -10, "ķê": -10, "ķë": -10, "ķė": -10,
+	"ķè": -10, "ķē": -10, "ķę": -10, "ķo": -15, "ķó": -15, "ķô": -15,
+	"ķö": -15, "ķò": -15, "ķő": -15, "ķō": -15, "ķø": -15, "ķõ": -15,
+	"ķy": -15, "ķý": -15, "ķÿ": -15,
+	"nv": -40,
+	"ńv": -40,
+	"ňv": -40,
+	"ņv": -40,
+	"ñv": -40,
+	"ov": -10, "ow": -10,
+	"óv": -10, "ów": -10,
+	"ôv": -10, "ôw": -10,
+	"öv": -10, "öw": -10,
+	"òv": -10, "òw": -10,
+	"őv": -10, "őw": -10,
+	"ōv": -10, "ōw": -10,
+	"øv": -10, "øw": -10,
+	"õv": -10, "õw": -10,
+	".”": -55, ".’": -55,
+	"“A": -10, "“Á": -10, "“Ă": -10, "“Â": -10, "“Ä": -10, "“À": -10,
+	"“Ā": -10, "“Ą": -10, "“Å": -10, "“Ã": -10,
+	"‘A": -10, "‘Á": -10, "‘Ă": -10, "‘Â": -10, "‘Ä": -10, "‘À": -10,
+	"‘Ā": -10, "‘Ą": -10, "‘Å": -10, "‘Ã": -10, "‘‘": -63,
+	"’d": -20, "’đ": -20, "’’": -63, "’r": -20, "’ŕ": -20, "’ř": -20,
+	"’ŗ": -20, "’s": -37, "’ś": -37, "’š": -37, "’ş": -37, "’ș": -37,
+	"’ ": -74, "’v": -20,
+	"rc": -18, "rć": -18, "rč": -18, "rç": -18, "r,": -92, "re": -18,
+	"ré": -18, "rě": -18, "rê": -18, "rë": -18, "rė": -18, "rè": -18,
+	"rē": -18, "rę": -18, "rg": -10, "rğ": -10, "rģ": -10, "r-": -37,
+	"rn": -15, "rń": -15, "rň": -15, "rņ": -15, "rñ": -15, "ro": -18,
+	"ró": -18, "rô": -18, "rö": -18, "rò": -18, "rő": -18, "rō": -18,
+	"rø": -18, "rõ": -18, "rp": -10, "r.": -100, "rq": -18, "rv": -10,
+	"ŕc": -18, "ŕć": -18, "ŕč": -18, "ŕç": -18, "ŕ,": -92, "ŕe": -18,
+	"ŕé": -18, "ŕě": -18, "ŕê": -18, "ŕë": -18, "ŕė": -18, "ŕè": -18,
+	"ŕē": -18, "ŕę": -18, "ŕg": -10, "ŕğ": -10, "ŕģ": -10, "ŕ-": -37,
+	"ŕn": -15, "ŕń": -15, "ŕň": -15, "ŕņ": -15, "ŕñ": -15, "ŕo": -18,
+	"ŕó": -18, "ŕô": -18, "ŕö": -18, "ŕò": -18, "ŕő": -18, "ŕō": -18,
+	"ŕø": -18, "ŕõ": -18, "ŕp": -10, "ŕ.": -100, "ŕq": -18, "ŕv": -10,
+	"řc": -18, "řć": -18, "řč": -18, "řç": -18, "ř,": -92, "ře": -18,
+	"řé": -18, "řě": -18, "řê": -18, "řë": -18, "řė": -18, "řè": -18,
+	"řē": -18, "řę": -18, "řg": -10, "řğ": -10, "řģ": -10, "ř-": -37,
+	"řn": -15, "řń": -15, "řň": -15, "řņ": -15, "řñ": -15, "řo": -18,
+	"řó": -18, "řô": -18, "řö": -18, "řò": -18, "řő": -18, "řō": -18,
+	"řø": -18, "řõ": -18, "řp": -10, "ř.": -100, "řq": -18, "řv": -10,
+	"ŗc": -18, "ŗć": -18, "ŗč": -18, "ŗç": -18, "ŗ,": -92, "ŗe": -18,
+	"ŗé": -18, "ŗě": -18, "ŗê": -18, "ŗë": -18, "ŗė": -18, "ŗè": -18,
+	"ŗē": -18, "ŗę": -18, "ŗg": -10, "ŗğ": -10, "ŗģ": -10, "ŗ-": -37,
+	"ŗn": -15, "ŗń": -15, "ŗň": -15, "ŗņ": -15, "ŗñ": -15, "ŗo": -18,
+	"ŗó": -18, "ŗô": -18, "ŗö": -18, "ŗò": -18, "ŗő": -18, "ŗō": -18,
+	"ŗø": -18, "ŗõ": -18, "ŗp": -10, "ŗ.": -100, "ŗq": -18, "ŗv": -10,
+	" A": -55, " Á": -55, " Ă": -55, " Â": -55, " Ä": -55, " À": -55,
+	" Ā": -55, " Ą": -55, " Å": -55, " Ã": -55, " T": -30, " Ť": -30,
+	" Ţ": -30, " V": -45, " W": -30, " Y": -55, " Ý": -55, " Ÿ": -55,
+	"va": -10, "vá": -10, "vă": -10, "vâ": -10, "vä": -10, "và": -10,
+	"vā": -10, "vą": -10, "vå": -10, "vã": -10, "v,": -55, "ve": -10,
+	"vé": -10, "vě": -10, "vê": -10, "vë": -10, "vė": -10, "vè": -10,
+	"vē": -10, "vę": -10, "vo": -10, "vó": -10, "vô": -10, "vö": -10,
+	"vò": -10, "vő": -10, "vō": -10, "vø": -10, "võ": -10, "v.": -70,
+	"w,": -55, "wo": -10, "wó": -10, "wô": -10, "wö": -10, "wò": -10,
+	"wő": -10, "wō": -10, "wø": -10, "wõ": -10, "w.": -70,
+	"y,": -55, "ye": -10, "yé": -10, "yě": -10, "yê": -10, "yë": -10,
+	"yė": -10, "yè": -10, "yē": -10, "yę": -10, "yo": -25, "yó": -25,
+	"yô": -25, "yö": -25, "yò": -25, "yő": -25, "yō": -25, "yø": -25,
+	"yõ": -25, "y.": -70,
+	"ý,": -55, "ýe": -10, "ýé": -10, "ýě": -10, "ýê": -10, "ýë": -10,
+	"ýė": -10, "ýè": -10, "ýē": -10, "ýę": -10, "ýo": -25, "ýó": -25,
+	"ýô": -25, "ýö": -25, "ýò": -25, "ýő": -25, "ýō": -25, "ýø": -25,
+	"ýõ": -25, "ý.": -70,
+	"ÿ,": -55, "ÿe": -10, "ÿé": -10, "ÿě": -10, "ÿê": -10, "ÿë": -10,
+	"ÿė": -10, "ÿè": -10, "ÿē": -10, "ÿę": -10, "ÿo": -25, "ÿó": -25,
+	"ÿô": -25, "ÿö": -25, "ÿò": -25, "ÿő": -25, "ÿō": -25, "ÿø": -25,
+	"ÿõ": -25, "ÿ.": -70,
+} //                                                            pdfKernTimesBold
+
+// pdfKernTimesBoldItalic is the kerning of Times-BoldItalic, from
+// Times-BoldItalic.afm version 002.000 (UniqueID 43066)
+var pdfKernTimesBoldItalic = map[string]int{
+	"AC": -65, "AĆ": -65, "AČ": -65, "AÇ": -65, "AG": -60, "AĞ": -60,
+	"AĢ": -60, "AO": -50, "AÓ": -50, "AÔ": -50, "AÖ": -50, "AÒ": -50,
+	"AŐ": -50, "AŌ": -50, "AØ": -50, "AÕ": -50, "AQ": -55, "AT": -55,
+	"AŤ": -55, "AŢ": -55, "AU": -50, "AÚ": -50, "AÛ": -50, "AÜ": -50,
+	"AÙ": -50, "AŰ": -50, "AŪ": -50, "AŲ": -50, "AŮ": -50, "AV": -95,
+	"AW": -100, "AY": -70, "AÝ": -70, "AŸ": -70, "A’": -74, "Au": -30,
+	"Aú": -30, "Aû": -30, "Aü": -30, "Aù": -30, "Aű": -30, "Aū": -30,
+	"Aų": -30, "Aů": -30, "Av": -74, "Aw": -74, "Ay": -74, "Aý": -74,
+	"Aÿ": -74,
+	"ÁC": -65, "ÁĆ": -65, "ÁČ": -65, "ÁÇ": -65, "ÁG": -60, "ÁĞ": -60,
+	"ÁĢ": -60, "ÁO": -50, "ÁÓ": -50, "ÁÔ": -50, "ÁÖ": -50, "ÁÒ": -50,
+	"ÁŐ": -50, "ÁŌ": -50, "ÁØ": -50, "ÁÕ": -50, "ÁQ": -55, "ÁT": -55,
+	"ÁŤ": -55, "ÁŢ": -55, "ÁU": -50, "ÁÚ": -50, "ÁÛ": -50, "ÁÜ": -50,
+	"ÁÙ": -50, "ÁŰ": -50, "ÁŪ": -50, "ÁŲ": -50, "ÁŮ": -50, "ÁV": -95,
+	"ÁW": -100, "ÁY": -70, "ÁÝ": -70, "ÁŸ": -70, "Á’": -74, "Áu": -30,
+	"Áú": -30, "Áû": -30, "Áü": -30, "Áù": -30, "Áű": -30, "Áū": -30,
+	"Áų": -30, "Áů": -30, "Áv": -74, "Áw": -74, "Áy": -74, "Áý": -74,
+	"Áÿ": -74,
+	"ĂC": -65, "ĂĆ": -65, "ĂČ": -65, "ĂÇ": -65, "ĂG": -60, "ĂĞ": -60,
+	"ĂĢ": -60, "ĂO": -50, "ĂÓ": -50, "ĂÔ": -50, "ĂÖ": -50, "ĂÒ": -50,
+	"ĂŐ": -50, "ĂŌ": -50, "ĂØ": -50, "ĂÕ": -50, "ĂQ": -55, "ĂT": -55,
+	"ĂŤ": -55, "ĂŢ": -55, "ĂU": -50, "ĂÚ": -50, "ĂÛ": -50, "ĂÜ": -50,
+	"ĂÙ": -50, "ĂŰ": -50, "ĂŪ": -50, "ĂŲ": -50, "ĂŮ": -50, "ĂV": -95,
+	"ĂW": -100, "ĂY": -70, "ĂÝ": -70, "ĂŸ": -70, "Ă’": -74, "Ău": -30,
+	"Ăú": -30, "Ăû": -30, "Ăü": -30, "Ăù": -30, "Ăű": -30, "Ăū": -30,
+	"Ăų": -30, "Ăů": -30, "Ăv": -74, "Ăw": -74, "Ăy": -74, "Ăý": -74,
+	"Ăÿ": -74,
+	"ÂC": -65, "ÂĆ": -65, "ÂČ": -65, "ÂÇ": -65, "ÂG": -60, "ÂĞ": -60,
+	"ÂĢ": -60, "ÂO": -50, "ÂÓ": -50, "ÂÔ": -50, "ÂÖ": -50, "ÂÒ": -50,
+	"ÂŐ": -50, "ÂŌ": -50, "ÂØ": -50, "ÂÕ": -50, "ÂQ": -55, "ÂT": -55,
+	"ÂŤ": -55, "ÂŢ": -55, "ÂU": -50, "ÂÚ": -50, "ÂÛ": -50, "ÂÜ": -50,
+	"ÂÙ": -50, "ÂŰ": -50, "ÂŪ": -50, "ÂŲ": -50, "ÂŮ": -50, "ÂV": -95,
+	"ÂW": -100, "ÂY": -70, "ÂÝ": -70, "ÂŸ": -70, "Â’": -74, "Âu": -30,
+	"Âú": -30, "Âû": -30, "Âü": -30, "Âù": -30, "Âű": -30, "Âū": -30,
+	"Âų": -30, "Âů": -30, "Âv": -74, "Âw": -74, "Ây": -74, "Âý": -74,
+	"Âÿ": -74,
+	"ÄC": -65, "ÄĆ": -65, "ÄČ": -65, "ÄÇ": -65, "ÄG": -60, "ÄĞ": -60,
+	"ÄĢ": -60, "ÄO": -50, "ÄÓ": -50, "ÄÔ": -50, "ÄÖ": -50, "ÄÒ": -50,
+	"ÄŐ": -50, "ÄŌ": -50, "ÄØ": -50, "ÄÕ": -50, "ÄQ": -55, "ÄT": -55,
+	"ÄŤ": -55, "ÄŢ": -55, "ÄU": -50, "ÄÚ": -50, "ÄÛ": -50, "ÄÜ": -50,
+	"ÄÙ": -50, "ÄŰ": -50, "ÄŪ": -50, "ÄŲ": -50, "ÄŮ": -50, "ÄV": -95,
+	"ÄW": -100, "ÄY": -70, "ÄÝ": -70, "ÄŸ": -70, "Ä’": -74, "Äu": -30,
+	"Äú": -30, "Äû": -30, "Äü": -30, "Äù": -30, "Äű": -30, "Äū": -30,
+	"Äų": -30, "Äů": -30, "Äv": -74, "Äw": -74, "Äy": -74, "Äý": -74,
+	"Äÿ": -74,
+	"ÀC": -65, "ÀĆ": -65, "ÀČ": -65, "ÀÇ": -65, "ÀG": -60, "ÀĞ": -60,
+	"ÀĢ": -60, "ÀO": -50, "ÀÓ": -50, "ÀÔ": -50, "ÀÖ": -50, "ÀÒ": -50,
+	"ÀŐ": -50, "ÀŌ": -50, "ÀØ": -50, "ÀÕ": -50, "ÀQ": -55, "ÀT": -55,
+	"ÀŤ": -55, "ÀŢ": -55, "ÀU": -50, "ÀÚ": -50, "ÀÛ": -50, "ÀÜ": -50,
+	"ÀÙ": -50, "ÀŰ": -50, "ÀŪ": -50, "ÀŲ": -50, "ÀŮ": -50, "ÀV": -95,
+	"ÀW": -100, "ÀY": -70, "ÀÝ": -70, "ÀŸ": -70, "À’": -74, "Àu": -30,
+	"Àú": -30, "Àû": -30, "Àü": -30, "Àù": -30, "Àű": -30, "Àū": -30,
+	"Àų": -30, "Àů": -30, "Àv": -74, "Àw": -74, "Ày": -74, "Àý": -74,
+	"Àÿ": -74,
+	"ĀC": -65, "ĀĆ": -65, "ĀČ": -65, "ĀÇ": -65, "ĀG": -60, "ĀĞ": -60,
+	"ĀĢ": -60, "ĀO": -50, "ĀÓ": -50, "ĀÔ": -50, "ĀÖ": -50, "ĀÒ": -50,
+	"ĀŐ": -50, "ĀŌ": -50, "ĀØ": -50, "ĀÕ": -50, "ĀQ": -55, "ĀT": -55,
+	"ĀŤ": -55, "ĀŢ": -55, "ĀU": -50, "ĀÚ": -50, "ĀÛ": -50, "ĀÜ": -50,
+	"ĀÙ": -50, "ĀŰ": -50, "ĀŪ": -50, "ĀŲ": -50, "ĀŮ": -50, "ĀV": -95,
+	"ĀW": -100, "ĀY": -70, "ĀÝ": -70, "ĀŸ": -70, "Ā’": -74, "Āu": -30,
+	"Āú": -30, "Āû": -30, "Āü": -30, "Āù": -30, "Āű": -30, "Āū": -30,
+	"Āų": -30, "Āů": -30, "Āv": -74, "Āw": -74, "Āy": -74, "Āý": -74,
+	"Āÿ": -74,
+	"ĄC": -65, "ĄĆ": -65, "ĄČ": -65, "ĄÇ": -65, "ĄG": -60, "ĄĞ": -60,
+	"ĄĢ": -60, "ĄO": -50, "ĄÓ": -50, "ĄÔ": -50, "ĄÖ": -50, "ĄÒ": -50,
+	"ĄŐ": -50, "ĄŌ": -50, "ĄØ": -50, "ĄÕ": -50, "ĄQ": -55, "ĄT": -55,
+	"ĄŤ": -55, "ĄŢ": -55, "ĄU": -50, "ĄÚ": -50, "ĄÛ": -50, "ĄÜ": -50,
+	"ĄÙ": -50, "ĄŰ": -50, "ĄŪ": -50, "ĄŲ": -50, "ĄŮ": -50, "ĄV": -95,
+	"ĄW": -100, "ĄY": -70, "ĄÝ": -70, "ĄŸ": -70, "Ą’": -74, "Ąu": -30,
+	"Ąú": -30, "Ąû": -30, "Ąü": -30, "Ąù": -30, "Ąű": -30, "Ąū": -30,
+	"Ąų": -30, "Ąů": -30, "Ąv": -74, "Ąw": -74, "Ąy": -34, "Ąý": -34,
+	"Ąÿ": -34,
+	"ÅC": -65, "ÅĆ": -65, "ÅČ": -65, "ÅÇ": -65, "ÅG": -60, "ÅĞ": -60,
+	"ÅĢ": -60, "ÅO": -50, "ÅÓ": -50, "ÅÔ": -50, "ÅÖ": -50, "ÅÒ": -50,
+	"ÅŐ": -50, "ÅŌ": -50, "ÅØ": -50, "ÅÕ": -50, "ÅQ": -55, "ÅT": -55,
+	"ÅŤ": -55, "ÅŢ": -55, "ÅU": -50, "ÅÚ": -50, "ÅÛ": -50, "ÅÜ": -50,
+	"ÅÙ": -50, "ÅŰ": -50, "ÅŪ": -50, "ÅŲ": -50, "ÅŮ": -50, "ÅV": -95,
+	"ÅW": -100, "ÅY": -70, "ÅÝ": -70, "ÅŸ": -70, "Å’": -74, "Åu": -30,
+	"Åú": -30, "Åû": -30, "Åü": -30, "Åù": -30, "Åű": -30, "Åū": -30,
+	"Åų": -30, "Åů": -30, "Åv": -74, "Åw": -74, "Åy": -74, "Åý": -74,
+	"Åÿ": -74,
+	"ÃC": -65, "ÃĆ": -65, "ÃČ": -65, "ÃÇ": -65, "ÃG": -60, "ÃĞ": -60,
+	"ÃĢ": -60, "ÃO": -50, "ÃÓ": -50, "ÃÔ": -50, "ÃÖ": -50, "ÃÒ": -50,
+	"ÃŐ": -50, "ÃŌ": -50, "ÃØ": -50, "ÃÕ": -50, "ÃQ": -55, "ÃT": -55,
+	"ÃŤ": -55, "ÃŢ": -55, "ÃU": -50, "ÃÚ": -50, "ÃÛ": -50, "ÃÜ": -50,
+	"ÃÙ": -50, "ÃŰ": -50, "ÃŪ": -50, "ÃŲ": -50, "ÃŮ": -50, "ÃV": -95,
+	"ÃW": -100, "ÃY": -70, "ÃÝ": -70, "ÃŸ": -70, "Ã’": -74, "Ãu": -30,
+	"Ãú": -30, "Ãû": -30, "Ãü": -30, "Ãù": -30, "Ãű": -30, "Ãū": -30,
+	"Ãų": -30, "Ãů": -30, "Ãv": -74, "Ãw": -74, "Ãy": -74, "Ãý": -74,
+	"Ãÿ": -74,
+	"BA": -25, "BÁ": -25, "BĂ": -25, "BÂ": -25, "BÄ": -25, "BÀ": -25,
+	"BĀ": -25, "BĄ": -25, "BÅ": -25, "BÃ": -25, "BU": -10, "BÚ": -10,
+	"BÛ": -10, "BÜ": -10, "BÙ": -10, "BŰ": -10, "BŪ": -10, "BŲ": -10,
+	"BŮ": -10,
+	"DA": -25, "DÁ": -25, "DĂ": -25, "DÂ": -25, "DÄ": -25, "DÀ": -25,
+	"DĀ": -25, "DĄ": -25, "DÅ": -25, "DÃ": -25, "DV": -50, "DW": -40,
+	"DY": -50, "DÝ": -50, "DŸ": -50,
+	"ĎA": -25, "ĎÁ": -25, "ĎĂ": -25, "ĎÂ": -25, "ĎÄ": -25, "ĎÀ": -25,
+	"ĎĀ": -25, "ĎĄ": -25, "ĎÅ": -25, "ĎÃ": -25, "ĎV": -50, "ĎW": -40,
+	"ĎY": -50, "ĎÝ": -50, "ĎŸ": -50,
+	"ĐA": -25, "ĐÁ": -25, "ĐĂ": -25, "ĐÂ": -25, "ĐÄ": -25, "ĐÀ": -25,
+	"ĐĀ": -25, "ĐĄ": -25, "ĐÅ": -25, "ĐÃ": -25, "ĐV": -50, "ĐW": -40,
+	"ĐY": -50, "ĐÝ": -50, "ĐŸ": -50,
+	"FA": -100, "FÁ": -100, "FĂ": -100, "FÂ": -100, "FÄ": -100, "FÀ": -100,
+	"FĀ": -100, "FĄ": -100, "FÅ": -100, "FÃ": -100, "Fa": -95, "Fá": -95,
+	"Fă": -95, "Fâ": -95, "Fä": -95, "Fà": -95, "Fā": -95, "Fą": -95,
+	"Få": -95, "Fã": -95, "F,": -129, "Fe": -100, "Fé": -100, "Fě": -100,
+	"Fê": -100, "Fë": -100, "Fė": -100, "Fè": -100, "Fē": -100, "Fę": -100,
+	"Fi": -40, "Fí": -40, "Fî": -40, "Fï": -40, "Fì": -40, "Fī": -40,
+	"Fį": -40, "Fo": -70, "Fó": -70, "Fô": -70, "Fö": -70, "Fò": -70,
+	"Fő": -70, "Fō": -70, "Fø": -70, "Fõ": -70, "F.": -129, "Fr": -50,
+	"Fŕ": -50, "Fř": -50, "Fŗ": -50,
+	"JA": -25, "JÁ": -25, "JĂ": -25, "JÂ": -25, "JÄ": -25, "JÀ": -25,
+	"JĀ": -25, "JĄ": -25, "JÅ": -25, "JÃ": -25, "Ja": -40, "Já": -40,
+	"Jă": -40, "Jâ": -40, "Jä": -40, "Jà": -40, "Jā": -40, "Ją": -40,
+	"Jå": -40, "Jã": -40, "J,": -10, "Je": -40, "Jé": -40, "Jě": -40,
+	"Jê": -40, "Jë": -40, "Jė": -40, "Jè": -40, "Jē": -40, "Ję": -40,
+	"Jo": -40, "Jó": -40, "Jô": -40, "Jö": -40, "Jò": -40, "Jő": -40,
+	"Jō": -40, "Jø": -40, "Jõ": -40, "J.": -10, "Ju": -40, "Jú": -40,
+	"Jû": -40, "Jü": -40, "Jù": -40, "Jű": -40, "Jū": -40, "Jų": -40,
+	"Jů": -40,
+	"KO": -30, "KÓ": -30, "KÔ": -30, "KÖ": -30, "KÒ": -30, "KŐ": -30,
+	"KŌ": -30, "KØ": -30, "KÕ": -30, "Ke": -25, "Ké": -25, "Kě": -25,
+	"Kê": -25, "Kë": -25, "Kė": -25, "Kè": -25, "Kē": -25, "Kę": -25,
+	"Ko": -25, "Kó": -25, "Kô": -25, "Kö": -25, "Kò": -25, "Kő": -25,
+	"Kō": -25, "Kø": -25, "Kõ": -25, "Ku": -20, "Kú": -20, "Kû": -20,
+	"Kü": -20, "Kù": -20, "Kű": -20, "Kū": -20, "Kų": -20, "Ků": -20,
+	"Ky": -20, "Ký": -20, "Kÿ": -20,
+	"ĶO": -30, "ĶÓ": -30, "ĶÔ": -30, "ĶÖ": -30, "ĶÒ": -30, "ĶŐ": -30,
+	"ĶŌ": -30, "ĶØ": -30, "ĶÕ": -30, "Ķe": -25, "Ķé": -25, "Ķě": -25,
+	"Ķê": -25, "Ķë": -25, "Ķė": -25, "Ķè": -25, "Ķē": -25, "Ķę": -25,
+	"Ķo": -25, "Ķó": -25, "Ķô": -25, "Ķö": -25, "Ķò": -25, "Ķő": -25,
+	"Ķō": -25, "Ķø": -25, "Ķõ": -25, "Ķu": -20, "Ķú": -20, "Ķû": -20,
+	"Ķü": -20, "Ķù": -20, "Ķű": -20, "Ķū": -20, "Ķų": -20, "Ķů": -20,
+	"Ķy": -20, "Ķý": -20, "Ķÿ": -20,
+	"LT": -18, "LŤ": -18, "LŢ": -18, "LV": -37, "LW": -37, "LY": -37,
+	"LÝ": -37, "LŸ": -37, "L’": -55, "Ly": -37, "Lý": -37, "Lÿ": -37,
+	"ĹT": -18, "ĹŤ": -18, "ĹŢ": -18, "ĹV": -37, "ĹW": -37, "ĹY": -37,
+	"ĹÝ": -37, "ĹŸ": -37, "Ĺ’": -55, "Ĺy": -37, "Ĺý": -37, "Ĺÿ": -37,
+	"ĻT": -18, "ĻŤ": -18, "ĻŢ": -18, "ĻV": -37, "ĻW": -37, "ĻY": -37,
+	"ĻÝ": -37, "ĻŸ": -37, "Ļ’": -55, "Ļy": -37, "Ļý": -37, "Ļÿ": -37,
+	"ŁT": -18, "ŁŤ": -18, "ŁŢ": -18, "ŁV": -37, "ŁW": -37, "ŁY": -37,
+	"ŁÝ": -37, "ŁŸ": -37, "Ł’": -55, "Ły": -37, "Łý": -37, "Łÿ": -37,
+	"NA": -30, "NÁ": -30, "NĂ": -30, "NÂ": -30, "NÄ": -30, "NÀ": -30,
+	"NĀ": -30, "NĄ": -30, "NÅ": -30, "NÃ": -30,
+	"ŃA": -30, "ŃÁ": -30, "ŃĂ": -30, "ŃÂ": -30, "ŃÄ": -30, "ŃÀ": -30,
+	"ŃĀ": -30, "ŃĄ": -30, "ŃÅ": -30, "ŃÃ": -30,
+	"ŇA": -30, "ŇÁ": -30, "ŇĂ": -30, "ŇÂ": -30, "ŇÄ": -30, "ŇÀ": -30,
+	"ŇĀ": -30, "ŇĄ": -30, "ŇÅ": -30, "ŇÃ": -30,
+	"ŅA": -30, "ŅÁ": -30, "ŅĂ": -30, "ŅÂ": -30, "ŅÄ": -30, "ŅÀ": -30,
+	"ŅĀ": -30, "ŅĄ": -30, "ŅÅ": -30, "ŅÃ": -30,
+	"ÑA": -30, "ÑÁ": -30, "ÑĂ": -30, "ÑÂ": -30, "ÑÄ": -30, "ÑÀ": -30,
+	"ÑĀ": -30, "ÑĄ": -30, "ÑÅ": -30, "ÑÃ": -30,
+	"OA": -40, "OÁ": -40, "OĂ": -40, "OÂ": -40, "OÄ": -40, "OÀ": -40,
+	"OĀ": -40, "OĄ": -40, "OÅ": -40, "OÃ": -40, "OT": -40, "OŤ": -40,
+	"OŢ": -40, "OV": -50, "OW": -50, "OX": -40, "OY": -50, "OÝ": -50,
+	"OŸ": -50,
+	"ÓA": -40, "ÓÁ": -40, "ÓĂ": -40, "ÓÂ": -40, "ÓÄ": -40, "ÓÀ": -40,
+	"ÓĀ": -40, "ÓĄ": -40, "ÓÅ": -40, "ÓÃ": -40, "ÓT": -40, "ÓŤ": -40,
+	"ÓŢ": -40, "ÓV": -50, "ÓW": -50, "ÓX": -40, "ÓY": -50, "ÓÝ": -50,
+	"ÓŸ": -50,
+	"ÔA": -40, "ÔÁ": -40, "ÔĂ": -40, "ÔÂ": -40, "ÔÄ": -40, "ÔÀ": -40,
+	"ÔĀ": -40, "ÔĄ": -40, "ÔÅ": -40, "ÔÃ": -40, "ÔT": -40, "ÔŤ": -40,
+	"ÔŢ": -40, "ÔV": -50, "ÔW": -50, "ÔX": -40, "ÔY": -50, "ÔÝ": -50,
+	"ÔŸ": -50,
+	"ÖA": -40, "ÖÁ": -40, "ÖĂ": -40, "ÖÂ": -40, "ÖÄ": -40, "ÖÀ": -40,
+	"ÖĀ": -40, "ÖĄ": -40, "ÖÅ": -40, "ÖÃ": -40, "ÖT": -40, "ÖŤ": -40,
+	"ÖŢ": -40, "ÖV": -50, "ÖW": -50, "ÖX": -40, "ÖY": -50, "ÖÝ": -50,
+	"ÖŸ": -50,
+	"ÒA": -40, "ÒÁ": -40, "ÒĂ": -40, "ÒÂ": -40, "ÒÄ": -40, "ÒÀ": -40,
+	"ÒĀ": -40, "ÒĄ": -40, "ÒÅ": -40, "ÒÃ": -40, "ÒT": -40, "ÒŤ": -40,
+	"ÒŢ": -40, "ÒV": -50, "ÒW": -50, "ÒX": -40, "ÒY": -50, "ÒÝ": -50,
+	"ÒŸ": -50,
+	"ŐA": -40, "ŐÁ": -40, "ŐĂ": -40, "ŐÂ": -40, "ŐÄ": -40, "ŐÀ": -40,
+	"ŐĀ": -40, "ŐĄ": -40, "ŐÅ": -40, "ŐÃ": -40, "ŐT": -40, "ŐŤ": -40,
+	"ŐŢ": -40, "ŐV": -50, "ŐW": -50, "ŐX": -40, "ŐY": -50, "ŐÝ": -50,
+	"ŐŸ": -50,
+	"ŌA": -40, "ŌÁ": -40, "ŌĂ": -40, "ŌÂ": -40, "ŌÄ": -40, "ŌÀ": -40,
+	"ŌĀ": -40, "ŌĄ": -40, "ŌÅ": -40, "ŌÃ": -40, "ŌT": -40, "ŌŤ": -40,
+	"ŌŢ": -40, "ŌV": -50, "ŌW": -50, "ŌX": -40, "ŌY": -50, "ŌÝ": -50,
+	"ŌŸ": -50,
+	"ØA": -40, "ØÁ": -40, "ØĂ": -40, "ØÂ": -40, "ØÄ": -40, "ØÀ": -40,
+	"ØĀ": -40, "ØĄ": -40, "ØÅ": -40, "ØÃ": -40, "ØT": -40, "ØŤ": -40,
+	"ØŢ": -40, "ØV": -50, "ØW": -50, "ØX": -40, "ØY": -50, "ØÝ": -50,
+	"ØŸ": -50,
+	"ÕA": -40, "ÕÁ": -40, "ÕĂ": -40, "ÕÂ": -40, "ÕÄ": -40, "ÕÀ": -40,
+	"ÕĀ": -40, "ÕĄ": -40, "ÕÅ": -40, "ÕÃ": -40, "ÕT": -40, "ÕŤ": -40,
+	"ÕŢ": -40, "ÕV": -50, "ÕW": -50, "ÕX": -40, "ÕY": -50, "ÕÝ": -50,
+	"ÕŸ": -50,
+	"PA": -85, "PÁ": -85, "PĂ": -85, "PÂ": -85, "PÄ": -85, "PÀ": -85,
+	"PĀ": -85, "PĄ": -85, "PÅ": -85, "PÃ": -85, "Pa": -40, "Pá": -40,
+	"Pă": -40, "Pâ": -40, "Pä": -40, "Pà": -40, "Pā": -40, "Pą": -40,
+	"På": -40, "Pã": -40, "P,": -129, "Pe": -50, "Pé": -50, "Pě": -50,
+	"Pê": -50, "Pë": -50, "Pė": -50, "Pè": -50, "Pē": -50, "Pę": -50,
+	"Po": -55, "Pó": -55, "Pô": -55, "Pö": -55, "Pò": -55, "Pő": -55,
+	"Pō": -55, "Pø": -55, "Põ": -55, "P.": -129,
+	"QU": -10, "QÚ": -10, "QÛ": -10, "QÜ": -10, "QÙ": -10, "QŰ": -10,
+	"QŪ": -10, "QŲ": -10, "QŮ": -10,
+	"RO": -40, "RÓ": -40, "RÔ": -40, "RÖ": -40, "RÒ": -40, "RŐ": -40,
+	"RŌ": -40, "RØ": -40, "RÕ": -40, "RT": -30, "RŤ": -30, "RŢ": -30,
+	"RU": -40, "RÚ": -40, "RÛ": -40, "RÜ": -40, "RÙ": -40, "RŰ": -40,
+	"RŪ": -40, "RŲ": -40, "RŮ": -40, "RV": -18, "RW": -18, "RY": -18,
+	"RÝ": -18, "RŸ": -18,
+	"ŔO": -40, "ŔÓ": -40, "ŔÔ": -40, "ŔÖ": -40, "ŔÒ": -40, "ŔŐ": -40,
+	"ŔŌ": -40, "ŔØ": -40, "ŔÕ": -40, "ŔT": -30, "ŔŤ": -30, "ŔŢ": -30,
+	"ŔU": -40, "ŔÚ": -40, "ŔÛ": -40, "ŔÜ": -40, "ŔÙ": -40, "ŔŰ": -40,
+	"ŔŪ": -40, "ŔŲ": -40, "ŔŮ": -40, "ŔV": -18, "ŔW": -18, "ŔY": -18,
+	"ŔÝ": -18, "ŔŸ": -18,
+	"ŘO": -40, "ŘÓ": -40, "ŘÔ": -40, "ŘÖ": -40, "ŘÒ": -40, "ŘŐ": -40,
+	"ŘŌ": -40, "ŘØ": -40, "ŘÕ": -40, "ŘT": -30, "ŘŤ": -30, "ŘŢ": -30,
+	"ŘU": -40, "ŘÚ": -40, "ŘÛ": -40, "ŘÜ": -40, "ŘÙ": -40, "ŘŰ": -40,
+	"ŘŪ": -40, "ŘŲ": -40, "ŘŮ": -40, "ŘV": -18, "ŘW": -18, "ŘY": -18,
+	"ŘÝ": -18, "ŘŸ": -18,
+	"ŖO": -40, "ŖÓ": -40, "ŖÔ": -40, "ŖÖ": -40, "ŖÒ": -40, "ŖŐ": -40,
+	"ŖŌ": -40, "ŖØ": -40, "ŖÕ": -40, "ŖT": -30, "ŖŤ": -30, "ŖŢ": -30,
+	"ŖU": -40, "ŖÚ": -40, "ŖÛ": -40, "ŖÜ": -40, "ŖÙ": -40, "ŖŰ": -40,
+	"ŖŪ": -40, "ŖŲ": -40, "ŖŮ": -40, "ŖV": -18, "ŖW": -18, "ŖY": -18,
+	"ŖÝ": -18, "ŖŸ": -18,
+	"TA": -55, "TÁ": -55, "TĂ": -55, "TÂ": -55, "TÄ": -55, "TÀ": -55,
+	"TĀ": -55, "TĄ": -55, "TÅ": -55, "TÃ": -55, "TO": -18, "TÓ": -18,
+	"TÔ": -18, "TÖ": -18, "TÒ": -18, "TŐ": -18, "TŌ": -18, "TØ": -18,
+	"TÕ": -18, "Ta": -92, "Tá": -92, "Tă": -92, "Tâ": -92, "Tä": -92,
+	"Tà": -92, "Tā": -92, "Tą": -92, "Tå": -92, "Tã": -92, "T:": -74,
+	"T,": -92, "Te": -92, "Té": -92, "Tě": -92, "Tê": -92, "Të": -52,
+	"Tė": -92, "Tè": -52, "Tē": -52, "Tę": -92, "T-": -92, "Ti": -37,
+	"Tí": -37, "Tį": -37, "To": -95, "Tó": -95, "Tô": -95, "Tö": -95,
+	"Tò": -95, "Tő": -95, "Tō": -95, "Tø": -95, "Tõ": -95, "T.": -92,
+	"Tr": -37, "Tŕ": -37, "Tř": -37, "Tŗ": -37, "T;": -74, "Tu": -37,
+	"Tú": -37, "Tû": -37, "Tü": -37, "Tù": -37, "Tű": -37, "Tū": -37,
+	"Tų": -37, "Tů": -37, "Tw": -37, "Ty": -37, "Tý": -37, "Tÿ": -37,
+	"ŤA": -55, "ŤÁ": -55, "ŤĂ": -55, "ŤÂ": -55, "ŤÄ": -55, "ŤÀ": -55,
+	"ŤĀ": -55, "ŤĄ": -55, "ŤÅ": -55, "ŤÃ": -55, "ŤO": -18, "ŤÓ": -18,
+	"ŤÔ": -18, "ŤÖ": -18, "ŤÒ": -18, "ŤŐ": -18, "ŤŌ": -18, "ŤØ": -18,
+	"ŤÕ": -18, "Ťa": -92, "Ťá": -92, "Ťă": -92, "Ťâ": -92, "Ťä": -92,
+	"Ťà": -92, "Ťā": -92, "Ťą": -92, "Ťå": -92, "Ťã": -92, "Ť:": -74,
+	"Ť,": -92, "Ťe": -92, "Ťé": -92, "Ťě": -92, "Ťê": -92, "Ťë": -52,
+	"Ťė": -92, "Ťè": -52, "Ťē": -52, "Ťę": -92, "Ť-": -92, "Ťi": -37,
+	"Ťí": -37, "Ťį": -37, "Ťo": -95, "Ťó": -95, "Ťô": -95, "Ťö": -95,
+	"Ťò": -95, "Ťő": -95, "Ťō": -95, "Ťø": -95, "Ťõ": -95, "Ť.": -92,
+	"Ťr": -37, "Ťŕ": -37, "Ťř": -37, "Ťŗ": -37, "Ť;": -74, "Ťu": -37,
+	"Ťú": -37, "Ťû": -37, "Ťü": -37, "Ťù": -37, "Ťű": -37, "Ťū": -37,
+	"Ťų": -37, "Ťů": -37, "Ťw": -37, "Ťy": -37, "Ťý": -37, "Ťÿ": -37,
+	"ŢA": -55, "ŢÁ": -55, "ŢĂ": -55, "ŢÂ": -55, "ŢÄ": -55, "ŢÀ": -55,
+	"ŢĀ": -55, "ŢĄ": -55, "ŢÅ": -55, "ŢÃ": -55, "ŢO": -18, "ŢÓ": -18,
+	"ŢÔ": -18, "ŢÖ": -18, "ŢÒ": -18, "ŢŐ": -18, "ŢŌ": -18, "ŢØ": -18,
+	"ŢÕ": -18, "Ţa": -92, "Ţá": -92, "Ţă": -92, "Ţâ": -92, "Ţä": -92,
+	"Ţà": -92, "Ţā": -92, "Ţą": -92, "Ţå": -92, "Ţã": -92, "Ţ:": -74,
+	"Ţ,": -92, "Ţe": -92, "Ţé": -92, "Ţě": -92, "Ţê": -92, "Ţë": -52,
+	"Ţė": -92, "Ţè": -52, "Ţē": -52, "Ţę": -92, "Ţ-": -92, "Ţi": -37,
+	"Ţí": -37, "Ţį": -37, "Ţo": -95, "Ţó": -95, "Ţô": -95, "Ţö": -95,
+	"Ţò": -95, "Ţő": -95, "Ţō": -95, "Ţø": -95, "Ţõ": -95, "Ţ.": -92,
+	"Ţr": -37, "Ţŕ": -37, "Ţř": -37, "Ţŗ": -37, "Ţ;": -74, "Ţu": -37,
+	"Ţú": -37, "Ţû": -37, "Ţü": -37, "Ţù": -37, "Ţű": -37, "Ţū": -37,
+	"Ţų": -37, "Ţů": -37, "Ţw": -37, "Ţy": -37, "Ţý": -37, "Ţÿ": -37,
+	"UA": -45, "UÁ": -45, "UĂ": -45, "UÂ": -45, "UÄ": -45, "UÀ": -45,
+	"UĀ": -45, "UĄ": -45, "UÅ": -45, "UÃ": -45,
+	"ÚA": -45, "ÚÁ": -45, "ÚĂ": -45, "ÚÂ": -45, "ÚÄ": -45, "ÚÀ": -45,
+	"ÚĀ": -45, "ÚĄ": -45, "ÚÅ": -45, "ÚÃ": -45,
+	"ÛA": -45, "ÛÁ": -45, "ÛĂ": -45, "ÛÂ": -45, "ÛÄ": -45, "ÛÀ": -45,
+	"ÛĀ": -45, "ÛĄ": -45, "ÛÅ": -45, "ÛÃ": -45,
+	"ÜA": -45, "ÜÁ": -45, "ÜĂ": -45, "ÜÂ": -45, "ÜÄ": -45, "ÜÀ": -45,
+	"ÜĀ": -45, "ÜĄ": -45, "ÜÅ": -45, "ÜÃ": -45,
+	"ÙA": -45, "ÙÁ": -45, "ÙĂ": -45, "ÙÂ": -45, "ÙÄ": -45, "ÙÀ": -45,
+	"ÙĀ": -45, "ÙĄ": -45, "ÙÅ": -45, "ÙÃ": -45,
+	"ŰA": -45, "ŰÁ": -45, "ŰĂ": -45, "ŰÂ": -45, "ŰÄ": -45, "ŰÀ": -45,
+	"ŰĀ": -45, "ŰĄ": -45, "ŰÅ": -45, "ŰÃ": -45,
+	"ŪA": -45, "ŪÁ": -45, "ŪĂ": -45, "ŪÂ": -45, "ŪÄ": -45, "ŪÀ": -45,
+	"ŪĀ": -45, "ŪĄ": -45, "ŪÅ": -45, "ŪÃ": -45,
+	"ŲA": -45, "ŲÁ": -45, "ŲĂ": -45, "ŲÂ": -45, "ŲÄ": -45, "ŲÀ": -45,
+	"ŲĀ": -45, "ŲĄ": -45, "ŲÅ": -45, "ŲÃ": -45,
+	"ŮA": -45, "ŮÁ": -45, "ŮĂ": -45, "ŮÂ": -45, "ŮÄ": -45, "ŮÀ": -45,
+	"ŮĀ": -45, "ŮĄ": -45, "ŮÅ": -45, "ŮÃ": -45,
+	"VA": -85, "VÁ": -85, "VĂ": -85, "VÂ": -85, "VÄ": -85, "VÀ": -85,
+	"VĀ": -85, "VĄ": -85, "VÅ": -85, "VÃ": -85, "VG": -10, "VĞ": -10,
+	"VĢ": -10, "VO": -30, "VÓ": -30, "VÔ": -30, "VÖ": -30, "VÒ": -30,
+	"VŐ": -30, "VŌ": -30, "VØ": -30, "VÕ": -30, "Va": -111, "Vá": -111,
+	"Vă": -111, "Vâ": -111, "Vä": -111, "Và": -111, "Vā": -111, "Vą": -111,
+	"Vå": -111, "Vã": -111, "V:": -74, "V,": -129, "Ve": -111, "Vé": -111,
+	"Vě": -111, "Vê": -111, "Vë": -71, "Vė": -111, "Vè": -71, "Vē": -71,
+	"Vę": -111, "V-": -70, "Vi": -55, "Ví": -55, "Vį": -55, "Vo": -111,
+	"Vó": -111, "Vô": -111, "Vö": -111, "Vò": -111, "Vő": -111, "Vō": -111,
+	"Vø": -111, "Võ": -111, "V.": -129, "V;": -74, "Vu": -55, "Vú": -55,
+	"Vû": -55, "Vü": -55, "Vù": -55, "Vű": -55, "Vū": -55, "Vų": -55,
+	"Vů": -55,
+	"WA": -74, "WÁ": -74, "WĂ": -74, "WÂ": -74, "WÄ": -74, "WÀ": -74,
+	"WĀ": -74, "WĄ": -74, "WÅ": -74, "WÃ": -74, "WO": -15, "WÓ": -15,
+	"WÔ": -15, "WÖ": -15, "WÒ": -15, "WŐ": -15, "WŌ": -15, "WØ": -15,
+	"WÕ": -15, "Wa": -85, "Wá": -85, "Wă": -85, "Wâ": -85, "Wä": -85,
+	"Wà": -85, "Wā": -85, "Wą": -85, "Wå": -85, "Wã": -85, "W:": -55,
+	"W,": -74, "We": -90, "Wé": -90, "Wě": -90, "Wê": -90, "Wë": -50,
+	"Wė": -90, "Wè": -50, "Wē": -50, "Wę": -90, "W-": -50, "Wi": -37,
+	"Wí": -37, "Wį": -37, "Wo": -80, "Wó": -80, "Wô": -80, "Wö": -80,
+	"Wò": -80, "Wő": -80, "Wō": -80, "Wø": -80, "Wõ": -80, "W.": -74,
+	"W;": -55, "Wu": -55, "Wú": -55, "Wû": -55, "Wü": -55, "Wù": -55,
+	"Wű": -55, "Wū": -55, "Wų": -55, "Wů": -55, "Wy": -55, "Wý": -55,
+	"Wÿ": -55,
+	"YA": -74, "YÁ": -74, "YĂ": -74, "YÂ": -74, "YÄ": -74, "YÀ": -74,
+	"YĀ": -74, "YĄ": -74, "YÅ": -74, "YÃ": -74, "YO": -25, "YÓ": -25,
+	"YÔ": -25, "YÖ": -25, "YÒ": -25, "YŐ": -25, "YŌ": -25, "YØ": -25,
+	"YÕ": -25, "Ya": -92, "Yá": -92, "Yă": -92, "Yâ": -92, "Yä": -92,
+	"Yà": -92, "Yā": -92, "Yą": -92, "Yå": -92, "Yã": -92, "Y:": -92,
+	"Y,": -92, "Ye": -111, "Yé": -111, "Yě": -111, "Yê": -71, "Yë": -71,
+	"Yė": -111, "Yè": -71, "Yē": -71, "Yę": -111, "Y-": -92, "Yi": -55,
+	"Yí": -55, "Yį": -55, "Yo": -111, "Yó": -111, "Yô": -111, "Yö": -111,
+	"Yò": -111, "Yő": -111, "Yō": -111, "Yø": -111, "Yõ": -111, "Y.": -74,
+	"Y;": -92, "Yu": -92, "Yú": -92, "Yû": -92, "Yü": -92, "Yù": -92,
+	"Yű": -92, "Yū": -92, "Yų": -92, "Yů": -92,
+	"ÝA": -74, "ÝÁ": -74, "ÝĂ": -74, "ÝÂ": -74, "ÝÄ": -74, "ÝÀ": -74,
+	"ÝĀ": -74, "ÝĄ": -74, "ÝÅ": -74, "ÝÃ": -74, "ÝO": -25, "ÝÓ": -25,
+	"ÝÔ": -25, "ÝÖ": -25, "ÝÒ": -25, "ÝŐ": -25, "ÝŌ": -25, "ÝØ": -25,
+	"ÝÕ": -25, "Ýa": -92, "Ýá": -92, "Ýă": -92, "Ýâ": -92, "Ýä": -92,
+	"Ýà": -92, "Ýā": -92, "Ýą": -92, "Ýå": -92, "Ýã": -92, "Ý:": -92,
+	"Ý,": -92, "Ýe": -111, "Ýé": -111, "Ýě": -111, "Ýê": -71, "Ýë": -71,
+	"Ýė": -111, "Ýè": -71, "Ýē": -71, "Ýę": -111, "Ý-": -92, "Ýi": -55,
+	"Ýí": -55, "Ýį": -55, "Ýo": -111, "Ýó": -111, "Ýô": -111, "Ýö": -111,
+	"Ýò": -111, "Ýő": -111, "Ýō": -111, "Ýø": -111, "Ýõ": -111, "Ý.": -74,
+	"Ý;": -92, "Ýu": -92, "Ýú": -92, "Ýû": -92, "Ýü": -92, "Ýù": -92,
+	"Ýű": -92, "Ýū": -92, "Ýų": -92, "Ýů": -92,
+	"ŸA": -74, "ŸÁ": -74, "ŸĂ": -74, "ŸÂ": -74, "ŸÄ": -74, "ŸÀ": -74,
+	"ŸĀ": -74, "ŸĄ": -74, "ŸÅ": -74, "ŸÃ": -74, "ŸO": -25, "ŸÓ": -25,
+	"ŸÔ": -25, "ŸÖ": -25, "ŸÒ": -25, "ŸŐ": -25, "ŸŌ": -25, "ŸØ": -25,
+	"ŸÕ": -25, "Ÿa": -92, "Ÿá": -92, "Ÿă": -92, "Ÿâ": -92, "Ÿä": -92,
+	"Ÿà": -92, "Ÿā": -92, "Ÿą": -92, "Ÿå": -92, "Ÿã": -92, "Ÿ:": -92,
+	"Ÿ,": -92, "Ÿe": -111, "Ÿé": -111, "Ÿě": -111, "Ÿê": -71, "Ÿë": -71,
+	"Ÿė": -111, "Ÿè": -71, "Ÿē": -71, "Ÿę": -111, "Ÿ-": -92, "Ÿi": -55,
+	"Ÿí": -55, "Ÿį": -55, "Ÿo": -111, "Ÿó": -111, "Ÿô": -111, "Ÿö": -111,
+	"Ÿò": -111, "Ÿő": -111, "Ÿō": -111, "Ÿø": -111, "Ÿõ": -111, "Ÿ.": -74,
+	"Ÿ;": -92, "Ÿu": -92, "Ÿú": -92, "Ÿû": -92, "Ÿü": -92, "Ÿù": -92,
+	"Ÿű": -92, "Ÿū": -92, "Ÿų": -92, "Ÿů": -92,
+	"bb": -10, "b.": -40, "bu": -20, "bú": -20, "bû": -20, "bü": -20,
+	"bù": -20, "bű": -20, "bū": -20, "bų": -20, "bů": -20,
+	"ch": -10, "ck": -10, "cķ": -10,
+	"ćh": -10, "ćk": -10, "ćķ": -10,
+	"čh": -10, "čk": -10, "čķ": -10,
+	"çh": -10, "çk": -10, "çķ": -10,
+	",”": -95, ",’": -95,
+	"eb": -10,
+	"éb": -10,
+	"ěb": -10,
+	"êb": -10,
+	"ëb": -10,
+	"ėb": -10,
+	"èb": -10,
+	"ēb": -10,
+	"ęb": -10,
+	"f,": -10, "fı": -30, "fe": -10, "fé": -10, "fė": -10, "fę": -10,
+	"ff": -18, "fo": -10, "fó": -10, "fô": -10, "fò": -10, "fő": -10,
+	"fø": -10, "fõ": -10, "f.": -10, "f’": 55,
+	"ke": -30, "ké": -30, "kě": -30, "kê": -30, "kë": -30, "kė": -30,
+	"kè": -30, "kē": -30, "kę": -30, "ko": -10, "kó": -10, "kô": -10,
+	"kö": -10, "kò": -10, "kő": -10, "kō": -10, "kø": -10, "kõ": -10,
+	"ķe": -30, "ķé": -30, "ķě": -30, "ķê": -30, "ķë": -30, "ķė": -30,
+	"ķè": -30, "ķē": -30, "ķę": -30, "ķo": -10, "ķó": -10, "ķô": -10,
+	"ķö": -10, "ķò": -10, "ķő": -10, "ķō": -10, "ķø": -10, "ķõ": -10,
+	"nv": -40,
+	"ńv": -40,
+	"ňv": -40,
+	"ņv": -40,
+	"ñv": -40,
+	"ov": -15, "ow": -25, "ox": -10, "oy": -10, "oý": -10, "oÿ": -10,
+	"óv": -15, "ów": -25, "óx": -10, "óy": -10, "óý": -10, "óÿ": -10,
+	"ôv": -15, "ôw": -25, "ôx": -10, "ôy": -10, "ôý": -10, "ôÿ": -10,
+	"öv": -15, "öw": -25, "öx": -10, "öy": -10, "öý": -10, "öÿ": -10,
+	"òv": -15, "òw": -25, "òx": -10, "òy": -10, "òý": -10, "òÿ": -10,
+	"őv": -15, "őw": -25, "őx": -10, "őy": -10, "őý": -10, "őÿ": -10,
+	"ōv": -15, "ōw": -25, "ōx": -10, "ōy": -10, "ōý": -10, "ōÿ": -10,
+	"øv": -15, "øw": -25, "øx": -10, "øy": -10, "øý": -10, "øÿ": -10,
+	"õv": -15, "õw": -25, "õx": -10, "õy": -10, "õý": -10, "õÿ": -10,
+	".”": -95, ".’": -95,
+	"‘‘": -74,
+	"’d": -15, "’đ": -15, "’’": -74, "’r": -15, "’ŕ": -15, "’ř": -15,
+	"’ŗ": -15, "’s": -74, "’ś": -74, "’š": -74, "’ş": -74, "’ș": -74,
+	"’ ": -74, "’t": -37, "’ţ": -37, "’v": -15,
+	"r,": -65, "r.": -65,
+	"ŕ,": -65, "ŕ.": -65,
+	"ř,": -65, "ř.": -65,
+	"ŗ,": -65, "ŗ.": -65,
+	" A": -37, " Á": -37, " Ă": -37, " Â": -37, " Ä": -37, " À": -37,
+	" Ā": -37, " Ą": -37, " Å": -37, " Ã": -37, " V": -70, " W": -70,
+	" Y": -70, " Ý": -70, " Ÿ": -70,
+	"v,": -37, "ve": -15, "vé": -15, "vě": -15, "vê": -15, "vë": -15,
+	"vė": -15, "vè": -15, "vē": -15, "vę": -15, "vo": -15, "vó": -15,
+	"vô": -15, "vö": -15, "vò": -15, "vő": -15, "vō": -15, "vø": -15,
+	"võ": -15, "v.": -37,
+	"wa": -10, "wá": -10, "wă": -10, "wâ": -10, "wä": -10, "wà": -10,
+	"wā": -10, "wą": -10, "wå": -10, "wã": -10, "w,": -37, "we": -10,
+	"wé": -10, "wě": -10, "wê": -10, "wë": -10, "wė": -10, "wè": -10,
+	"wē": -10, "wę": -10, "wo": -15, "wó": -15, "wô": -15, "wö": -15,
+	"wò": -15, "wő": -15, "wō": -15, "wø": -15, "wõ": -15, "w.": -37,
+	"xe": -10, "xé": -10, "xě": -10, "xê": -10, "xë": -10, "xė": -10,
+	"xè": -10, "xē": -10, "xę": -10,
+	"y,": -37, "y.": -37,
+	"ý,": -37, "ý.": -37,
+	"ÿ,": -37, "ÿ.": -37,
+} //                                                      pdfKernTimesBoldItalic
+
+// pdfKernTimesItalic is the kerning of Times-Italic, from
+// Times-Italic.afm version 002.000 (UniqueID 43067)
+var pdfKernTimesItalic = map[string]int{
+	"AC": -30, "AĆ": -30, "AČ": -30, "AÇ": -30, "AG": -35, "AĞ": -35,
+	"AĢ": -35, "AO": -40, "AÓ": -40, "AÔ": -40, "AÖ": -40, "AÒ": -40,
+	"AŐ": -40, "AŌ": -40, "AØ": -40, "AÕ": -40, "AQ": -40, "AT": -37,
+	"AŤ": -37, "AŢ": -37, "AU": -50, "AÚ": -50, "AÛ": -50, "AÜ": -50,
+	"AÙ": -50, "AŰ": -50, "AŪ": -50, "AŲ": -50, "AŮ": -50, "AV": -105,
+	"AW": -95, "AY": -55, "AÝ": -55, "AŸ": -55, "A’": -37, "Au": -20,
+	"Aú": -20, "Aû": -20, "Aü": -20, "Aù": -20, "Aű": -20, "Aū": -20,
+	"Aų": -20, "Aů": -20, "Av": -55, "Aw": -55, "Ay": -55, "Aý": -55,
+	"Aÿ": -55,
+	"ÁC": -30, "ÁĆ": -30, "ÁČ": -30, "ÁÇ": -30, "ÁG": -35, "ÁĞ": -35,
+	"ÁĢ": -35, "ÁO": -40, "ÁÓ": -40, "ÁÔ": -40, "ÁÖ": -40, "ÁÒ": -40,
+	"ÁŐ": -40, "ÁŌ": -40, "ÁØ": -40, "ÁÕ": -40, "ÁQ": -40, "ÁT": -37,
+	"ÁŤ": -37, "ÁŢ": -37, "ÁU": -50, "ÁÚ": -50, "ÁÛ": -50, "ÁÜ": -50,
+	"ÁÙ": -50, "ÁŰ": -50, "ÁŪ": -50, "ÁŲ": -50, "ÁŮ": -50, "ÁV": -105,
+	"ÁW": -95, "ÁY": -55, "ÁÝ": -55, "ÁŸ": -55, "Á’": -37, "Áu": -20,
+	"Áú": -20, "Áû": -20, "Áü": -20, "Áù": -20, "Áű": -20, "Áū": -20,
+	"Áų": -20, "Áů": -20, "Áv": -55, "Áw": -55, "Áy": -55, "Áý": -55,
+	"Áÿ": -55,
+	"ĂC": -30, "ĂĆ": -30, "ĂČ": -30, "ĂÇ": -30, "ĂG": -35, "ĂĞ": -35,
+	"ĂĢ": -35, "ĂO": -40, "ĂÓ": -40, "ĂÔ": -40, "ĂÖ": -40, "ĂÒ": -40,
+	"ĂŐ": -40, "ĂŌ": -40, "ĂØ": -40, "ĂÕ": -40, "ĂQ": -40, "ĂT": -37,
+	"ĂŤ": -37, "ĂŢ": -37, "ĂU": -50, "ĂÚ": -50, "ĂÛ": -50, "ĂÜ": -50,
+	"ĂÙ": -50, "ĂŰ": -50, "ĂŪ": -50, "ĂŲ": -50, "ĂŮ": -50, "ĂV": -105,
+	"ĂW": -95, "ĂY": -55, "ĂÝ": -55, "ĂŸ": -55, "Ă’": -37, "Ău": -20,
+	"Ăú": -20, "Ăû": -20, "Ăü": -20, "Ăù": -20, "Ăű": -20, "Ăū": -20,
+	"Ăų": -20, "Ăů": -20, "Ăv": -55, "Ăw": -55, "Ăy": -55, "Ăý": -55,
+	"Ăÿ": -55,
+	"ÂC": -30, "ÂĆ": -30, "ÂČ": -30, "ÂÇ": -30, "ÂG": -35, "ÂĞ": -35,
+	"ÂĢ": -35, "ÂO": -40, "ÂÓ": -40, "ÂÔ": -40, "ÂÖ": -40, "ÂÒ": -40,
+	"ÂŐ": -40, "ÂŌ": -40, "ÂØ": -40, "ÂÕ": -40, "ÂQ": -40, "ÂT": -37,
+	"ÂŤ": -37, "ÂŢ": -37, "ÂU": -50, "ÂÚ": -50, "ÂÛ": -50, "ÂÜ": -50,
+	"ÂÙ": -50, "ÂŰ": -50, "ÂŪ": -50, "ÂŲ": -50, "ÂŮ": -50, "ÂV": -105,
+	"ÂW": -95, "ÂY": -55, "ÂÝ": -55, "ÂŸ": -55, "Â’": -37, "Âu": -20,
+	"Âú": -20, "Âû": -20, "Âü": -20, "Âù": -20, "Âű": -20, "Âū": -20,
+	"Âų": -20, "Âů": -20, "Âv": -55, "Âw": -55, "Ây": -55, "Âý": -55,
+	"Âÿ": -55,
+	"ÄC": -30, "ÄĆ": -30, "ÄČ": -30, "ÄÇ": -30, "ÄG": -35, "ÄĞ": -35,
+	"ÄĢ": -35, "ÄO": -40, "ÄÓ": -40, "ÄÔ": -40, "ÄÖ": -40, "ÄÒ": -40,
+	"ÄŐ": -40, "ÄŌ": -40, "ÄØ": -40, "ÄÕ": -40, "ÄQ": -40, "ÄT": -37,
+	"ÄŤ": -37, "ÄŢ": -37, "ÄU": -50, "ÄÚ": -50, "ÄÛ": -50, "ÄÜ": -50,
+	"ÄÙ": -50, "ÄŰ": -50, "ÄŪ": -50, "ÄŲ": -50, "ÄŮ": -50, "ÄV": -105,
+	"ÄW": -95, "ÄY": -55, "ÄÝ": -55, "ÄŸ": -55, "Ä’": -37, "Äu": -20,
+	"Äú": -20, "Äû": -20, "Äü": -20, "Äù": -20, "Äű": -20, "Äū": -20,
+	"Äų": -20, "Äů": -20, "Äv": -55, "Äw": -55, "Äy": -55, "Äý": -55,
+	"Äÿ": -55,
+	"ÀC": -30, "ÀĆ": -30, "ÀČ": -30, "ÀÇ": -30, "ÀG": -35, "ÀĞ": -35,
+	"ÀĢ": -35, "ÀO": -40, "ÀÓ": -40, "ÀÔ": -40, "ÀÖ": -40, "ÀÒ": -40,
+	"ÀŐ": -40, "ÀŌ": -40, "ÀØ": -40, "ÀÕ": -40, "ÀQ": -40, "ÀT": -37,
+	"ÀŤ": -37, "ÀŢ": -37, "ÀU": -50, "ÀÚ": -50, "ÀÛ": -50, "ÀÜ": -50,
+	"ÀÙ": -50, "ÀŰ": -50, "ÀŪ": -50, "ÀŲ": -50, "ÀŮ": -50, "ÀV": -105,
+	"ÀW": -95, "ÀY": -55, "ÀÝ": -55, "ÀŸ": -55, "À’": -37, "Àu": -20,
+	"Àú": -20, "Àû": -20, "Àü": -20, "Àù": -20, "Àű": -20, "Àū": -20,
+	"Àų": -20, "Àů": -20, "Àv": -55, "Àw": -55, "Ày": -55, "Àý": -55,
+	"Àÿ": -55,
+	"ĀC": -30, "ĀĆ": -30, "ĀČ": -30, "ĀÇ": -30, "ĀG": -35, "ĀĞ": -35,
+	"ĀĢ": -35, "ĀO": -40, "ĀÓ": -40, "ĀÔ": -40, "ĀÖ": -40, "ĀÒ": -40,
+	"ĀŐ": -40, "ĀŌ": -40, "ĀØ": -40, "ĀÕ": -40, "ĀQ": -40, "ĀT": -37,
+	"ĀŤ": -37, "ĀŢ": -37, "ĀU": -50, "ĀÚ": -50, "ĀÛ": -50, "ĀÜ": -50,
+	"ĀÙ": -50, "ĀŰ": -50, "ĀŪ": -50, "ĀŲ": -50, "ĀŮ": -50, "ĀV": -105,
+	"ĀW": -95, "ĀY": -55, "ĀÝ": -55, "ĀŸ": -55, "Ā’": -37, "Āu": -20,
+	"Āú": -20, "Āû": -20, "Āü": -20, "Āù": -20, "Āű": -20, "Āū": -20,
+	"Āų": -20, "Āů": -20, "Āv": -55, "Āw": -55, "Āy": -55, "Āý": -55,
+	"Āÿ": -55,
+	"ĄC": -30, "ĄĆ": -30, "ĄČ": -30, "ĄÇ": -30, "ĄG": -35, "ĄĞ": -35,
+	"ĄĢ": -35, "ĄO": -40, "ĄÓ": -40, "ĄÔ": -40, "ĄÖ": -40, "ĄÒ": -40,
+	"ĄŐ": -40, "ĄŌ": -40, "ĄØ": -40, "ĄÕ": -40, "ĄQ": -40, "ĄT": -37,
+	"ĄŤ": -37, "ĄŢ": -37, "ĄU": -50, "ĄÚ": -50, "ĄÛ": -50, "ĄÜ": -50,
+	"ĄÙ": -50, "ĄŰ": -50, "ĄŪ": -50, "ĄŲ": -50, "ĄŮ": -50, "ĄV": -105,
+	"ĄW": -95, "ĄY": -55, "ĄÝ": -55, "ĄŸ": -55, "Ą’": -37, "Ąu": -20,
+	"Ąú": -20, "Ąû": -20, "Ąü": -20, "Ąù": -20, "Ąű": -20, "Ąū": -20,
+	"Ąų": -20, "Ąů": -20, "Ąv": -55, "Ąw": -55, "Ąy": -55, "Ąý": -55,
+	"Ąÿ": -55,
+	"ÅC": -30, "ÅĆ": -30, "ÅČ": -30, "ÅÇ": -30, "ÅG": -35, "ÅĞ": -35,
+	"ÅĢ": -35, "ÅO": -40, "ÅÓ": -40, "ÅÔ": -40, "ÅÖ": -40, "ÅÒ": -40,
+	"ÅŐ": -40, "ÅŌ": -40, "ÅØ": -40, "ÅÕ": -40, "ÅQ": -40, "ÅT": -37,
+	"ÅŤ": -37, "ÅŢ": -37, "ÅU": -50, "ÅÚ": -50, "ÅÛ": -50, "ÅÜ": -50,
+	"ÅÙ": -50, "ÅŰ": -50, "ÅŪ": -50, "ÅŲ": -50, "ÅŮ": -50, "ÅV": -105,
+	"ÅW": -95, "ÅY": -55, "ÅÝ": -55, "ÅŸ": -55, "Å’": -37, "Åu": -20,
+	"Åú": -20, "Åû": -20, "Åü": -20, "Åù": -20, "Åű": -20, "Åū": -20,
+	"Åų": -20, "Åů": -20, "Åv": -55, "Åw": -55, "Åy": -55, "Åý": -55,
+	"Åÿ": -55,
+	"ÃC": -30, "ÃĆ": -30, "ÃČ": -30, "ÃÇ": -30, "ÃG": -35, "ÃĞ": -35,
+	"ÃĢ": -35, "ÃO": -40, "ÃÓ": -40, "ÃÔ": -40, "ÃÖ": -40, "ÃÒ": -40,
+	"ÃŐ": -40, "ÃŌ": -40, "ÃØ": -40, "ÃÕ": -40, "ÃQ": -40, "ÃT": -37,
+	"ÃŤ": -37, "ÃŢ": -37, "ÃU": -50, "ÃÚ": -50, "ÃÛ": -50, "ÃÜ": -50,
+	"ÃÙ": -50, "ÃŰ": -50, "ÃŪ": -50, "ÃŲ": -50, "ÃŮ": -50, "ÃV": -105,
+	"ÃW": -95, "ÃY": -55, "ÃÝ": -55, "ÃŸ": -55, "Ã’": -37, "Ãu": -20,
+	"Ãú": -20, "Ãû": -20, "Ãü": -20, "Ãù": -20, "Ãű": -20, "Ãū": -20,
+	"Ãų": -20, "Ãů": -20, "Ãv": -55, "Ãw": -55, "Ãy": -55, "Ãý": -55,
+	"Ãÿ": -55,
+	"BA": -25, "BÁ": -25, "BĂ": -25, "BÂ": -25, "BÄ": -25, "BÀ": -25,
+	"BĀ": -25, "BĄ": -25, "BÅ": -25, "BÃ": -25, "BU": -10, "BÚ": -10,
+	"BÛ": -10, "BÜ": -10, "BÙ": -10, "BŰ": -10, "BŪ": -10, "BŲ": -10,
+	"BŮ": -10,
+	"DA": -35, "DÁ": -35, "DĂ": -35, "DÂ": -35, "DÄ": -35, "DÀ": -35,
+	"DĀ": -35, "DĄ": -35, "DÅ": -35, "DÃ": -35, "DV": -40, "DW": -40,
+	"DY": -40, "DÝ": -40, "DŸ": -40,
+	"ĎA": -35, "ĎÁ": -35, "ĎĂ": -35, "ĎÂ": -35, "ĎÄ": -35, "ĎÀ": -35,
+	"ĎĀ": -35, "ĎĄ": -35, "ĎÅ": -35, "ĎÃ": -35, "ĎV": -40, "ĎW": -40,
+	"ĎY": -40, "ĎÝ": -40, "ĎŸ": -40,
+	"ĐA": -35, "ĐÁ": -35, "ĐĂ": -35, "ĐÂ": -35, "ĐÄ": -35, "ĐÀ": -35,
+	"ĐĀ": -35, "ĐĄ": -35, "ĐÅ": -35, "ĐÃ": -35, "ĐV": -40, "ĐW": -40,
+	"ĐY": -40, "ĐÝ": -40, "ĐŸ": -40,
+	"FA": -115, "FÁ": -115, "FĂ": -115, "FÂ": -115, "FÄ": -115, "FÀ": -115,
+	"FĀ": -115, "FĄ": -115, "FÅ": -115, "FÃ": -115, "Fa": -75, "Fá": -75,
+	"Fă": -75, "Fâ": -75, "Fä": -75, "Fà": -75, "Fā": -75, "Fą": -75,
+	"Få": -75, "Fã": -75, "F,": -135, "Fe": -75, "Fé": -75, "Fě": -75,
+	"Fê": -75, "Fë": -75, "Fė": -75, "Fè": -75, "Fē": -75, "Fę": -75,
+	"Fi": -45, "Fí": -45, "Fî": -45, "Fï": -45, "Fì": -45, "Fī": -45,
+	"Fį": -45, "Fo": -105, "Fó": -105, "Fô": -105, "Fö": -105, "Fò": -105,
+	"Fő": -105, "Fō": -105, "Fø": -105, "Fõ": -105, "F.": -135, "Fr": -55,
+	"Fŕ": -55, "Fř": -55, "Fŗ": -55,
+	"JA": -40, "JÁ": -40, "JĂ": -40, "JÂ": -40, "JÄ": -40, "JÀ": -40,
+	"JĀ": -40, "JĄ": -40, "JÅ": -40, "JÃ": -40, "Ja": -35, "Já": -35,
+	"Jă": -35, "Jâ": -35, "Jä": -35, "Jà": -35, "Jā": -35, "Ją": -35,
+	"Jå": -35, "Jã": -35, "J,": -25, "Je": -25, "Jé": -25, "Jě": -25,
+	"Jê": -25, "Jë": -25, "Jė": -25, "Jè": -25, "Jē": -25, "Ję": -25,
+	"Jo": -25, "Jó": -25, "Jô": -25, "Jö": -25, "Jò": -25, "Jő": -25,
+	"Jō": -25, "Jø": -25, "Jõ": -25, "J.": -25, "Ju": -35, "Jú": -35,
+	"Jû": -35, "Jü": -35, "Jù": -35, "Jű": -35, "Jū": -35, "Jų": -35,
+	"Jů": -35,
+	"KO": -50, "KÓ": -50, "KÔ": -50, "KÖ": -50, "KÒ": -50, "KŐ": -50,
+	"KŌ": -50, "KØ": -50, "KÕ": -50, "Ke": -35, "Ké": -35, "Kě": -35,
+	"Kê": -35, "Kë": -35, "Kė": -35, "Kè": -35, "Kē": -35, "Kę": -35,
+	"Ko": -40, "Kó": -40, "Kô": -40, "Kö": -40, "Kò": -40, "Kő": -40,
+	"Kō": -40, "Kø": -40, "Kõ": -40, "Ku": -40, "Kú": -40, "Kû": -40,
+	"Kü": -40, "Kù": -40, "Kű": -40, "Kū": -40, "Kų": -40, "Ků": -40,
+	"Ky": -40, "Ký": -40, "Kÿ": -40,
+	"ĶO": -50, "ĶÓ": -50, "ĶÔ": -50, "ĶÖ": -50, "ĶÒ": -50, "ĶŐ": -50,
+	"ĶŌ": -50, "ĶØ": -50, "ĶÕ": -50, "Ķe": -35, "Ķé": -35, "Ķě": -35,
+	"Ķê": -35, "Ķë": -35, "Ķė": -35, "Ķè": -35, "Ķē": -35, "Ķę": -35,
+	"Ķo": -40, "Ķó": -40, "Ķô": -40, "Ķö": -40, "Ķò": -40, "Ķő": -40,
+	"Ķō": -40, "Ķø": -40, "Ķõ": -40, "Ķu": -40, "Ķú": -40, "Ķû": -40,
+	"Ķü": -40, "Ķù": -40, "Ķű": -40, "Ķū": -40, "Ķų": -40, "Ķů": -40,
+	"Ķy": -40, "Ķý": -40, "Ķÿ": -40,
+	"LT": -20, "LŤ": -20, "LŢ": -20, "LV": -55, "LW": -55, "LY": -20,
+	"LÝ": -20, "LŸ": -20, "L’": -37, "Ly": -30, "Lý": -30, "Lÿ": -30,
+	"ĹT": -20, "ĹŤ": -20, "ĹŢ": -20, "ĹV": -55, "ĹW": -55, "ĹY": -20,
+	"ĹÝ": -20, "ĹŸ": -20, "Ĺ’": -37, "Ĺy": -30, "Ĺý": -30, "Ĺÿ": -30,
+	"ĻT": -20, "ĻŤ": -20, "ĻŢ": -20, "ĻV": -55, "ĻW": -55, "ĻY": -20,
+	"ĻÝ": -20, "ĻŸ": -20, "Ļ’": -37, "Ļy": -30, "Ļý": -30, "Ļÿ": -30,
+	"ŁT": -20, "ŁŤ": -20, "ŁŢ": -20, "ŁV": -55, "ŁW": -55, "ŁY": -20,
+	"ŁÝ": -20, "ŁŸ": -20, "Ł’": -37, "Ły": -30, "Łý": -30, "Łÿ": -30,
+	"NA": -27, "NÁ": -27, "NĂ": -27, "NÂ": -27, "NÄ": -27, "NÀ": -27,
+	"NĀ": -27, "NĄ": -27, "NÅ": -27, "NÃ": -27,
+	"ŃA": -27, "ŃÁ": -27, "ŃĂ": -27, "ŃÂ": -27, "ŃÄ": -27, "ŃÀ": -27,
+	"ŃĀ": -27, "ŃĄ": -27, "ŃÅ": -27, "ŃÃ": -27,
+	"ŇA": -27, "ŇÁ": -27, "ŇĂ": -27, "ŇÂ": -27, "ŇÄ": -27, "ŇÀ": -27,
+	"ŇĀ": -27, "ŇĄ": -27, "ŇÅ": -27, "ŇÃ": -27,
+	"ŅA": -27, "ŅÁ": -27, "ŅĂ": -27, "ŅÂ": -27, "ŅÄ": -27, "ŅÀ": -27,
+	"ŅĀ": -27, "ŅĄ": -27, "ŅÅ": -27, "ŅÃ": -27,
+	"ÑA": -27, "ÑÁ": -27, "ÑĂ": -27, "ÑÂ": -27, "ÑÄ": -27, "ÑÀ": -27,
+	"ÑĀ": -27, "ÑĄ": -27, "ÑÅ": -27, "ÑÃ": -27,
+	"OA": -55, "OÁ": -55, "OĂ": -55, "OÂ": -55, "OÄ": -55, "OÀ": -55,
+	"OĀ": -55, "OĄ": -55, "OÅ": -55, "OÃ": -55, "OT": -40, "OŤ": -40,
+	"OŢ": -40, "OV": -50, "OW": -50, "OX": -40, "OY": -50, "OÝ": -50,
+	"OŸ": -50,
+	"ÓA": -55, "ÓÁ": -55, "ÓĂ": -55, "ÓÂ": -55, "ÓÄ": -55, "ÓÀ": -55,
+	"ÓĀ": -55, "ÓĄ": -55, "ÓÅ": -55, "ÓÃ": -55, "ÓT": -40, "ÓŤ": -40,
+	"ÓŢ": -40, "ÓV": -50, "ÓW": -50, "ÓX": -40, "ÓY": -50, "ÓÝ": -50,
+	"ÓŸ": -50,
+	"ÔA": -55, "ÔÁ": -55, "ÔĂ": -55, "ÔÂ": -55, "ÔÄ": -55, "ÔÀ": -55,
+	"ÔĀ": -55, "ÔĄ": -55, "ÔÅ": -55, "ÔÃ": -55, "ÔT": -40, "ÔŤ": -40,
+	"ÔŢ": -40, "ÔV": -50, "ÔW": -50, "ÔX": -40, "ÔY": -50, "ÔÝ": -50,
+	"ÔŸ": -50,
+	"ÖA": -55, "ÖÁ": -55, "ÖĂ": -55, "ÖÂ": -55, "ÖÄ": -55, "ÖÀ": -55,
+	"ÖĀ": -55, "ÖĄ": -55, "ÖÅ": -55, "ÖÃ": -55, "ÖT": -40, "ÖŤ": -40,
+	"ÖŢ": -40, "ÖV": -50, "ÖW": -50, "ÖX": -40, "ÖY": -50, "ÖÝ": -50,
+	"ÖŸ": -50,
+	"ÒA": -55, "ÒÁ": -55, "ÒĂ": -55, "ÒÂ": -55, "ÒÄ": -55, "ÒÀ": -55,
+	"ÒĀ": -55, "ÒĄ": -55, "ÒÅ": -55, "ÒÃ": -55, "ÒT": -40, "ÒŤ": -40,
+	"ÒŢ": -40, "ÒV": -50, "ÒW": -50, "ÒX": -40, "ÒY": -50, "ÒÝ": -50,
+	"ÒŸ": -50,
+	"ŐA": -55, "ŐÁ": -55, "ŐĂ": -55, "ŐÂ": -55, "ŐÄ": -55, "ŐÀ": -55,
+	"ŐĀ": -55, "ŐĄ": -55, "ŐÅ": -55, "ŐÃ": -55, "ŐT": -40, "ŐŤ": -40,
+	"ŐŢ": -40, "ŐV": -50, "ŐW": -50, "ŐX": -40, "ŐY": -50, "ŐÝ": -50,
+	"ŐŸ": -50,
+	"ŌA": -55, "ŌÁ": -55, "ŌĂ": -55, "ŌÂ": -55, "ŌÄ": -55, "ŌÀ": -55,
+	"ŌĀ": -55, "ŌĄ": -55, "ŌÅ": -55, "ŌÃ": -55, "ŌT": -40, "ŌŤ": -40,
+	"ŌŢ": -40, "ŌV": -50, "ŌW": -50, "ŌX": -40, "ŌY": -50, "ŌÝ": -50,
+	"ŌŸ": -50,
+	"ØA": -55, "ØÁ": -55, "ØĂ": -55, "ØÂ": -55, "ØÄ": -55, "ØÀ": -55,
+	"ØĀ": -55, "ØĄ": -55, "ØÅ": -55, "ØÃ": -55, "ØT": -40, "ØŤ": -40,
+	"ØŢ": -40, "ØV": -50, "ØW": -50, "ØX": -40, "ØY": -50, "ØÝ": -50,
+	"ØŸ": -50,
+	"ÕA": -55, "ÕÁ": -55, "ÕĂ": -55, "ÕÂ": -55, "ÕÄ": -55, "ÕÀ": -55,
+	"ÕĀ": -55, "ÕĄ": -55, "ÕÅ": -55, "ÕÃ": -55, "ÕT": -40, "ÕŤ": -40,
+	"ÕŢ": -40, "ÕV": -50, "ÕW": -50, "ÕX": -40, "ÕY": -50, "ÕÝ": -50,
+	"ÕŸ": -50,
+	"PA": -90, "PÁ": -90, "PĂ": -90, "PÂ": -90, "PÄ": -90, "PÀ": -90,
+	"PĀ": -90, "PĄ": -90, "PÅ": -90, "PÃ": -90, "Pa": -80, "Pá": -80,
+	"Pă": -80, "Pâ": -80, "Pä": -80, "Pà": -80, "Pā": -80, "Pą": -80,
+	"På": -80, "Pã": -80, "P,": -135, "Pe": -80, "Pé": -80, "Pě": -80,
+	"Pê": -80, "Pë": -80, "Pė": -80, "Pè": -80, "Pē": -80, "Pę": -80,
+	"Po": -80, "Pó": -80, "Pô": -80, "Pö": -80, "Pò": -80, "Pő": -80,
+	"Pō": -80, "Pø": -80, "Põ": -80, "P.": -135,
+	"QU": -10, "QÚ": -10, "QÛ": -10, "QÜ": -10, "QÙ": -10, "QŰ": -10,
+	"QŪ": -10, "QŲ": -10, "QŮ": -10,
+	"RO": -40, "RÓ": -40, "RÔ": -40, "RÖ": -40, "RÒ": -40, "RŐ": -40,
+	"RŌ": -40, "RØ": -40, "RÕ": -40, "RU": -40, "RÚ": -40, "RÛ": -40,
+	"RÜ": -40, "RÙ": -40, "RŰ": -40, "RŪ": -40, "RŲ": -40, "RŮ": -40,
+	"RV": -18, "RW": -18, "RY": -18, "RÝ": -18, "RŸ": -18,
+	"ŔO": -40, "ŔÓ": -40, "ŔÔ": -40, "ŔÖ": -40, "ŔÒ": -40, "ŔŐ": -40,
+	"ŔŌ": -40, "ŔØ": -40, "ŔÕ": -40, "ŔU": -40, "ŔÚ": -40, "ŔÛ": -40,
+	"ŔÜ": -40, "ŔÙ": -40, "ŔŰ": -40, "ŔŪ": -40, "ŔŲ": -40, "ŔŮ": -40,
+	"ŔV": -18, "ŔW": -18, "ŔY": -18, "ŔÝ": -18, "ŔŸ": -18,
+	"ŘO": -40, "ŘÓ": -40, "ŘÔ": -40, "ŘÖ": -40, "ŘÒ": -40, "ŘŐ": -40,
+	"ŘŌ": -40, "ŘØ": -40, "ŘÕ": -40, "ŘU": -40, "ŘÚ": -40, "ŘÛ": -40,
+	"ŘÜ": -40, "ŘÙ": -40, "ŘŰ": -40, "ŘŪ": -40, "ŘŲ": -40, "ŘŮ": -40,
+	"ŘV": -18, "ŘW": -18, "ŘY": -18, "ŘÝ": -18, "ŘŸ": -18,
+	"ŖO": -40, "ŖÓ": -40, "ŖÔ": -40, "ŖÖ": -40, "ŖÒ": -40, "ŖŐ": -40,
+	"ŖŌ": -40, "ŖØ": -40, "ŖÕ": -40, "ŖU": -40, "ŖÚ": -40, "ŖÛ": -40,
+	"ŖÜ": -40, "ŖÙ": -40, "ŖŰ": -40, "ŖŪ": -40, "ŖŲ": -40, "ŖŮ": -40,
+	"ŖV": -18, "ŖW": -18, "ŖY": -18, "ŖÝ": -18, "ŖŸ": -18,
+	"TA": -50, "TÁ": -50, "TĂ": -50, "TÂ": -50, "TÄ": -50, "TÀ": -50,
+	"TĀ": -50, "TĄ": -50, "TÅ": -50, "TÃ": -50, "TO": -18, "TÓ": -18,
+	"TÔ": -18, "TÖ": -18, "TÒ": -18, "TŐ": -18, "TŌ": -18, "TØ": -18,
+	"TÕ": -18, "Ta": -92, "Tá": -92, "Tă": -92, "Tâ": -92, "Tä": -92,
+	"Tà": -92, "Tā": -92, "Tą": -92, "Tå": -92, "Tã": -92, "T:": -55,
+	"T,": -74, "Te": -92, "Té": -92, "Tě": -92, "Tê": -52, "Të": -52,
+	"Tė": -92, "Tè": -52, "Tē": -52, "Tę": -92, "T-": -74, "Ti": -55,
+	"Tí": -55, "Tį": -55, "To": -92, "Tó": -92, "Tô": -92, "Tö": -92,
+	"Tò": -92, "Tő": -92, "Tō": -92, "Tø": -92, "Tõ": -92, "T.": -74,
+	"Tr": -55, "Tŕ": -55, "Tř": -55, "Tŗ": -55, "T;": -65, "Tu": -55,
+	"Tú": -55, "Tû": -55, "Tü": -55, "Tù": -55, "Tű": -55, "Tū": -55,
+	"Tų": -55, "Tů": -55, "Tw": -74, "Ty": -74, "Tý": -74, "Tÿ": -34,
+	"ŤA": -50, "ŤÁ": -50, "ŤĂ": -50, "ŤÂ": -50, "ŤÄ": -50, "ŤÀ": -50,
+	"ŤĀ": -50, "ŤĄ": -50, "ŤÅ": -50, "ŤÃ": -50, "ŤO": -18, "ŤÓ": -18,
+	"ŤÔ": -18, "ŤÖ": -18, "ŤÒ": -18, "ŤŐ": -18, "ŤŌ": -18, "ŤØ": -18,
+	"ŤÕ": -18, "Ťa": -92, "Ťá": -92, "Ťă": -92, "Ťâ": -92, "Ťä": -92,
+	"Ťà": -92, "Ťā": -92, "Ťą": -92, "Ťå": -92, "Ťã": -92, "Ť:": -55,
+	"Ť,": -74, "Ťe": -92, "Ťé": -92, "Ťě": -92, "Ťê": -52, "Ťë": -52,
+	"Ťė": -92, "Ťè": -52, "Ťē": -52, "Ťę": -92, "Ť-": -74, "Ťi": -55,
+	"Ťí": -55, "Ťį": -55, "Ťo": -92, "Ťó": -92, "Ťô": -92, "Ťö": -92,
+	"Ťò": -92, "Ťő": -92, "Ťō": -92, "Ťø": -92, "Ťõ": -92, "Ť.": -74,
+	"Ťr": -55, "Ťŕ": -55, "Ťř": -55, "Ťŗ": -55, "Ť;": -65, "Ťu": -55,
+	"Ťú": -55, "Ťû": -55, "Ťü": -55, "Ťù": -55, "Ťű": -55, "Ťū": -55,
+	"Ťų": -55, "Ťů": -55, "Ťw": -74, "Ťy": -74, "Ťý": -74, "Ťÿ": -34,
+	"ŢA": -50, "ŢÁ": -50, "ŢĂ": -50, "ŢÂ": -50, "ŢÄ": -50, "ŢÀ": -50,
+	"ŢĀ": -50, "ŢĄ": -50, "ŢÅ": -50, "ŢÃ": -50, "ŢO": -18, "ŢÓ": -18,
+	"ŢÔ": -18, "ŢÖ": -18, "ŢÒ": -18, "ŢŐ": -18, "ŢŌ": -18, "ŢØ": -18,
+	"ŢÕ": -18, "Ţa": -92, "Ţá": -92, "Ţă": -92, "Ţâ": -92, "Ţä": -92,
+	"Ţà": -92, "Ţā": -92, "Ţą": -92, "Ţå": -92, "Ţã": -92, "Ţ:": -55,
+	"Ţ,": -74, "Ţe": -92, "Ţé": -92, "Ţě": -92, "Ţê": -52, "Ţë": -52,
+	"Ţė": -92, "Ţè": -52, "Ţē": -52, "Ţę": -92, "Ţ-": -74, "Ţi": -55,
+	"Ţí": -55, "Ţį": -55, "Ţo": -92, "Ţó": -92, "Ţô": -92, "Ţö": -92,
+	"Ţò": -92, "Ţő": -92, "Ţō": -92, "Ţø": -92, "Ţõ": -92, "Ţ.": -74,
+	"Ţr": -55, "Ţŕ": -55, "Ţř": -55, "Ţŗ": -55, "Ţ;": -65, "Ţu": -55,
+	"Ţú": -55, "Ţû": -55, "Ţü": -55, "Ţù": -55, "Ţű": -55, "Ţū": -55,
+	"Ţų": -55, "Ţů": -55, "Ţw": -74, "Ţy": -74, "Ţý": -74, "Ţÿ": -34,
+	"UA": -40, "UÁ": -40, "UĂ": -40, "UÂ": -40, "UÄ": -40, "UÀ": -40,
+	"UĀ": -40, "UĄ": -40, "UÅ": -40, "UÃ": -40, "U,": -25, "U.": -25,
+	"ÚA": -40, "ÚÁ": -40, "ÚĂ": -40, "ÚÂ": -40, "ÚÄ": -40, "ÚÀ": -40,
+	"ÚĀ": -40, "ÚĄ": -40, "ÚÅ": -40, "ÚÃ": -40, "Ú,": -25, "Ú.": -25,
+	"ÛA": -40, "ÛÁ": -40, "ÛĂ": -40, "ÛÂ": -40, "ÛÄ": -40, "ÛÀ": -40,
+	"ÛĀ": -40, "ÛĄ": -40, "ÛÅ": -40, "ÛÃ": -40, "Û,": -25, "Û.": -25,
+	"ÜA": -40, "ÜÁ": -40, "ÜĂ": -40, "ÜÂ": -40, "ÜÄ": -40, "ÜÀ": -40,
+	"ÜĀ": -40, "ÜĄ": -40, "ÜÅ": -40, "ÜÃ": -40, "Ü,": -25, "Ü.": -25,
+	"ÙA": -40, "ÙÁ": -40, "ÙĂ": -40, "ÙÂ": -40, "ÙÄ": -40, "ÙÀ": -40,
+	"ÙĀ": -40, "ÙĄ": -40, "ÙÅ": -40, "ÙÃ": -40, "Ù,": -25, "Ù.": -25,
+	"ŰA": -40, "ŰÁ": -40, "ŰĂ": -40, "ŰÂ": -40, "ŰÄ": -40, "ŰÀ": -40,
+	"ŰĀ": -40, "ŰĄ": -40, "ŰÅ": -40, "ŰÃ": -40, "Ű,": -25, "Ű.": -25,
+	"ŪA": -40, "ŪÁ": -40, "ŪĂ": -40, "ŪÂ": -40, "ŪÄ": -40, "ŪÀ": -40,
+	"ŪĀ": -40, "ŪĄ": -40, "ŪÅ": -40, "ŪÃ": -40, "Ū,": -25, "Ū.": -25,
+	"ŲA": -40, "ŲÁ": -40, "ŲĂ": -40, "ŲÂ": -40, "ŲÄ": -40, "ŲÀ": -40,
+	"ŲĀ": -40, "ŲĄ": -40, "ŲÅ": -40, "ŲÃ": -40, "Ų,": -25, "Ų.": -25,
+	"ŮA": -40, "ŮÁ": -40, "ŮĂ": -40, "ŮÂ": -40, "ŮÄ": -40, "ŮÀ": -40,
+	"ŮĀ": -40, "ŮĄ": -40, "ŮÅ": -40, "ŮÃ": -40, "Ů,": -25, "Ů.": -25,
+	"VA": -60, "VÁ": -60, "VĂ": -60, "VÂ": -60, "VÄ": -60, "VÀ": -60,
+	"VĀ": -60, "VĄ": -60, "VÅ": -60, "VÃ": -60, "VO": -30, "VÓ": -30,
+	"VÔ": -30, "VÖ": -30, "VÒ": -30, "VŐ": -30, "VŌ": -30, "VØ": -30,
+	"VÕ": -30, "Va": -111, "Vá": -111, "Vă": -111, "Vâ": -111, "Vä": -111,
+	"Và": -111, "Vā": -111, "Vą": -111, "Vå": -111, "Vã": -111, "V:": -65,
+	"V,": -129, "Ve": -111, "Vé": -111, "Vě": -111, "Vê": -111, "Vë": -71,
+	"Vė": -111, "Vè": -71, "Vē": -71, "Vę": -111, "V-": -55, "Vi": -74,
+	"Ví": -74, "Vî": -34, "Vï": -34, "Vì": -34, "Vī": -34, "Vį": -74,
+	"Vo": -111, "Vó": -111, "Vô": -111, "Vö": -111, "Vò": -111, "Vő": -111,
+	"Vō": -111, "Vø": -111, "Võ": -111, "V.": -129, "V;": -74, "Vu": -74,
+	"Vú": -74, "Vû": -74, "Vü": -74, "Vù": -74, "Vű": -74, "Vū": -74,
+	"Vų": -74, "Vů": -74,
+	"WA": -60, "WÁ": -60, "WĂ": -60, "WÂ": -60, "WÄ": -60, "WÀ": -60,
+	"WĀ": -60, "WĄ": -60, "WÅ": -60, "WÃ": -60, "WO": -25, "WÓ": -25,
+	"WÔ": -25, "WÖ": -25, "WÒ": -25, "WŐ": -25, "WŌ": -25, "WØ": -25,
+	"WÕ": -25, "Wa": -92, "Wá": -92, "Wă": -92, "Wâ": -92, "Wä": -92,
+	"Wà": -92, "Wā": -92, "Wą": -92, "Wå": -92, "Wã": -92, "W:": -65,
+	"W,": -92, "We": -92, "Wé": -92, "Wě": -92, "Wê": -92, "Wë": -52,
+	"Wė": -92, "Wè": -52, "Wē": -52, "Wę": -92, "W-": -37, "Wi": -55,
+	"Wí": -55, "Wį": -55, "Wo": -92, "Wó": -92, "Wô": -92, "Wö": -92,
+	"Wò": -92, "Wő": -92, "Wō": -92, "Wø": -92, "Wõ": -92, "W.": -92,
+	"W;": -65, "Wu": -55, "Wú": -55, "Wû": -55, "Wü": -55, "Wù": -55,
+	"Wű": -55, "Wū": -55, "Wų": -55, "Wů": -55, "Wy": -70, "Wý": -70,
+	"Wÿ": -70,
+	"YA": -50, "YÁ": -50, "YĂ": -50, "YÂ": -50, "YÄ": -50, "YÀ": -50,
+	"YĀ": -50, "YĄ": -50, "YÅ": -50, "YÃ": -50, "YO": -15, "YÓ": -15,
+	"YÔ": -15, "YÖ": -15, "YÒ": -15, "YŐ": -15, "YŌ": -15, "YØ": -15,
+	"YÕ": -15, "Ya": -92, "Yá": -92, "Yă": -92, "Yâ": -92, "Yä": -92,
+	"Yà": -92, "Yā": -92, "Yą": -92, "Yå": -92, "Yã": -92, "Y:": -65,
+	"Y,": -92, "Ye": -92, "Yé": -92, "Yě": -92, "Yê": -92, "Yë": -52,
+	"Yė": -92, "Yè": -52, "Yē": -52, "Yę": -92, "Y-": -74, "Yi": -74,
+	"Yí": -74, "Yî": -34, "Yï": -34, "Yì": -34, "Yī": -34, "Yį": -74,
+	"Yo": -92, "Yó": -92, "Yô": -92, "Yö": -92, "Yò": -92, "Yő": -92,
+	"Yō": -92, "Yø": -92, "Yõ": -92, "Y.": -92, "Y;": -65, "Yu": -92,
+	"Yú": -92, "Yû": -92, "Yü": -92, "Yù": -92, "Yű": -92, "Yū": -92,
+	"Yų": -92, "Yů": -92,
+	"ÝA": -50, "ÝÁ": -50, "ÝĂ": -50, "ÝÂ": -50, "ÝÄ": -50, "ÝÀ": -50,
+	"ÝĀ": -50, "ÝĄ": -50, "ÝÅ": -50, "ÝÃ": -50, "ÝO": -15, "ÝÓ": -15,
+	"ÝÔ": -15, "ÝÖ": -15, "ÝÒ": -15, "ÝŐ": -15, "ÝŌ": -15, "ÝØ": -15,
+	"ÝÕ": -15, "Ýa": -92, "Ýá": -92, "Ýă": -92, "Ýâ": -92, "Ýä": -92,
+	"Ýà": -92, "Ýā": -92, "Ýą": -92, "Ýå": -92, "Ýã": -92, "Ý:": -65,
+	"Ý,": -92, "Ýe": -92, "Ýé": -92, "Ýě": -92, "Ýê": -92, "Ýë": -52,
+	"Ýė": -92, "Ýè": -52, "Ýē": -52, "Ýę": -92, "Ý-": -74, "Ýi": -74,
+	"Ýí": -74, "Ýî": -34, "Ýï": -34, "Ýì": -34, "Ýī": -34, "Ýį": -74,
+	"Ýo": -92, "Ýó": -92, "Ýô": -92, "Ýö": -92, "Ýò": -92, "Ýő": -92,
+	"Ýō": -92, "Ýø": -92, "Ýõ": -92, "Ý.": -92, "Ý;": -65, "Ýu": -92,
+	"Ýú": -92, "Ýû": -92, "Ýü": -92, "Ýù": -92, "Ýű": -92, "Ýū": -92,
+	"Ýų": -92, "Ýů": -92,
+	"ŸA": -50, "ŸÁ": -50, "ŸĂ": -50, "ŸÂ": -50, "ŸÄ": -50, "ŸÀ": -50,
+	"ŸĀ": -50, "ŸĄ": -50, "ŸÅ": -50, "ŸÃ": -50, "ŸO": -15, "ŸÓ": -15,
+	"ŸÔ": -15, "ŸÖ": -15, "ŸÒ": -15, "ŸŐ": -15, "ŸŌ": -15, "ŸØ": -15,
+	"ŸÕ": -15, "Ÿa": -92, "Ÿá": -92, "Ÿă": -92, "Ÿâ": -92, "Ÿä": -92,
+	"Ÿà": -92, "Ÿā": -92, "Ÿą": -92, "Ÿå": -92, "Ÿã": -92, "Ÿ:": -65,
+	"Ÿ,": -92, "Ÿe": -92, "Ÿé": -92, "Ÿě": -92, "Ÿê": -92, "Ÿë": -52,
+	"Ÿė": -92, "Ÿè": -52, "Ÿē": -52, "Ÿę": -92, "Ÿ-": -74, "Ÿi": -74,
+	"Ÿí": -74, "Ÿî": -34, "Ÿï": -34, "Ÿì": -34, "Ÿī": -34, "Ÿį": -74,
+	"Ÿo": -92, "Ÿó": -92, "Ÿô": -92, "Ÿö": -92, "Ÿò": -92, "Ÿő": -92,
+	"Ÿō": -92, "Ÿø": -92, "Ÿõ": -92, "Ÿ.": -92, "Ÿ;": -65, "Ÿu": -92,
+	"Ÿú": -92, "Ÿû": -92, "Ÿü": -92, "Ÿù": -92, "Ÿű": -92, "Ÿū": -92,
+	"Ÿų": -92, "Ÿů": -92,
+	"ag": -10, "ağ": -10, "aģ": -10,
+	"ág": -10, "áğ": -10, "áģ": -10,
+	"ăg": -10, "ăğ": -10, "ăģ": -10,
+	"âg": -10, "âğ": -10, "âģ": -10,
+	"äg": -10, "äğ": -10, "äģ": -10,
+	"àg": -10, "àğ": -10, "àģ": -10,
+	"āg": -10, "āğ": -10, "āģ": -10,
+	"ąg": -10, "ąğ": -10, "ąģ": -10,
+	"åg": -10, "åğ": -10, "åģ": -10,
+	"ãg": -10, "ãğ": -10, "ãģ": -10,
+	"b.": -40, "bu": -20, "bú": -20, "bû": -20, "bü": -20, "bù": -20,
+	"bű": -20, "bū": -20, "bų": -20, "bů": -20,
+	"ch": -15, "ck": -20, "cķ": -20,
+	"ćh": -15, "ćk": -20, "ćķ": -20,
+	"čh": -15, "čk": -20, "čķ": -20,
+	"çh": -15, "çk": -20, "çķ": -20,
+	",”": -140, ",’": -140,
+	"e,": -10, "eg": -40, "eğ": -40, "eģ": -40, "e.": -15, "ev": -15,
+	"ew": -15, "ex": -20, "ey": -30, "eý": -30, "eÿ": -30,
+	"é,": -10, "ég": -40, "éğ": -40, "éģ": -40, "é.": -15, "év": -15,
+	"éw": -15, "éx": -20, "éy": -30, "éý": -30, "éÿ": -30,
+	"ě,": -10, "ěg": -40, "ěğ": -40, "ěģ": -40, "ě.": -15, "ěv": -15,
+	"ěw": -15, "ěx": -20, "ěy": -30, "ěý": -30, "ěÿ": -30,
+	"ê,": -10, "êg": -40, "êğ": -40, "êģ": -40, "ê.": -15, "êv": -15,
+	"êw": -15, "êx": -20, "êy": -30, "êý": -30, "êÿ": -30,
+	"ë,": -10, "ëg": -40, "ëğ": -40, "ëģ": -40, "ë.": -15, "ëv": -15,
+	"ëw": -15, "ëx": -20, "ëy": -30, "ëý": -30, "ëÿ": -30,
+	"ė,": -10, "ėg": -40, "ėğ": -40, "ėģ": -40, "ė.": -15, "ėv": -15,
+	"ėw": -15, "ėx": -20, "ėy": -30, "ėý": -30, "ėÿ": -30,
+	"è,": -10, "èg": -40, "èğ": -40, "èģ": -40, "è.": -15, "èv": -15,
+	"èw": -15, "èx": -20, "èy": -30, "èý": -30, "èÿ": -30,
+	"ē,": -10, "ēg": -40, "ēğ": -40, "ēģ": -40, "ē.": -15, "ēv": -15,
+	"ēw": -15, "ēx": -20, "ēy": -30, "ēý": -30, "ēÿ": -30,
+	"ę,": -10, "ęg": -40, "ęğ": -40, "ęģ": -40, "ę.": -15, "ęv": -15,
+	"ęw": -15, "ęx": -20, "ęy": -30, "ęý": -30, "ęÿ": -30,
+	"f,": -10, "fı": -60, "ff": -18, "fi": -20, "fį": -20, "f.": -15,
+	"f’": 92,
+	"g,": -10, "ge": -10, "gé": -10, "gě": -10, "gê": -10, "gë": -10,
+	"gė": -10, "gè": -10, "gē": -10, "gę": -10, "gg": -10, "gğ": -10,
+	"gģ": -10, "g.": -15,
+	"ğ,": -10, "ğe": -10, "ğé": -10, "ğě": -10, "ğê": -10, "ğë": -10,
+	"ğė": -10, "ğè": -10, "ğē": -10, "ğę": -10, "ğg": -10, "ğğ": -10,
+	"ğģ": -10, "ğ.": -15,
+	"ģ,": -10, "ģe": -10, "ģé": -10, "ģě": -10, "ģê": -10, "ģë": -10,
+	"ģė": -10, "ģè": -10, "ģē": -10, "ģę": -10, "ģg": -10, "ģğ": -10,
+	"ģģ": -10, "ģ.": -15,
+	"ke": -10, "ké": -10, "kě": -10, "kê": -10, "kë": -10, "kė": -10,
+	"kè": -10, "kē": -10, "kę": -10, "ko": -10, "kó": -10, "kô": -10,
+	"kö": -10, "kò": -10, "kő": -10, "kō": -10, "kø": -10, "kõ": -10,
+	"ky": -10, "ký": -10, "kÿ": -10,
+	"ķe": -10, "ķé": -10, "ķě": -10, "ķê": -10, "ķë": -10, "ķė": -10,
+	"ķè": -10, "ķē": -10, "ķę": -10, "ķo": -10, "ķó": -10, "ķô": -10,
+	"ķö": -10, "ķò": -10, "ķő": -10, "ķō": -10, "ķø": -10, "ķõ": -10,
+	"ķy": -10, "ķý": -10, "ķÿ": -10,
+	"nv": -40,
+	"ńv": -40,
+	"ňv": -40,
+	"ņv": -40,
+	"ñv": -40,
+	"og": -10, "oğ": -10, "oģ": -10, "ov": -10,
+	"óg": -10, "óğ": -10, "óģ": -10, "óv": -10,
+	"ôg": -10, "ôğ": -10, "ôģ": -10, "ôv": -10,
+	"ög": -10, "öğ": -10, "öģ": -10, "öv": -10,
+	"òg": -10, "òğ": -10, "òģ": -10, "òv": -10,
+	"őg": -10, "őğ": -10, "őģ": -10, "őv": -10,
+	"ōg": -10, "ōğ": -10, "ōģ": -10, "ōv": -10,
+	"øg": -10, "øğ": -10, "øģ": -10, "øv": -10,
+	"õg": -10, "õğ": -10, "õģ": -10, "õv": -10,
+	".”": -140, ".’": -140,
+	"‘‘": -111,
+	"’d": -25, "’đ": -25, "’’": -111, "’r": -25, "’ŕ": -25, "’ř": -25,
+	"’ŗ": -25, "’s": -40, "’ś": -40, "’š": -40, "’ş": -40, "’ș": -40,
+	"’ ": -111, "’t": -30, "’ţ": -30, "’v": -10,
+	"ra": -15, "rá": -15, "ră": -15, "râ": -15, "rä": -15, "rà": -15,
+	"rā": -15, "rą": -15, "rå": -15, "rã": -15, "rc": -37, "rć": -37,
+	"rč": -37, "rç": -37, "r,": -111, "rd": -37, "rđ": -37, "re": -37,
+	"ré": -37, "rě": -37, "rê": -37, "rë": -37, "rė": -37, "rè": -37,
+	"rē": -37, "rę": -37, "rg": -37, "rğ": -37, "rģ": -37, "r-": -20,
+	"ro": -45, "ró": -45, "rô": -45, "rö": -45, "rò": -45, "rő": -45,
+	"rō": -45, "rø": -45, "rõ": -45, "r.": -111, "rq": -37, "rs": -10,
+	"rś": -10, "rš": -10, "rş": -10, "rș": -10,
+	"ŕa": -15, "ŕá": -15, "ŕă": -15, "ŕâ": -15, "ŕä": -15, "ŕà": -15,
+	"ŕā": -15, "ŕą": -15, "ŕå": -15, "ŕã": -15, "ŕc": -37, "ŕć": -37,
+	"ŕč": -37, "ŕç": -37, "ŕ,": -111, "ŕd": -37, "ŕđ": -37, "ŕe": -37,
+	"ŕé": -37, "ŕě": -37, "ŕê": -37, "ŕë": -37, "ŕė": -37, "ŕè": -37,
+	"ŕē": -37, "ŕę": -37, "ŕg": -37, "ŕğ": -37, "ŕģ": -37, "ŕ-": -20,
+	"ŕo": -45, "ŕó": -45, "ŕô": -45, "ŕö": -45, "ŕò": -45, "ŕő": -45,
+	"ŕō": -45, "ŕø": -45, "ŕõ": -45, "ŕ.": -111, "ŕq": -37, "ŕs": -10,
+	"ŕś": -10, "ŕš": -10, "ŕş": -10, "ŕș": -10,
+	"řa": -15, "řá": -15, "řă": -15, "řâ": -15, "řä": -15, "řà": -15,
+	"řā": -15, "řą": -15, "řå": -15, "řã": -15, "řc": -37, "řć": -37,
+	"řč": -37, "řç": -37, "ř,": -111, "řd": -37, "řđ": -37, "ře": -37,
+	"řé": -37, "řě": -37, "řê": -37, "řë": -37, "řė": -37, "řè": -37,
+	"řē": -37, "řę": -37, "řg": -37, "řğ": -37, "řģ": -37, "ř-": -20,
+	"řo": -45, "řó": -45, "řô": -45, "řö": -45, "řò": -45, "řő": -45,
+	"řō": -45, "řø": -45, "řõ": -45, "ř.": -111, "řq": -37, "řs": -10,
+	"řś": -10, "řš": -10, "řş": -10, "řș": -10,
+	"ŗa": -15, "ŗá": -15, "ŗă": -15, "ŗâ": -15, "ŗä": -15, "ŗà": -15,
+	"ŗā": -15, "ŗą": -15, "ŗå": -15, "ŗã": -15, "ŗc": -37, "ŗć": -37,
+	"ŗč": -37, "ŗç": -37, "ŗ,": -111, "ŗd": -37, "ŗđ": -37, "ŗe": -37,
+	"ŗé": -37, "ŗě": -37, "ŗê": -37, "ŗë": -37, "ŗė": -37, "ŗè": -37,
+	"ŗē": -37, "ŗę": -37, "ŗg": -37, "ŗğ": -37, "ŗģ": -37, "ŗ-": -20,
+	"ŗo": -45, "ŗó": -45, "ŗô": -45, "ŗö": -45, "ŗò": -45, "ŗő": -45,
+	"ŗō": -45, "ŗø": -45, "ŗõ": -45, "ŗ.": -111, "ŗq": -37, "ŗs": -10,
+	"ŗś": -10, "ŗš": -10, "ŗş": -10, "ŗș": -10,
+	" A": -18, " Á": -18, " Ă": -18, " Â": -18, " Ä": -18, " À": -18,
+	" Ā": -18, " Ą": -18, " Å": -18, " Ã": -18, " T": -18, " Ť": -18,
+	" Ţ": -18, " V": -35, " W": -40, " Y": -75, " Ý": -75, " Ÿ": -75,
+	"v,": -74, "v.": -74,
+	"w,": -74, "w.": -74,
+	"y,": -55, "y.": -55,
+	"ý,": -55, "ý.": -55,
+	"ÿ,": -55, "ÿ.": -55,
+} //                                                          pdfKernTimesItalic
+
+// pdfKernTimesRoman is the kerning of Times-Roman, from Times-Roman.afm
+// version 002.000 (UniqueID 43068)
+var pdfKernTimesRoman = map[string]int{
+	"AC": -40, "AĆ": -40, "AČ": -40, "AÇ": -40, "AG": -40, "AĞ": -40,
+	"AĢ": -40, "AO": -55, "AÓ": -55, "AÔ": -55, "AÖ": -55, "AÒ": -55,
+	"AŐ": -55, "AŌ": -55, "AØ": -55, "AÕ": -55, "AQ": -55, "AT": -111,
+	"AŤ": -111, "AŢ": -111, "AU": -55, "AÚ": -55, "AÛ": -55, "AÜ": -55,
+	"AÙ": -55, "AŰ": -55, "AŪ": -55, "AŲ": -55, "AŮ": -55, "AV": -135,
+	"AW": -90, "AY": -105, "AÝ": -105, "AŸ": -105, "A’": -111, "Av": -74,
+	"Aw": -92, "Ay": -92, "Aý": -92, "Aÿ": -92,
+	"ÁC": -40, "ÁĆ": -40, "ÁČ": -40, "ÁÇ": -40, "ÁG": -40, "ÁĞ": -40,
+	"ÁĢ": -40, "ÁO": -55, "ÁÓ": -55, "ÁÔ": -55, "ÁÖ": -55, "ÁÒ": -55,
+	"ÁŐ": -55, "ÁŌ": -55, "ÁØ": -55, "ÁÕ": -55, "ÁQ": -55, "ÁT": -111,
+	"ÁŤ": -111, "ÁŢ": -111, "ÁU": -55, "ÁÚ": -55, "ÁÛ": -55, "ÁÜ": -55,
+	"ÁÙ": -55, "ÁŰ": -55, "ÁŪ": -55, "ÁŲ": -55, "ÁŮ": -55, "ÁV": -135,
+	"ÁW": -90, "ÁY": -105, "ÁÝ": -105, "ÁŸ": -105, "Á’": -111, "Áv": -74,
+	"Áw": -92, "Áy": -92, "Áý": -92, "Áÿ": -92,
+	"ĂC": -40, "ĂĆ": -40, "ĂČ": -40, "ĂÇ": -40, "ĂG": -40, "ĂĞ": -40,
+	"ĂĢ": -40, "ĂO": -55, "ĂÓ": -55, "ĂÔ": -55, "ĂÖ": -55, "ĂÒ": -55,
+	"ĂŐ": -55, "ĂŌ": -55, "ĂØ": -55, "ĂÕ": -55, "ĂQ": -55, "ĂT": -111,
+	"ĂŤ": -111, "ĂŢ": -111, "ĂU": -55, "ĂÚ": -55, "ĂÛ": -55, "ĂÜ": -55,
+	"ĂÙ": -55, "ĂŰ": -55, "ĂŪ": -55, "ĂŲ": -55, "ĂŮ": -55, "ĂV": -135,
+	"ĂW": -90, "ĂY": -105, "ĂÝ": -105, "ĂŸ": -105, "Ă’": -111, "Ăv": -74,
+	"Ăw": -92, "Ăy": -92, "Ăý": -92, "Ăÿ": -92,
+	"ÂC": -40, "ÂĆ": -40, "ÂČ": -40, "ÂÇ": -40, "ÂG": -40, "ÂĞ": -40,
+	"ÂĢ": -40, "ÂO": -55, "ÂÓ": -55, "ÂÔ": -55, "ÂÖ": -55, "ÂÒ": -55,
+	"ÂŐ": -55, "ÂŌ": -55, "ÂØ": -55, "ÂÕ": -55, "ÂQ": -55, "ÂT": -111,
+	"ÂŤ": -111, "ÂŢ": -111, "ÂU": -55, "ÂÚ": -55, "ÂÛ": -55, "ÂÜ": -55,
+	"ÂÙ": -55, "ÂŰ": -55, "ÂŪ": -55, "ÂŲ": -55, "ÂŮ": -55, "ÂV": -135,
+	"ÂW": -90, "ÂY": -105, "ÂÝ": -105, "ÂŸ": -105, "Â’": -111, "Âv": -74,
+	"Âw": -92, "Ây": -92, "Âý": -92, "Âÿ": -92,
+	"ÄC": -40, "ÄĆ": -40, "ÄČ": -40, "ÄÇ": -40, "ÄG": -40, "ÄĞ": -40,
+	"ÄĢ": -40, "ÄO": -55, "ÄÓ": -55, "ÄÔ": -55, "ÄÖ": -55, "ÄÒ": -55,
+	"ÄŐ": -55, "ÄŌ": -55, "ÄØ": -55, "ÄÕ": -55, "ÄQ": -55, "ÄT": -111,
+	"ÄŤ": -111, "ÄŢ": -111, "ÄU": -55, "ÄÚ": -55, "ÄÛ": -55, "ÄÜ": -55,
+	"ÄÙ": -55, "ÄŰ": -55, "ÄŪ": -55, "ÄŲ": -55, "ÄŮ": -55, "ÄV": -135,
+	"ÄW": -90, "ÄY": -105, "ÄÝ": -105, "ÄŸ": -105, "Ä’": -111, "Äv": -74,
+	"Äw": -92, "Äy": -92, "Äý": -92, "Äÿ": -92,
+	"ÀC": -40, "ÀĆ": -40, "ÀČ": -40, "ÀÇ": -40, "ÀG": -40, "ÀĞ": -40,
+	"ÀĢ": -40, "ÀO": -55, "ÀÓ": -55, "ÀÔ": -55, "ÀÖ": -55, "ÀÒ": -55,
+	"ÀŐ": -55, "ÀŌ": -55, "ÀØ": -55, "ÀÕ": -55, "ÀQ": -55, "ÀT": -111,
+	"ÀŤ": -111, "ÀŢ": -111, "ÀU": -55, "ÀÚ": -55, "ÀÛ": -55, "ÀÜ": -55,
+	"ÀÙ": -55, "ÀŰ": -55, "ÀŪ": -55, "ÀŲ": -55, "ÀŮ": -55, "ÀV": -135,
+	"ÀW": -90, "ÀY": -105, "ÀÝ": -105, "ÀŸ": -105, "À’": -111, "Àv": -74,
+	"Àw": -92, "Ày": -92, "Àý": -92, "Àÿ": -92,
+	"ĀC": -40, "ĀĆ": -40, "ĀČ": -40, "ĀÇ": -40, "ĀG": -40, "ĀĞ": -40,
+	"ĀĢ": -40, "ĀO": -55, "ĀÓ": -55, "ĀÔ": -55, "ĀÖ": -55, "ĀÒ": -55,
+	"ĀŐ": -55, "ĀŌ": -55, "ĀØ": -55, "ĀÕ": -55, "ĀQ": -55, "ĀT": -111,
+	"ĀŤ": -111, "ĀŢ": -111, "ĀU": -55, "ĀÚ": -55, "ĀÛ": -55, "ĀÜ": -55,
+	"ĀÙ": -55, "ĀŰ": -55, "ĀŪ": -55, "ĀŲ": -55, "ĀŮ": -55, "ĀV": -135,
+	"ĀW": -90, "ĀY": -105, "ĀÝ": -105, "ĀŸ": -105, "Ā’": -111, "Āv": -74,
+	"Āw": -92, "Āy": -92, "Āý": -92, "Āÿ": -92,
+	"ĄC": -40, "ĄĆ": -40, "ĄČ": -40, "ĄÇ": -40, "ĄG": -40, "ĄĞ": -40,
+	"ĄĢ": -40, "ĄO": -55, "ĄÓ": -55, "ĄÔ": -55, "ĄÖ": -55, "ĄÒ": -55,
+	"ĄŐ": -55, "ĄŌ": -55, "ĄØ": -55, "ĄÕ": -55, "ĄQ": -55, "ĄT": -111,
+	"ĄŤ": -111, "ĄŢ": -111, "ĄU": -55, "ĄÚ": -55, "ĄÛ": -55, "ĄÜ": -55,
+	"ĄÙ": -55, "ĄŰ": -55, "ĄŪ": -55, "ĄŲ": -55, "ĄŮ": -55, "ĄV": -135,
+	"ĄW": -90, "ĄY": -105, "ĄÝ": -105, "ĄŸ": -105, "Ą’": -111, "Ąv": -74,
+	"Ąw": -52, "Ąy": -52, "Ąý": -52, "Ąÿ": -52,
+	"ÅC": -40, "ÅĆ": -40, "ÅČ": -40, "ÅÇ": -40, "ÅG": -40, "ÅĞ": -40,
+	"ÅĢ": -40, "ÅO": -55, "ÅÓ": -55, "ÅÔ": -55, "ÅÖ": -55, "ÅÒ": -55,
+	"ÅŐ": -55, "ÅŌ": -55, "ÅØ": -55, "ÅÕ": -55, "ÅQ": -55, "ÅT": -111,
+	"ÅŤ": -111, "ÅŢ": -111, "ÅU": -55, "ÅÚ": -55, "ÅÛ": -55, "ÅÜ": -55,
+	"ÅÙ": -55, "ÅŰ": -55, "ÅŪ": -55, "ÅŲ": -55, "ÅŮ": -55, "ÅV": -135,
+	"ÅW": -90, "ÅY": -105, "ÅÝ": -105, "ÅŸ": -105, "Å’": -111, "Åv": -74,
+	"Åw": -92, "Åy": -92, "Åý": -92, "Åÿ": -92,
+	"ÃC": -40, "ÃĆ": -40, "ÃČ": -40, "ÃÇ": -40, "ÃG": -40, "ÃĞ": -40,
+	"ÃĢ": -40, "ÃO": -55, "ÃÓ": -55, "ÃÔ": -55, "ÃÖ": -55, "ÃÒ": -55,
+	"ÃŐ": -55, "ÃŌ": -55, "ÃØ": -55, "ÃÕ": -55, "ÃQ": -55, "ÃT": -111,
+	"ÃŤ": -111, "ÃŢ": -111, "ÃU": -55, "ÃÚ": -55, "ÃÛ": -55, "ÃÜ": -55,
+	"ÃÙ": -55, "ÃŰ": -55, "ÃŪ": -55, "ÃŲ": -55, "ÃŮ": -55, "ÃV": -135,
+	"ÃW": -90, "ÃY": -105, "ÃÝ": -105, "ÃŸ": -105, "Ã’": -111, "Ãv": -74,
+	"Ãw": -92, "Ãy": -92, "Ãý": -92, "Ãÿ": -92,
+	"BA": -35, "BÁ": -35, "BĂ": -35, "BÂ": -35, "BÄ": -35, "BÀ": -35,
+	"BĀ": -35, "BĄ": -35, "BÅ": -35, "BÃ": -35, "BU": -10, "BÚ": -10,
+	"BÛ": -10, "BÜ": -10, "BÙ": -10, "BŰ": -10, "BŪ": -10, "BŲ": -10,
+	"BŮ": -10,
+	"DA": -40, "DÁ": -40, "DĂ": -40, "DÂ": -40, "DÄ": -40, "DÀ": -40,
+	"DĀ": -40, "DĄ": -40, "DÅ": -40, "DÃ": -40, "DV": -40, "DW": -30,
+	"DY": -55, "DÝ": -55, "DŸ": -55,
+	"ĎA": -40, "ĎÁ": -40, "ĎĂ": -40, "ĎÂ": -40, "ĎÄ": -40, "ĎÀ": -40,
+	"ĎĀ": -40, "ĎĄ": -40, "ĎÅ": -40, "ĎÃ": -40, "ĎV": -40, "ĎW": -30,
+	"ĎY": -55, "ĎÝ": -55, "ĎŸ": -55,
+	"ĐA": -40, "ĐÁ": -40, "ĐĂ": -40, "ĐÂ": -40, "ĐÄ": -40, "ĐÀ": -40,
+	"ĐĀ": -40, "ĐĄ": -40, "ĐÅ": -40, "ĐÃ": -40, "ĐV": -40, "ĐW": -30,
+	"ĐY": -55, "ĐÝ": -55, "ĐŸ": -55,
+	"FA": -74, "FÁ": -74, "FĂ": -74, "FÂ": -74, "FÄ": -74, "FÀ": -74,
+	"FĀ": -74, "FĄ": -74, "FÅ": -74, "FÃ": -74, "Fa": -15, "Fá": -15,
+	"Fă": -15, "Fâ": -15, "Fä": -15, "Fà": -15, "Fā": -15, "Fą": -15,
+	"Få": -15, "Fã": -15, "F,": -80, "Fo": -15, "Fó": -15, "Fô": -15,
+	"Fö": -15, "Fò": -15, "Fő": -15, "Fō": -15, "Fø": -15, "Fõ": -15,
+	"F.": -80,
+	"JA": -60, "JÁ": -60, "JĂ": -60, "JÂ": -60, "JÄ": -60, "JÀ": -60,
+	"JĀ": -60, "JĄ": -60, "JÅ": -60, "JÃ": -60,
+	"KO": -30, "KÓ": -30, "KÔ": -30, "KÖ": -30, "KÒ": -30, "KŐ": -30,
+	"KŌ": -30, "KØ": -30, "KÕ": -30, "Ke": -25, "Ké": -25, "Kě": -25,
+	"Kê": -25, "Kë": -25, "Kė": -25, "Kè": -25, "Kē": -25, "Kę": -25,
+	"Ko": -35, "Kó": -35, "Kô": -35, "Kö": -35, "Kò": -35, "Kő": -35,
+	"Kō": -35, "Kø": -35, "Kõ": -35, "Ku": -15, "Kú": -15, "Kû": -15,
+	"Kü": -15, "Kù": -15, "Kű": -15, "Kū": -15, "Kų": -15, "Ků": -15,
+	"Ky": -25, "Ký": -25, "Kÿ": -25,
+	"ĶO": -30, "ĶÓ": -30, "ĶÔ": -30, "ĶÖ": -30, "ĶÒ": -30, "ĶŐ": -30,
+	"ĶŌ": -30, "ĶØ": -30, "ĶÕ": -30, "Ķe": -25, "Ķé": -25, "Ķě": -25,
+	"Ķê": -25, "Ķë": -25, "Ķė": -25, "Ķè": -25, "Ķē": -25, "Ķę": -25,
+	"Ķo": -35, "Ķó": -35, "Ķô": -35, "Ķö": -35, "Ķò": -35, "Ķő": -35,
+	"Ķō": -35, "Ķø": -35, "Ķõ": -35, "Ķu": -15, "Ķú": -15, "Ķû": -15,
+	"Ķü": -15, "Ķù": -15, "Ķű": -15, "Ķū": -15, "Ķų": -15, "Ķů": -15,
+	"Ķy": -25, "Ķý": -25, "Ķÿ": -25,
+	"LT": -92, "LŤ": -92, "LŢ": -92, "LV": -100, "LW": -74, "LY": -100,
+	"LÝ": -100, "LŸ": -100, "L’": -92, "Ly": -55, "Lý": -55, "Lÿ": -55,
+	"ĹT": -92, "ĹŤ": -92, "ĹŢ": -92, "ĹV": -100, "ĹW": -74, "ĹY": -100,
+	"ĹÝ": -100, "ĹŸ": -100, "Ĺ’": -92, "Ĺy": -55, "Ĺý": -55, "Ĺÿ": -55,
+	"Ľ’": -92, "Ľy": -55, "Ľý": -55, "Ľÿ": -55,
+	"ĻT": -92, "ĻŤ": -92, "ĻŢ": -92, "ĻV": -100, "ĻW": -74, "ĻY": -100,
+	"ĻÝ": -100, "ĻŸ": -100, "Ļ’": -92, "Ļy": -55, "Ļý": -55, "Ļÿ": -55,
+	"ŁT": -92, "ŁŤ": -92, "ŁŢ": -92, "ŁV": -100, "ŁW": -74, "ŁY": -100,
+	"ŁÝ": -100, "ŁŸ": -100, "Ł’": -92, "Ły": -55, "Łý": -55, "Łÿ": -55,
+	"NA": -35, "NÁ": -35, "NĂ": -35, "NÂ": -35, "NÄ": -35, "NÀ": -35,
+	"NĀ": -35, "NĄ": -35, "NÅ": -35, "NÃ": -35,
+	"ŃA": -35, "ŃÁ": -35, "ŃĂ": -35, "ŃÂ": -35, "ŃÄ": -35, "ŃÀ": -35,
+	"ŃĀ": -35, "ŃĄ": -35, "ŃÅ": -35, "ŃÃ": -35,
+	"ŇA": -35, "ŇÁ": -35, "ŇĂ": -35, "ŇÂ": -35, "ŇÄ": -35, "ŇÀ": -35,
+	"ŇĀ": -35, "ŇĄ": -35, "ŇÅ": -35, "ŇÃ": -35,
+	"ŅA": -35, "ŅÁ": -35, "ŅĂ": -35, "ŅÂ": -35, "ŅÄ": -35, "ŅÀ": -35,
+	"ŅĀ": -35, "ŅĄ": -35, "ŅÅ": -35, "ŅÃ": -35,
+	"ÑA": -35, "ÑÁ": -35, "ÑĂ": -35, "ÑÂ": -35, "ÑÄ": -35, "ÑÀ": -35,
+	"ÑĀ": -35, "ÑĄ": -35, "ÑÅ": -35, "ÑÃ": -35,
+	"OA": -35, "OÁ": -35, "OĂ": -35, "OÂ": -35, "OÄ": -35, "OÀ": -35,
+	"OĀ": -35, "OĄ": -35, "OÅ": -35, "OÃ": -35, "OT": -40, "OŤ": -40,
+	"OŢ": -40, "OV": -50, "OW": -35, "OX": -40, "OY": -50, "OÝ": -50,
+	"OŸ": -50,
+	"ÓA": -35, "ÓÁ": -35, "ÓĂ": -35, "ÓÂ": -35, "ÓÄ": -35, "ÓÀ": -35,
+	"ÓĀ": -35, "ÓĄ": -35, "ÓÅ": -35, "ÓÃ": -35, "ÓT": -40, "ÓŤ": -40,
+	"ÓŢ": -40, "ÓV": -50, "ÓW": -35, "ÓX": -40, "ÓY": -50, "ÓÝ": -50,
+	"ÓŸ": -50,
+	"ÔA": -35, "ÔÁ": -35, "ÔĂ": -35, "ÔÂ": -35, "ÔÄ": -35, "ÔÀ": -35,
+	"ÔĀ": -35, "ÔĄ": -35, "ÔÅ": -35, "ÔÃ": -35, "ÔT": -40, "ÔŤ": -40,
+	"ÔŢ": -40, "ÔV": -50, "ÔW": -35, "ÔX": -40, "ÔY": -50, "ÔÝ": -50,
+	"ÔŸ": -50,
+	"ÖA": -35, "ÖÁ": -35, "ÖĂ": -35, "ÖÂ": -35, "ÖÄ": -35, "ÖÀ": -35,
+	"ÖĀ": -35, "ÖĄ": -35, "ÖÅ": -35, "ÖÃ": -35, "ÖT": -40, "ÖŤ": -40,
+	"ÖŢ": -40, "ÖV": -50, "ÖW": -35, "ÖX": -40, "ÖY": -50, "ÖÝ": -50,
+	"ÖŸ": -50,
+	"ÒA": -35, "ÒÁ": -35, "ÒĂ": -35, "ÒÂ": -35, "ÒÄ": -35, "ÒÀ": -35,
+	"ÒĀ": -35, "ÒĄ": -35, "ÒÅ": -35, "ÒÃ": -35, "ÒT": -40, "ÒŤ": -40,
+	"ÒŢ": -40, "ÒV": -50, "ÒW": -35, "ÒX": -40, "ÒY": -50, "ÒÝ": -50,
+	"ÒŸ": -50,
+	"ŐA": -35, "ŐÁ": -35, "ŐĂ": -35, "ŐÂ": -35, "ŐÄ": -35, "ŐÀ": -35,
+	"ŐĀ": -35, "ŐĄ": -35, "ŐÅ": -35, "ŐÃ": -35, "ŐT": -40, "ŐŤ": -40,
+	"ŐŢ": -40, "ŐV": -50, "ŐW": -35, "ŐX": -40, "ŐY": -50, "ŐÝ": -50,
+	"ŐŸ": -50,
+	"ŌA": -35, "ŌÁ": -35, "ŌĂ": -35, "ŌÂ": -35, "ŌÄ": -35, "ŌÀ": -35,
+	"ŌĀ": -35, "ŌĄ": -35, "ŌÅ": -35, "ŌÃ": -35, "ŌT": -40, "ŌŤ": -40,
+	"ŌŢ": -40, "ŌV": -50, "ŌW": -35, "ŌX": -40, "ŌY": -50, "ŌÝ": -50,
+	"ŌŸ": -50,
+	"ØA": -35, "ØÁ": -35, "ØĂ": -35, "ØÂ": -35, "ØÄ": -35, "ØÀ": -35,
+	"ØĀ": -35, "ØĄ": -35, "ØÅ": -35, "ØÃ": -35, "ØT": -40, "ØŤ": -40,
+	"ØŢ": -40, "ØV": -50, "ØW": -35, "ØX": -40, "ØY": -50, "ØÝ": -50,
+	"ØŸ": -50,
+	"ÕA": -35, "ÕÁ": -35, "ÕĂ": -35, "ÕÂ": -35, "ÕÄ": -35, "ÕÀ": -35,
+	"ÕĀ": -35, "ÕĄ": -35, "ÕÅ": -35, "ÕÃ": -35, "ÕT": -40, "ÕŤ": -40,
+	"ÕŢ": -40, "ÕV": -50, "ÕW": -35, "ÕX": -40, "ÕY": -50, "ÕÝ": -50,
+	"ÕŸ": -50,
+	"PA": -92, "PÁ": -92, "PĂ": -92, "PÂ": -92, "PÄ": -92, "PÀ": -92,
+	"PĀ": -92, "PĄ": -92, "PÅ": -92, "PÃ": -92, "Pa": -15, "Pá": -15,
+	"Pă": -15, "Pâ": -15, "Pä": -15, "Pà": -15, "Pā": -15, "Pą": -15,
+	"På": -15, "Pã": -15, "P,": -111, "P.": -111,
+	"QU": -10, "QÚ": -10, "QÛ": -10, "QÜ": -10, "QÙ": -10, "QŰ": -10,
+	"QŪ": -10, "QŲ": -10, "QŮ": -10,
+	"RO": -40, "RÓ": -40, "RÔ": -40, "RÖ": -40, "RÒ": -40, "RŐ": -40,
+	"RŌ": -40, "RØ": -40, "RÕ": -40, "RT": -60, "RŤ": -60, "RŢ": -60,
+	"RU": -40, "RÚ": -40, "RÛ": -40, "RÜ": -40, "RÙ": -40, "RŰ": -40,
+	"RŪ": -40, "RŲ": -40, "RŮ": -40, "RV": -80, "RW": -55, "RY": -65,
+	"RÝ": -65, "RŸ": -65,
+	"ŔO": -40, "ŔÓ": -40, "ŔÔ": -40, "ŔÖ": -40, "ŔÒ": -40, "ŔŐ": -40,
+	"ŔŌ": -40, "ŔØ": -40, "ŔÕ": -40, "ŔT": -60, "ŔŤ": -60, "ŔŢ": -60,
+	"ŔU": -40, "ŔÚ": -40, "ŔÛ": -40, "ŔÜ": -40, "ŔÙ": -40, "ŔŰ": -40,
+	"ŔŪ": -40, "ŔŲ": -40, "ŔŮ": -40, "ŔV": -80, "ŔW": -55, "ŔY": -65,
+	"ŔÝ": -65, "ŔŸ": -65,
+	"ŘO": -40, "ŘÓ": -40, "ŘÔ": -40, "ŘÖ": -40, "ŘÒ": -40, "ŘŐ": -40,
+	"ŘŌ": -40, "ŘØ": -40, "ŘÕ": -40, "ŘT": -60, "ŘŤ": -60, "ŘŢ": -60,
+	"ŘU": -40, "ŘÚ": -40, "ŘÛ": -40, "ŘÜ": -40, "ŘÙ": -40, "ŘŰ": -40,
+	"ŘŪ": -40, "ŘŲ": -40, "ŘŮ": -40, "ŘV": -80, "ŘW": -55, "ŘY": -65,
+	"ŘÝ": -65, "ŘŸ": -65,
+	"ŖO": -40, "ŖÓ": -40, "ŖÔ": -40, "ŖÖ": -40, "ŖÒ": -40, "ŖŐ": -40,
+	"ŖŌ": -40, "ŖØ": -40, "ŖÕ": -40, "ŖT": -60, "ŖŤ": -60, "ŖŢ": -60,
+	"ŖU": -40, "ŖÚ": -40, "ŖÛ": -40, "ŖÜ": -40, "ŖÙ": -40, "ŖŰ": -40,
+	"ŖŪ": -40, "ŖŲ": -40, "ŖŮ": -40, "ŖV": -80, "ŖW": -55, "ŖY": -65,
+	"ŖÝ": -65, "ŖŸ": -65,
+	"TA": -93, "TÁ": -93, "TĂ": -93, "TÂ": -93, "TÄ": -93, "TÀ": -93,
+	"TĀ": -93, "TĄ": -93, "TÅ": -93, "TÃ": -93, "TO": -18, "TÓ": -18,
+	"TÔ": -18, "TÖ": -18, "TÒ": -18, "TŐ": -18, "TŌ": -18, "TØ": -18,
+	"TÕ": -18, "Ta": -80, "Tá": -80, "Tă": -80, "Tâ": -80, "Tä": -40,
+	"Tà": -40, "Tā": -40, "Tą": -80, "Tå": -80, "Tã": -40, "T:": -50,
+	"T,": -74, "Te": -70, "Té": -70, "Tě": -70, "Tê": -70, "Të": -30,
+	"Tė": -70, "Tè": -70, "Tē": -30, "Tę": -70, "T-": -92, "Ti": -35,
+	"Tí": -35, "Tį": -35, "To": -80, "Tó": -80, "Tô": -80, "Tö": -80,
+	"Tò": -80, "Tő": -80, "Tō": -80, "Tø": -80, "Tõ": -80, "T.": -74,
+	"Tr": -35, "Tŕ": -35, "Tř": -35, "Tŗ": -35, "T;": -55, "Tu": -45,
+	"Tú": -45, "Tû": -45, "Tü": -45, "Tù": -45, "Tű": -45, "Tū": -45,
+	"Tų": -45, "Tů": -45, "Tw": -80, "Ty": -80, "Tý": -80, "Tÿ": -80,
+	"ŤA": -93, "ŤÁ": -93, "ŤĂ": -93, "ŤÂ": -93, "ŤÄ": -93, "ŤÀ": -93,
+	"ŤĀ": -93, "ŤĄ": -93, "ŤÅ": -93, "ŤÃ": -93, "ŤO": -18, "ŤÓ": -18,
+	"ŤÔ": -18, "ŤÖ": -18, "ŤÒ": -18, "ŤŐ": -18, "ŤŌ": -18, "ŤØ": -18,
+	"ŤÕ": -18, "Ťa": -80, "Ťá": -80, "Ťă": -80, "Ťâ": -80, "Ťä": -40,
+	"Ťà": -40, "Ťā": -40, "Ťą": -80, "Ťå": -80, "Ťã": -40, "Ť:": -50,
+	"Ť,": -74, "Ťe": -70, "Ťé": -70, "Ťě": -70, "Ťê": -30, "Ťë": -30,
+	"Ťė": -70, "Ťè": -70, "Ťē": -30, "Ťę": -70, "Ť-": -92, "Ťi": -35,
+	"Ťí": -35, "Ťį": -35, "Ťo": -80, "Ťó": -80, "Ťô": -80, "Ťö": -80,
+	"Ťò": -80, "Ťő": -80, "Ťō": -80, "Ťø": -80, "Ťõ": -80, "Ť.": -74,
+	"Ťr": -35, "Ťŕ": -35, "Ťř": -35, "Ťŗ": -35, "Ť;": -55, "Ťu": -45,
+	"Ťú": -45, "Ťû": -45, "Ťü": -45, "Ťù": -45, "Ťű": -45, "Ťū": -45,
+	"Ťų": -45, "Ťů": -45, "Ťw": -80, "Ťy": -80, "Ťý": -80, "Ťÿ": -80,
+	"ŢA": -93, "ŢÁ": -93, "ŢĂ": -93, "ŢÂ": -93, "ŢÄ": -93, "ŢÀ": -93,
+	"ŢĀ": -93, "ŢĄ": -93, "ŢÅ": -93, "ŢÃ": -93, "ŢO": -18, "ŢÓ": -18,
+	"ŢÔ": -18, "ŢÖ": -18, "ŢÒ": -18, "ŢŐ": -18, "ŢŌ": -18, "ŢØ": -18,
+	"ŢÕ": -18, "Ţa": -80, "Ţá": -80, "Ţă": -80, "Ţâ": -80, "Ţä": -40,
+	"Ţà": -40, "Ţā": -40, "Ţą": -80, "Ţå": -80, "Ţã": -40, "Ţ:": -50,
+	"Ţ,": -74, "Ţe": -70, "Ţé": -70, "Ţě": -70, "Ţê": -30, "Ţë": -30,
+	"Ţė": -70, "Ţè": -30, "Ţē": -70, "Ţę": -70, "Ţ-": -92, "Ţi": -35,
+	"Ţí": -35, "Ţį": -35, "Ţo": -80, "Ţó": -80, "Ţô": -80, "Ţö": -80,
+	"Ţò": -80, "Ţő": -80, "Ţō": -80, "Ţø": -80, "Ţõ": -80, "Ţ.": -74,
+	"Ţr": -35, "Ţŕ": -35, "Ţř": -35, "Ţŗ": -35, "Ţ;": -55, "Ţu": -45,
+	"Ţú": -45, "Ţû": -45, "Ţü": -45, "Ţù": -45, "Ţű": -45, "Ţū": -45,
+	"Ţų": -45, "Ţů": -45, "Ţw": -80, "Ţy": -80, "Ţý": -80, "Ţÿ": -80,
+	"UA": -40, "UÁ": -40, "UĂ": -40, "UÂ": -40, "UÄ": -40, "UÀ": -40,
+	"UĀ": -40, "UĄ": -40, "UÅ": -40, "UÃ": -40,
+	"ÚA": -40, "ÚÁ": -40, "ÚĂ": -40, "ÚÂ": -40, "ÚÄ": -40, "ÚÀ": -40,
+	"ÚĀ": -40, "ÚĄ": -40, "ÚÅ": -40, "ÚÃ": -40,
+	"ÛA": -40, "ÛÁ": -40, "ÛĂ": -40, "ÛÂ": -40, "ÛÄ": -40, "ÛÀ": -40,
+	"ÛĀ": -40, "ÛĄ": -40, "ÛÅ": -40, "ÛÃ": -40,
+	"ÜA": -40, "ÜÁ": -40, "ÜĂ": -40, "ÜÂ": -40, "ÜÄ": -40, "ÜÀ": -40,
+	"ÜĀ": -40, "ÜĄ": -40, "ÜÅ": -40, "ÜÃ": -40,
+	"ÙA": -40, "ÙÁ": -40, "ÙĂ": -40, "ÙÂ": -40, "ÙÄ": -40, "ÙÀ": -40,
+	"ÙĀ": -40, "ÙĄ": -40, "ÙÅ": -40, "ÙÃ": -40,
+	"ŰA": -40, "ŰÁ": -40, "ŰĂ": -40, "ŰÂ": -40, "ŰÄ": -40, "ŰÀ": -40,
+	"ŰĀ": -40, "ŰĄ": -40, "ŰÅ": -40, "ŰÃ": -40,
+	"ŪA": -40, "ŪÁ": -40, "ŪĂ": -40, "ŪÂ": -40, "ŪÄ": -40, "ŪÀ": -40,
+	"ŪĀ": -40, "ŪĄ": -40, "ŪÅ": -40, "ŪÃ": -40,
+	"ŲA": -40, "ŲÁ": -40, "ŲĂ": -40, "ŲÂ": -40, "ŲÄ": -40, "ŲÀ": -40,
+	"ŲĀ": -40, "ŲĄ": -40, "ŲÅ": -40, "ŲÃ": -40,
+	"ŮA": -40, "ŮÁ": -40, "ŮĂ": -40, "ŮÂ": -40, "ŮÄ": -40, "ŮÀ": -40,
+	"ŮĀ": -40, "ŮĄ": -40, "ŮÅ": -40, "ŮÃ": -40,
+	"VA": -135, "VÁ": -135, "VĂ": -135, "VÂ": -135, "VÄ": -135, "VÀ": -135,
+	"VĀ": -135, "VĄ": -135, "VÅ": -135, "VÃ": -135, "VG": -15, "VĞ": -15,
+	"VĢ": -15, "VO": -40, "VÓ": -40, "VÔ": -40, "VÖ": -40, "VÒ": -40,
+	"VŐ": -40, "VŌ": -40, "VØ": -40, "VÕ": -40, "Va": -111, "Vá": -111,
+	"Vă": -111, "Vâ": -71, "Vä": -71, "Và": -71, "Vā": -71, "Vą": -111,
+	"Vå": -111, "Vã": -71, "V:": -74, "V,": -129, "Ve": -111, "Vé": -111,
+	"Vě": -71, "Vê": -71, "Vë": -71, "Vė": -111, "Vè": -71, "Vē": -71,
+	"Vę": -111, "V-": -100, "Vi": -60, "Ví": -60, "Vî": -20, "Vï": -20,
+	"Vì": -20, "Vī": -20, "Vį": -60, "Vo": -129, "Vó": -129, "Vô": -129,
+	"Vö": -89, "Vò": -89, "Vő": -129, "Vō": -89, "Vø": -129, "Võ": -89,
+	"V.": -129, "V;": -74, "Vu": -75, "Vú": -75, "Vû": -75, "Vü": -75,
+	"Vù": -75, "Vű": -75, "Vū": -75, "Vų": -75, "Vů": -75,
+	"WA": -120, "WÁ": -120, "WĂ": -120, "WÂ": -120, "WÄ": -120, "WÀ": -120,
+	"WĀ": -120, "WĄ": -120, "WÅ": -120, "WÃ": -120, "WO": -10, "WÓ": -10,
+	"WÔ": -10, "WÖ": -10, "WÒ": -10, "WŐ": -10, "WŌ": -10, "WØ": -10,
+	"WÕ": -10, "Wa": -80, "Wá": -80, "Wă": -80, "Wâ": -80, "Wä": -80,
+	"Wà": -80, "Wā": -80, "Wą": -80, "Wå": -80, "Wã": -80, "W:": -37,
+	"W,": -92, "We": -80, "Wé": -80, "Wě": -80, "Wê": -80, "Wë": -40,
+	"Wė": -80, "Wè": -40, "Wē": -40, "Wę": -80, "W-": -65, "Wi": -40,
+	"Wí": -40, "Wį": -40, "Wo": -80, "Wó": -80, "Wô": -80, "Wö": -80,
+	"Wò": -80, "Wő": -80, "Wō": -80, "Wø": -80, "Wõ": -80, "W.": -92,
+	"W;": -37, "Wu": -50, "Wú": -50, "Wû": -50, "Wü": -50, "Wù": -50,
+	"Wű": -50, "Wū": -50, "Wų": -50, "Wů": -50, "Wy": -73, "Wý": -73,
+	"Wÿ": -73,
+	"YA": -120, "YÁ": -120, "YĂ": -120, "YÂ": -120, "YÄ": -120, "YÀ": -120,
+	"YĀ": -120, "YĄ": -120, "YÅ": -120, "YÃ": -120, "YO": -30, "YÓ": -30,
+	"YÔ": -30, "YÖ": -30, "YÒ": -30, "YŐ": -30, "YŌ": -30, "YØ": -30,
+	"YÕ": -30, "Ya": -100, "Yá": -100, "Yă": -100, "Yâ": -100, "Yä": -60,
+	"Yà": -60, "Yā": -60, "Yą": -100, "Yå": -100, "Yã": -60, "Y:": -92,
+	"Y,": -129, "Ye": -100, "Yé": -100, "Yě": -100, "Yê": -100, "Yë": -60,
+	"Yė": -100, "Yè": -60, "Yē": -60, "Yę": -100, "Y-": -111, "Yi": -55,
+	"Yí": -55, "Yį": -55, "Yo": -110, "Yó": -110, "Yô": -110, "Yö": -70,
+	"Yò": -70, "Yő": -110, "Yō": -70, "Yø": -110, "Yõ": -70, "Y.": -129,
+	"Y;": -92, "Yu": -111, "Yú": -111, "Yû": -111, "Yü": -71, "Yù": -71,
+	"Yű": -111, "Yū": -71, "Yų": -111, "Yů": -111,
+	"ÝA": -120, "ÝÁ": -120, "ÝĂ": -120, "ÝÂ": -120, "ÝÄ": -120, "ÝÀ": -120,
+	"ÝĀ": -120, "ÝĄ": -120, "ÝÅ": -120, "ÝÃ": -120, "ÝO": -30, "ÝÓ": -30,
+	"ÝÔ": -30, "ÝÖ": -30, "ÝÒ": -30, "ÝŐ": -30, "ÝŌ": -30, "ÝØ": -30,
+	"ÝÕ": -30, "Ýa": -100, "Ýá": -100, "Ýă": -100, "Ýâ": -100, "Ýä": -60,
+	"Ýà": -60, "Ýā": -60, "Ýą": -100, "Ýå": -100, "Ýã": -60, "Ý:": -92,
+	"Ý,": -129, "Ýe": -100, "Ýé": -100, "Ýě": -100, "Ýê": -100, "Ýë": -60,
+	"Ýė": -100, "Ýè": -60, "Ýē": -60, "Ýę": -100, "Ý-": -111, "Ýi": -55,
+	"Ýí": -55, "Ýį": -55, "Ýo": -110, "Ýó": -110, "Ýô": -110, "Ýö": -70,
+	"Ýò": -70, "Ýő": -110, "Ýō": -70, "Ýø": -110, "Ýõ": -70, "Ý.": -129,
+	"Ý;": -92, "Ýu": -111, "Ýú": -111, "Ýû": -111, "Ýü": -71, "Ýù": -71,
+	"Ýű": -111, "Ýū": -71, "Ýų": -111, "Ýů": -111,
+	"ŸA": -120, "ŸÁ": -120, "ŸĂ": -120, "ŸÂ": -120, "ŸÄ": -120, "ŸÀ": -120,
+	"ŸĀ": -120, "ŸĄ": -120, "ŸÅ": -120, "ŸÃ": -120, "ŸO": -30, "ŸÓ": -30,
+	"ŸÔ": -30, "ŸÖ": -30, "ŸÒ": -30, "ŸŐ": -30, "ŸŌ": -30, "ŸØ": -30,
+	"ŸÕ": -30, "Ÿa": -100, "Ÿá": -100, "Ÿă": -100, "Ÿâ": -100, "Ÿä": -60,
+	"Ÿà": -60, "Ÿā": -60, "Ÿą": -100, "Ÿå": -100, "Ÿã": -100, "Ÿ:": -92,
+	"Ÿ,": -129, "Ÿe": -100, "Ÿé": -100, "Ÿě": -100, "Ÿê": -100, "Ÿë": -60,
+	"Ÿė": -100, "Ÿè": -60, "Ÿē": -60, "Ÿę": -100, "Ÿ-": -111, "Ÿi": -55,
+	"Ÿí": -55, "Ÿį": -55, "Ÿo": -110, "Ÿó": -110, "Ÿô": -110, "Ÿö": -70,
+	"Ÿò": -70, "Ÿő": -110, "Ÿō": -70, "Ÿø": -110, "Ÿõ": -70, "Ÿ.": -129,
+	"Ÿ;": -92, "Ÿu": -111, "Ÿú": -111, "Ÿû": -111, "Ÿü": -71, "Ÿù": -71,
+	"Ÿű": -111, "Ÿū": -71, "Ÿų": -111, "Ÿů": -111,
+	"av": -20, "aw": -15,
+	"áv": -20, "áw": -15,
+	"ăv": -20, "ăw": -15,
+	"âv": -20, "âw": -15,
+	"äv": -20, "äw": -15,
+	"àv": -20, "àw": -15,
+	"āv": -20, "āw": -15,
+	"ąv": -20, "ąw": -15,
+	"åv": -20, "åw": -15,
+	"ãv": -20, "ãw": -15,
+	"b.": -40, "bu": -20, "bú": -20, "bû": -20, "bü": -20, "bù": -20,
+	"bű": -20, "bū": -20, "bų": -20, "bů": -20, "bv": -15,
+	"cy": -15, "cý": -15, "cÿ": -15,
+	"ćy": -15, "ćý": -15, "ćÿ": -15,
+	"čy": -15, "čý": -15, "čÿ": -15,
+	"çy": -15, "çý": -15, "çÿ": -15,
+	",”": -70, ",’": -70,
+	"eg": -15, "eğ": -15, "eģ": -15, "ev": -25, "ew": -25, "ex": -15,
+	"ey": -15, "eý": -15, "eÿ": -15,
+	"ég": -15, "éğ": -15, "éģ": -15, "év": -25, "éw": -25, "éx": -15,
+	"éy": -15, "éý": -15, "éÿ": -15,
+	"ěg": -15, "ěğ": -15, "ěģ": -15, "ěv": -25, "ěw": -25, "ěx": -15,
+	"ěy": -15, "ěý": -15, "ěÿ": -15,
+	"êg": -15, "êğ": -15, "êģ": -15, "êv": -25, "êw": -25, "êx": -15,
+	"êy": -15, "êý": -15, "êÿ": -15,
+	"ëg": -15, "ëğ": -15, "ëģ": -15, "ëv": -25, "ëw": -25, "ëx": -15,
+	"ëy": -15, "ëý": -15, "ëÿ": -15,
+	"ėg": -15, "ėğ": -15, "ėģ": -15, "ėv": -25, "ėw": -25, "ėx": -15,
+	"ėy": -15, "ėý": -15, "ėÿ": -15,
+	"èg": -15, "èğ": -15, "èģ": -15, "èv": -25, "èw": -25, "èx": -15,
+	"èy": -15, "èý": -15, "èÿ": -15,
+	"ēg": -15, "ēğ": -15, "ēģ": -15, "ēv": -25, "ēw": -25, "ēx": -15,
+	"ēy": -15, "ēý": -15, "ēÿ": -15,
+	"ęg": -15, "ęğ": -15, "ęģ": -15, "ęv": -25, "ęw": -25, "ęx": -15,
+	"ęy": -15, "ęý": -15, "ęÿ": -15,
+	"fa": -10, "fá": -10, "fă": -10, "fâ": -10, "fä": -10, "fà": -10,
+	"fā": -10, "fą": -10, "få": -10, "fã": -10, "fı": -50, "ff": -25,
+	"fi": -20, "fí": -20, "f’": 55,
+	"ga": -5, "gá": -5, "gă": -5, "gâ": -5, "gä": -5, "gà": -5,
+	"gā": -5, "gą": -5, "gå": -5, "gã": -5,
+	"ğa": -5, "ğá": -5, "ğă": -5, "ğâ": -5, "ğä": -5, "ğà": -5,
+	"ğā": -5, "ğą": -5, "ğå": -5, "ğã": -5,
+	"ģa": -5, "ģá": -5, "ģă": -5, "ģâ": -5, "ģä": -5, "ģà": -5,
+	"ģā": -5, "ģą": -5, "ģå": -5, "ģã": -5,
+	"hy": -5, "hý": -5, "hÿ": -5,
+	"iv": -25,
+	"ív": -25,
+	"îv": -25,
+	"ïv": -25,
+	"ìv": -25,
+	"īv": -25,
+	"įv": -25,
+	"ke": -10, "ké": -10, "kě": -10, "kê": -10, "kë": -10, "kė": -10,
+	"kè": -10, "kē": -10, "kę": -10, "ko": -10, "kó": -10, "kô": -10,
+	"kö": -10, "kò": -10, "kő": -10, "kō": -10, "kø": -10, "kõ": -10,
+	"ky": -15, "ký": -15, "kÿ": -15,
+	"ķe": -10, "ķé": -10, "ķě": -10, "ķê": -10, "ķë": -10, "ķė": -10,
+	"ķè": -10, "ķē": -10, "ķę": -10, "ķo": -10, "ķó": -10, "ķô": -10,
+	"ķö": -10, "ķò": -10, "ķő": -10, "ķō": -10, "ķø": -10, "ķõ": -10,
+	"ķy": -15, "ķý": -15, "ķÿ": -15,
+	"lw": -10,
+	"ĺw": -10,
+	"ļw": -10,
+	"łw": -10,
+	"nv": -40, "ny": -15, "ný": -15, "nÿ": -15,
+	"ńv": -40, "ńy": -15, "ńý": -15, "ńÿ": -15,
+	"ňv": -40, "ňy": -15, "ňý": -15, "ňÿ": -15,
+	"ņv": -40, "ņy": -15, "ņý": -15, "ņÿ": -15,
+	"ñv": -40, "ñy": -15, "ñý": -15, "ñÿ": -15,
+	"ov": -15, "ow": -25, "oy": -10, "oý": -10, "oÿ": -10,
+	"óv": -15, "ów": -25, "óy": -10, "óý": -10, "óÿ": -10,
+	"ôv": -15, "ôw": -25, "ôy": -10, "ôý": -10, "ôÿ": -10,
+	"öv": -15, "öw": -25, "öy": -10, "öý": -10, "öÿ": -10,
+	"òv": -15, "òw": -25, "òy": -10, "òý": -10, "òÿ": -10,
+	"őv": -15, "őw": -25, "őy": -10, "őý": -10, "őÿ": -10,
+	"ōv": -15, "ōw": -25, "ōy": -10, "ōý": -10, "ōÿ": -10,
+	"øv": -15, "øw": -25, "øy": -10, "øý": -10, "øÿ": -10,
+	"õv": -15, "õw": -25, "õy": -10, "õý": -10, "õÿ": -10,
+	"py": -10, "pý": -10, "pÿ": -10,
+	".”": -70, ".’": -70,
+	"“A": -80, "“Á": -80, "“Ă": -80, "“Â": -80, "“Ä": -80, "“À": -80,
+	"“Ā": -80, "“Ą": -80, "“Å": -80, "“Ã": -80,
+	"‘A": -80, "‘Á": -80, "‘Ă": -80, "‘Â": -80, "‘Ä": -80, "‘À": -80,
+	"‘Ā": -80, "‘Ą": -80, "‘Å": -80, "‘Ã": -80, "‘‘": -74,
+	"’d": -50, "’đ": -50, "’l": -10, "’ĺ": -10, "’ļ": -10, "’ł": -10,
+	"’’": -74, "’r": -50, "’ŕ": -50, "’ř": -50, "’ŗ": -50, "’s": -55,
+	"’ś": -55, "’š": -55, "’ş": -55, "’ș": -55, "’ ": -74, "’t": -18,
+	"’ţ": -18, "’v": -50,
+	"r,": -40, "rg": -18, "rğ": -18, "rģ": -18, "r-": -20, "r.": -55,
+	"ŕ,": -40, "ŕg": -18, "ŕğ": -18, "ŕģ": -18, "ŕ-": -20, "ŕ.": -55,
+	"ř,": -40, "řg": -18, "řğ": -18, "řģ": -18, "ř-": -20, "ř.": -55,
+	"ŗ,": -40, "ŗg": -18, "ŗğ": -18, "ŗģ": -18, "ŗ-": -20, "ŗ.": -55,
+	" A": -55, " Á": -55, " Ă": -55, " Â": -55, " Ä": -55, " À": -55,
+	" Ā": -55, " Ą": -55, " Å": -55, " Ã": -55, " T": -18, " Ť": -18,
+	" Ţ": -18, " V": -50, " W": -30, " Y": -90, " Ý": -90, " Ÿ": -90,
+	"va": -25, "vá": -25, "vă": -25, "vâ": -25, "vä": -25, "và": -25,
+	"vā": -25, "vą": -25, "vå": -25, "vã": -25, "v,": -65, "ve": -15,
+	"vé": -15, "vě": -15, "vê": -15, "vë": -15, "vė": -15, "vè": -15,
+	"vē": -15, "vę": -15, "vo": -20, "vó": -20, "vô": -20, "vö": -20,
+	"vò": -20, "vő": -20, "vō": -20, "vø": -20, "võ": -20, "v.": -65,
+	"wa": -10, "wá": -10, "wă": -10, "wâ": -10, "wä": -10, "wà": -10,
+	"wā": -10, "wą": -10, "wå": -10, "wã": -10, "w,": -65, "wo": -10,
+	"wó": -10, "wô": -10, "wö": -10, "wò": -10, "wő": -10, "wō": -10,
+	"wø": -10, "wõ": -10, "w.": -65,
+	"xe": -15, "xé": -15, "xě": -15, "xê": -15, "xë": -15, "xė": -15,
+	"xè": -15, "xē": -15, "xę": -15,
+	"y,": -65, "y.": -65,
+	"ý,": -65, "ý.": -65,
+	"ÿ,": -65, "ÿ.": -65,
+} //                                                           pdfKernTimesRoman
+
 // pdfStandardPaperSizes contains standard paper sizes in mm (width x height)
 var pdfStandardPaperSizes = map[string][2]int{
 	"A0": {841, 1189}, "B0": {1000, 1414}, "C0": {917, 1297}, // ISO-216
@@ -1986,4 +6593,57 @@ var pdfStandardPaperSizes = map[string][2]int{
 	"LETTER": {216, 279}, "LEDGER": {432, 279},
 } //                                                       pdfStandardPaperSizes
 
+// pdfSymbolChars are the Unicode characters of codes 32 to 255 in the
+// encoding of the Symbol font, or zero where there is no character
+var pdfSymbolChars = []rune(strings.Join([]string{
+	" !∀#∃%&∋()∗+,−./",                                        // 32-47
+	"0123456789:;<=>?",                                        // 48-63
+	"≅ΑΒΧΔΕΦΓΗΙϑΚΛΜΝΟ",                                        // 64-79
+	"ΠΘΡΣΤΥςΩΞΨΖ[∴]⊥_",                                        // 80-95
+	"\x00αβχδεφγηιϕκλμνο",                                     // 96-111
+	"πθρστυϖωξψζ{|}∼\x00",                                     // 112-127
+	strings.Repeat("\x00", 32),                                // 128-159
+	"€ϒ′≤⁄∞ƒ♣♦♥♠↔←↑→↓",                                        // 160-175
+	"°±″≥×∝∂•÷≠≡≈…\x00\x00↵",                                  // 176-191
+	"ℵℑℜ℘⊗⊕∅∩∪⊃⊇⊄⊂⊆∈∉",                                        // 192-207
+	"∠∇®©™∏√⋅¬∧∨⇔⇐⇑⇒⇓",                                        // 208-223
+	"◊〈\x00\x00\x00∑\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00", // 224-239
+	"\x00〉∫⌠\x00⌡\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00",    // 240-255
+}, ""))
+
+// pdfTextRenderModes are the names of text rendering modes, in the
+// order of the values of the Tr operator
+var pdfTextRenderModes = []string{
+	"fill", "stroke", "fill-stroke", "invisible",
+	"fill-clip", "stroke-clip", "fill-stroke-clip", "clip",
+}
+
+// pdfWinAnsiCodes maps characters to codes 128 to 159 of WinAnsiEncoding.
+// Codes 32 to 127 and 160 to 255 are the same as the Unicode characters.
+var pdfWinAnsiCodes = map[rune]byte{
+	'€': 128, '‚': 130, 'ƒ': 131, '„': 132, '…': 133, '†': 134, '‡': 135,
+	'ˆ': 136, '‰': 137, 'Š': 138, '‹': 139, 'Œ': 140, 'Ž': 142, '‘': 145,
+	'’': 146, '“': 147, '”': 148, '•': 149, '–': 150, '—': 151, '˜': 152,
+	'™': 153, 'š': 154, '›': 155, 'œ': 156, 'ž': 158, 'Ÿ': 159,
+} //                                                             pdfWinAnsiCodes
+
+// pdfZapfDingbatsChars are the Unicode characters of codes 32 to 255 in
+// the encoding of the ZapfDingbats font, or zero where there is no character
+var pdfZapfDingbatsChars = []rune(strings.Join([]string{
+	" ✁✂✃✄☎✆✇✈✉☛☞✌✍✎✏",         // 32-47
+	"✐✑✒✓✔✕✖✗✘✙✚✛✜✝✞✟",         // 48-63
+	"✠✡✢✣✤✥✦✧★✩✪✫✬✭✮✯",         // 64-79
+	"✰✱✲✳✴✵✶✷✸✹✺✻✼✽✾✿",         // 80-95
+	"❀❁❂❃❄❅❆❇❈❉❊❋●❍■❏",         // 96-111
+	"❐❑❒▲▼◆❖◗❘❙❚❛❜❝❞\x00",      // 112-127
+	"❨❩❪❫❬❭❮❯❰❱❲❳❴❵\x00\x00",   // 128-143
+	strings.Repeat("\x00", 16), // 144-159
+	"\x00❡❢❣❤❥❦❧♣♦♥♠①②③④",      // 160-175
+	"⑤⑥⑦⑧⑨⑩❶❷❸❹❺❻❼❽❾❿",         // 176-191
+	"➀➁➂➃➄➅➆➇➈➉➊➋➌➍➎➏",         // 192-207
+	"➐➑➒➓➔→↔↕➘➙➚➛➜➝➞➟",         // 208-223
+	"➠➡➢➣➤➥➦➧➨➩➪➫➬➭➮➯",         // 224-239
+	"\x00➱➲➳➴➵➶➷➸➹➺➻➼➽➾\x00",   // 240-255
+}, ""))
+
 // end
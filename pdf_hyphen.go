@@ -0,0 +1,193 @@
+// -----------------------------------------------------------------------------
+// github.com/balacode/one-file-pdf                 one-file-pdf/[pdf_hyphen.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// This file implements Liang's hyphenation algorithm, which finds the
+// points where words can be hyphenated using patterns of letters, as
+// in TeX. It reads the pattern files of the hyph-utf8 project, e.g.
+// hyph-en-us.tex, hyph-de-1996.tex, hyph-fr.tex and hyph-es.tex.
+// It augments PDF in pdf_core.go, but is not required for basic
+// PDF functionality.
+
+// # Module Initialization
+//   init()
+//
+// # Hyphenation Methods (h *pdfHyphenPatterns)
+//   hyphenate(word string) []int
+//   readPatterns(data []byte) error
+//
+// # Functions
+//   hyphenPatterns(language string, data []byte) (
+//       hyphenate func(word string) []int, err error)
+//   hyphenTeXGroup(s, command string) (group string, found bool)
+
+package pdf
+
+import (
+	"strings"
+	"unicode"
+)
+
+// pdfHyphenPatterns holds the hyphenation patterns of a language
+type pdfHyphenPatterns struct {
+	patterns   map[string][]int // letters -> values before, between, after
+	exceptions map[string][]int // words -> hyphenation points
+	maxLength  int              // number of letters in the longest pattern
+	leftMin    int              // minimum number of letters before a hyphen
+	rightMin   int              // minimum number of letters after a hyphen
+} //                                                           pdfHyphenPatterns
+
+// hyphenMinimums specifies the minimum number of letters before and after
+// a hyphen, by language. Other languages use 2 and 2.
+var hyphenMinimums = map[string][2]int{
+	"de": {2, 2}, "en": {2, 3}, "es": {2, 2}, "fr": {2, 3},
+}
+
+// init sets the plugin in pdf_core.go to read hyphenation patterns
+func init() {
+	pdfHyphenator = hyphenPatterns
+} //                                                                        init
+
+// -----------------------------------------------------------------------------
+// # Hyphenation Methods (h *pdfHyphenPatterns)
+
+// hyphenate returns the points where 'word' can be hyphenated, as the
+// number of characters before each hyphen, in ascending order
+func (h *pdfHyphenPatterns) hyphenate(word string) []int {
+	lower := strings.ToLower(word)
+	if points, found := h.exceptions[lower]; found {
+		return points
+	}
+	// every pattern that matches the word (with '.' marking its start and
+	// end) sets values between letters: a hyphen is allowed where the
+	// highest value is odd
+	letters := []rune("." + lower + ".")
+	values := make([]int, len(letters)+1)
+	for i := range letters {
+		for j := i + 1; j <= len(letters) && j-i <= h.maxLength; j++ {
+			pattern, found := h.patterns[string(letters[i:j])]
+			if !found {
+				continue
+			}
+			for k, val := range pattern {
+				if val > values[i+k] {
+					values[i+k] = val
+				}
+			}
+		}
+	}
+	var ret []int
+	count := len(letters) - 2
+	for n := h.leftMin; n <= count-h.rightMin; n++ {
+		if values[n+1]%2 == 1 { // n letters and '.' are before the value
+			ret = append(ret, n)
+		}
+	}
+	return ret
+} //                                                                   hyphenate
+
+// readPatterns reads hyphenation patterns and exceptions from 'data'.
+// Patterns are letters with digits between them, e.g. ".ach4" or "1tio",
+// and exceptions are words with hyphens, e.g. "ta-ble". In TeX files,
+// they are in \patterns{...} and \hyphenation{...} groups. '%' starts
+// a comment.
+func (h *pdfHyphenPatterns) readPatterns(data []byte) error {
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.IndexByte(line, '%'); i != -1 {
+			line = line[:i]
+		}
+		lines = append(lines, line)
+	}
+	text := strings.Join(lines, "\n")
+	patterns, isTeX := hyphenTeXGroup(text, `\patterns`)
+	if isTeX {
+		exceptions, _ := hyphenTeXGroup(text, `\hyphenation`)
+		text = patterns + "\n" + exceptions
+	}
+	h.patterns, h.exceptions = map[string][]int{}, map[string][]int{}
+	for _, token := range strings.Fields(text) {
+		if !strings.ContainsAny(token, "0123456789") &&
+			strings.Contains(token, "-") { //                       exception
+			var points []int
+			word := ""
+			for _, r := range token {
+				if r == '-' {
+					points = append(points, len([]rune(word)))
+					continue
+				}
+				word += string(r)
+			}
+			h.exceptions[strings.ToLower(word)] = points
+			continue
+		}
+		letters, values := "", []int{0}
+		for _, r := range token {
+			if r >= '0' && r <= '9' {
+				values[len(values)-1] = int(r - '0')
+				continue
+			}
+			if r != '.' && !unicode.IsLetter(r) && !unicode.IsMark(r) &&
+				r != '\'' && r != '’' {
+				return pdfError{id: 0xE5C2B7,
+					msg: "Invalid hyphenation pattern", val: token}
+			}
+			letters += string(r)
+			values = append(values, 0)
+		}
+		letters = strings.ToLower(letters)
+		for i, val := range h.patterns[letters] { // merge duplicates
+			if val > values[i] {
+				values[i] = val
+			}
+		}
+		h.patterns[letters] = values
+		if n := len(values) - 1; n > h.maxLength {
+			h.maxLength = n
+		}
+	}
+	if len(h.patterns) == 0 {
+		return pdfError{id: 0xE1D9A4, msg: "No hyphenation patterns"}
+	}
+	return nil
+} //                                                                readPatterns
+
+// -----------------------------------------------------------------------------
+// # Functions
+
+// hyphenPatterns reads the hyphenation patterns of 'language' (e.g. "en"
+// or "de-1996") from 'data', and returns a function that finds the
+// points where a word can be hyphenated
+func hyphenPatterns(language string, data []byte) (
+	hyphenate func(word string) []int, err error) {
+	mins, found := hyphenMinimums[strings.ToLower(
+		strings.SplitN(language, "-", 2)[0])]
+	if !found {
+		mins = [2]int{2, 2}
+	}
+	h := &pdfHyphenPatterns{leftMin: mins[0], rightMin: mins[1]}
+	if err := h.readPatterns(data); err != nil {
+		return nil, err
+	}
+	return h.hyphenate, nil
+} //                                                              hyphenPatterns
+
+// hyphenTeXGroup returns the text between the braces that follow
+// 'command' in TeX source 's', e.g. the patterns in \patterns{...}
+func hyphenTeXGroup(s, command string) (group string, found bool) {
+	i := strings.Index(s, command)
+	if i == -1 {
+		return "", false
+	}
+	s = strings.TrimLeft(s[i+len(command):], " \t\r\n")
+	if !strings.HasPrefix(s, "{") {
+		return "", false
+	}
+	if i = strings.IndexByte(s, '}'); i == -1 {
+		i = len(s)
+	}
+	return s[1:i], true
+} //                                                              hyphenTeXGroup
+
+// end
@@ -0,0 +1,115 @@
+// -----------------------------------------------------------------------------
+// github.com/balacode/one-file-pdf            one-file-pdf/[pdf_hyphen_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package pdf
+
+// # Hyphenation Tests:
+//   Test_hyphenPatterns_
+//   Test_hyphenPatterns_WrapTextLines_
+//
+// # Helper Functions
+//   tHyphenPatterns() string
+
+//  This file contains unit tests for the hyphenation algorithm.
+//  The patterns used in these tests are a few of the patterns
+//  of US English (hyph-en-us.tex) that match the test words.
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test_hyphenPatterns_ tests reading of patterns and hyphenation points
+func Test_hyphenPatterns_(t *testing.T) {
+	hyphenate, err := hyphenPatterns("en-us", []byte(tHyphenPatterns()))
+	tEqual(t, err, nil)
+	for _, tc := range []struct {
+		word string
+		want []int
+	}{
+		{"hyphenation", []int{2, 6}},         // hy-phen-ation
+		{"Hyphenation", []int{2, 6}},         // patterns are in lower case
+		{"table", []int{2}},                  // exception: ta-ble
+		{"concatenation", []int{3, 5, 7, 9}}, // con-ca-te-na-tion
+		{"tion", nil},                        // too short to hyphenate
+	} {
+		got := hyphenate(tc.word)
+		tEqual(t, got, tc.want)
+	}
+	// a hyphen needs at least 2 letters before it, and 3 in English
+	// after it (2 in other languages)
+	hyphenate, _ = hyphenPatterns("de", []byte("1ta"))
+	tEqual(t, hyphenate("kata"), []int{2})
+	hyphenate, _ = hyphenPatterns("en", []byte("1ta"))
+	tEqual(t, hyphenate("kata"), []int(nil))
+	//
+	// errors
+	for _, tc := range []struct{ patterns, want string }{
+		{"", "No hyphenation patterns"},
+		{`\patterns{ 1b* }`, "Invalid hyphenation pattern"},
+	} {
+		_, err := hyphenPatterns("en", []byte(tc.patterns))
+		tEqual(t, (&PDF{}).ErrorInfo(err).Msg, tc.want)
+	}
+} //                                                        Test_hyphenPatterns_
+
+// Test_hyphenPatterns_WrapTextLines_ tests hyphenation of words that
+// don't fit on a line by WrapTextLines()
+func Test_hyphenPatterns_WrapTextLines_(t *testing.T) {
+	doc := NewPDF("A4")
+	doc.SetUnits("pt").SetFont("Helvetica", 10).DrawText("")
+	doc.applyFont()
+	text := "Concatenation, hyphenation!"
+	//
+	// without hyphenation, a word that doesn't fit is cut anywhere
+	tEqual(t, doc.WrapTextLines(60, text),
+		[]string{"Concatenatio", "n, ", "hyphenation!"})
+	//
+	// with hyphenation, it is split at the last hyphenation point that fits
+	doc.RegisterHyphenation("en", []byte(tHyphenPatterns())).
+		SetHyphenation("EN")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, doc.Hyphenation(), "en")
+	tEqual(t, doc.WrapTextLines(60, text),
+		[]string{"Concatena-", "tion, hyphen-", "ation!"})
+	tEqual(t, doc.WrapTextLines(40, text),
+		[]string{"Conca-", "tenation, ", "hyphen-", "ation!"})
+	for _, line := range doc.WrapTextLines(40, text) {
+		if doc.TextWidth(strings.TrimSpace(line)) > 40 {
+			t.Errorf("line %q is wider than 40pt", line)
+		}
+	}
+	doc.SetHyphenation("")
+	tEqual(t, doc.Hyphenation(), "")
+	//
+	// errors
+	doc.SetHyphenation("fr")
+	tEqual(t, doc.PullError(),
+		`Hyphenation patterns not registered "fr" @SetHyphenation`)
+	doc.RegisterHyphenation("de", "no-such-file.tex")
+	tEqual(t, doc.ErrorInfo(doc.PullError()).Msg, "Failed reading file")
+	doc.RegisterHyphenation("de", 123)
+	tEqual(t, doc.ErrorInfo(doc.PullError()).Msg, "Invalid type in patterns")
+	doc.RegisterHyphenation("", []byte("1ta"))
+	tEqual(t, doc.ErrorInfo(doc.PullError()).Msg,
+		"Invalid hyphenation language")
+} //                                          Test_hyphenPatterns_WrapTextLines_
+
+// -----------------------------------------------------------------------------
+// # Helper Functions
+
+// tHyphenPatterns returns hyphenation patterns in a TeX pattern file
+func tHyphenPatterns() string {
+	return "% patterns from hyph-en-us.tex\n" +
+		"\\patterns{ % comment\n" +
+		"hy3ph he2n hena4 hen5at 1na n2at 1tio 2io\n" +
+		"o2n 1ca 1te 4te. n1a n2a\n" +
+		"}\n" +
+		"\\hyphenation{\n" +
+		"ta-ble\n" +
+		"}\n"
+} //                                                             tHyphenPatterns
+
+// end
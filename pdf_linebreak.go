@@ -0,0 +1,322 @@
+// -----------------------------------------------------------------------------
+// github.com/balacode/one-file-pdf              one-file-pdf/[pdf_linebreak.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// This file implements the Unicode Line Breaking Algorithm (UAX #14),
+// which finds the positions in text where a line can be broken: e.g.
+// after spaces and hyphens, between most CJK characters, but not
+// around non-breaking spaces or before closing punctuation.
+// It augments PDF in pdf_core.go, but is not required for basic
+// PDF functionality.
+
+// # Module Initialization
+//   init()
+//
+// # Functions
+//   lineBreakAllowed(before, after, lastNonSpace, count int,
+//       wide bool) bool
+//   lineBreakClass(r rune) int
+//   lineBreakSmallKana(r rune) bool
+//   lineBreaks(s string) []int
+
+package pdf
+
+import "unicode"
+
+// line breaking classes of the algorithm. Classes that the algorithm
+// resolves to others (AI, CB, CJ, SA, SG, XX) are not listed.
+const (
+	lbAL  = iota // alphabetic (and other characters)
+	lbB2         // break opportunity before and after (em dash)
+	lbBA         // break after (e.g. soft hyphen)
+	lbBB         // break before
+	lbBK         // mandatory break
+	lbCL         // close punctuation
+	lbCM         // combining mark
+	lbCP         // close parenthesis
+	lbCR         // carriage return
+	lbEX         // exclamation / interrogation
+	lbGL         // non-breaking ("glue"), e.g. no-break space
+	lbH2         // Hangul LV syllable
+	lbH3         // Hangul LVT syllable
+	lbHL         // Hebrew letter
+	lbHY         // hyphen
+	lbID         // ideographic
+	lbIN         // inseparable, e.g. ellipsis
+	lbIS         // infix numeric separator
+	lbJL         // Hangul L jamo
+	lbJT         // Hangul T jamo
+	lbJV         // Hangul V jamo
+	lbLF         // line feed
+	lbNL         // next line
+	lbNS         // nonstarter, e.g. small kana
+	lbNU         // numeric
+	lbOP         // open punctuation
+	lbPO         // postfix numeric, e.g. %
+	lbPR         // prefix numeric, e.g. $
+	lbQU         // quotation
+	lbRI         // regional indicator
+	lbSP         // space
+	lbSY         // symbols allowing break after, i.e. '/'
+	lbWJ         // word joiner
+	lbZW         // zero width space
+	lbZWJ        // zero width joiner
+)
+
+// init sets the plugin in pdf_core.go to find line break opportunities
+func init() {
+	pdfLineBreaks = lineBreaks
+} //                                                                        init
+
+// -----------------------------------------------------------------------------
+// # Functions
+
+// lineBreakAllowed returns true if a line can be broken between
+// characters of classes 'before' and 'after', applying rules LB4 to
+// LB31. 'lastNonSpace' is the class of the last character before any
+// spaces that precede 'after', 'count' is the number of consecutive
+// regional indicators that end with 'before', and 'wide' is true if
+// 'after' is a wide (East Asian) character.
+func lineBreakAllowed(before, after, lastNonSpace, count int,
+	wide bool) bool {
+	in := func(class int, classes ...int) bool {
+		for _, it := range classes {
+			if class == it {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case before == lbCR && after == lbLF: // LB5
+		return false
+	case in(before, lbBK, lbCR, lbLF, lbNL): // LB4, LB5
+		return true
+	case in(after, lbBK, lbCR, lbLF, lbNL, lbSP, lbZW): // LB6, LB7
+		return false
+	case lastNonSpace == lbZW: // LB8
+		return true
+	case before == lbZWJ, before == lbWJ, after == lbWJ: // LB8a, LB11
+		return false
+	case before == lbGL, // LB12
+		after == lbGL && !in(before, lbSP, lbBA, lbHY): // LB12a
+		return false
+	case in(after, lbCL, lbCP, lbEX, lbIS, lbSY): // LB13
+		return false
+	case lastNonSpace == lbOP, // LB14
+		lastNonSpace == lbQU && after == lbOP,         // LB15
+		in(lastNonSpace, lbCL, lbCP) && after == lbNS, // LB16
+		lastNonSpace == lbB2 && after == lbB2:         // LB17
+		return false
+	case before == lbSP: // LB18
+		return true
+	case before == lbQU, after == lbQU, // LB19
+		in(after, lbBA, lbHY, lbNS), before == lbBB, // LB21
+		before == lbSY && after == lbHL, // LB21b
+		after == lbIN:                   // LB22
+		return false
+	case in(before, lbAL, lbHL) && after == lbNU, // LB23
+		before == lbNU && in(after, lbAL, lbHL),
+		before == lbPR && after == lbID, // LB23a
+		before == lbID && after == lbPO,
+		in(before, lbPR, lbPO) && in(after, lbAL, lbHL), // LB24
+		in(before, lbAL, lbHL) && in(after, lbPR, lbPO),
+		in(before, lbCL, lbCP, lbNU) && in(after, lbPO, lbPR), // LB25
+		in(before, lbPO, lbPR) && after == lbOP,
+		in(before, lbPO, lbPR, lbHY, lbIS, lbNU, lbSY) && after == lbNU:
+		return false
+	case before == lbJL && in(after, lbJL, lbJV, lbH2, lbH3), // LB26
+		in(before, lbJV, lbH2) && in(after, lbJV, lbJT),
+		in(before, lbJT, lbH3) && after == lbJT,
+		in(before, lbJL, lbJV, lbJT, lbH2, lbH3) && after == lbPO, // LB27
+		before == lbPR && in(after, lbJL, lbJV, lbJT, lbH2, lbH3):
+		return false
+	case in(before, lbAL, lbHL) && in(after, lbAL, lbHL), // LB28
+		before == lbIS && in(after, lbAL, lbHL),                // LB29
+		in(before, lbAL, lbHL, lbNU) && after == lbOP && !wide, // LB30
+		before == lbCP && in(after, lbAL, lbHL, lbNU),
+		before == lbRI && after == lbRI && count%2 == 1: // LB30a
+		return false
+	}
+	return true // LB31
+} //                                                            lineBreakAllowed
+
+// lineBreakClass returns the line breaking class of character 'r'.
+// Classes are resolved as rule LB1 specifies: e.g. complex-context
+// letters (like Thai) are alphabetic, and small kana are nonstarters.
+func lineBreakClass(r rune) int {
+	switch {
+	case r == 0x0B, r == 0x0C, r == 0x2028, r == 0x2029:
+		return lbBK
+	case r == '\r':
+		return lbCR
+	case r == '\n':
+		return lbLF
+	case r == 0x85:
+		return lbNL
+	case r == ' ':
+		return lbSP
+	case r == 0x200B:
+		return lbZW
+	case r == 0x200D:
+		return lbZWJ
+	case r == 0x2060, r == 0xFEFF:
+		return lbWJ
+	case r == 0xA0, r == 0x202F, r == 0x2007, r == 0x2011, r == 0x180E,
+		r == 0x034F, r == 0x0F08, r == 0x0F0C, r == 0x0F12:
+		return lbGL
+	case r == '\t', r == 0xAD, r == '|', r == 0x058A, r == 0x05BE,
+		r == 0x0964, r == 0x0965, r == 0x0F0B, r == 0x1361, r == 0x1680,
+		r >= 0x2000 && r <= 0x2006, r >= 0x2008 && r <= 0x200A,
+		r == 0x2010, r == 0x2012, r == 0x2013, r == 0x2027, r == 0x205F,
+		r >= 0x2E0E && r <= 0x2E15, r == 0x2E17, r == 0x3000:
+		return lbBA
+	case r == 0xB4, r == 0x02C8, r == 0x02CC, r == 0x02DF, r == 0x1FFD:
+		return lbBB
+	case r == 0x2014:
+		return lbB2
+	case r == '-':
+		return lbHY
+	case r == ')', r == ']':
+		return lbCP
+	case r == 0x3001, r == 0x3002, r == 0xFE11, r == 0xFE12, r == 0xFF0C,
+		r == 0xFF0E, r == 0xFF61, r == 0xFF64, unicode.Is(unicode.Pe, r):
+		return lbCL
+	case r == 0xA1, r == 0xBF, unicode.Is(unicode.Ps, r):
+		return lbOP
+	case r == '"', r == '\'', unicode.Is(unicode.Pi, r),
+		unicode.Is(unicode.Pf, r):
+		return lbQU
+	case r == '!', r == '?', r == 0x05C6, r == 0x061B, r == 0x061F,
+		r == 0x06D4, r == 0x07F9, r == 0x0F0D, r == 0x0F0E, r == 0x0F14,
+		r == 0x1802, r == 0x1803, r == 0x1808, r == 0x1809, r == 0x1944,
+		r == 0x1945, r == 0x2762, r == 0x2763, r == 0xFE15, r == 0xFE16,
+		r == 0xFE56, r == 0xFE57, r == 0xFF01, r == 0xFF1F:
+		return lbEX
+	case r == 0x2024, r == 0x2025, r == 0x2026, r == 0xFE19:
+		return lbIN
+	case r == ',', r == '.', r == ':', r == ';', r == 0x037E, r == 0x0589,
+		r == 0x060C, r == 0x060D, r == 0x07F8, r == 0x2044, r == 0xFE10,
+		r == 0xFE13, r == 0xFE14:
+		return lbIS
+	case r == '/':
+		return lbSY
+	case r == '%', r == 0xA2, r == 0xB0, r == 0x0609, r == 0x060A,
+		r == 0x060B, r == 0x066A, r >= 0x2030 && r <= 0x2037, r == 0x20A7,
+		r == 0x20B6, r == 0x20BB, r == 0x20BE, r == 0x2103, r == 0x2109,
+		r == 0xFE6A, r == 0xFF05, r == 0xFFE0:
+		return lbPO
+	case r == '+', r == '\\', r == 0xB1, r == 0x2116, r == 0x2212,
+		r == 0x2213, unicode.Is(unicode.Sc, r):
+		return lbPR
+	case r == 0x17D6, r == 0x203C, r == 0x203D, r >= 0x2047 && r <= 0x2049,
+		r == 0x3005, r == 0x301C, r == 0x303B, r == 0x303C,
+		r >= 0x309B && r <= 0x309E, r == 0x30A0, r == 0x30FB, r == 0x30FC,
+		r == 0x30FD, r == 0x30FE, r == 0xA015, r == 0xFE54, r == 0xFE55,
+		r == 0xFF1A, r == 0xFF1B, r == 0xFF65, r == 0xFF9E, r == 0xFF9F,
+		r >= 0x31F0 && r <= 0x31FF, r >= 0xFF67 && r <= 0xFF70,
+		(r >= 0x3041 && r <= 0x30F6) && lineBreakSmallKana(r):
+		return lbNS
+	case r >= 0xFF10 && r <= 0xFF19: // fullwidth digits
+		return lbID
+	case unicode.Is(unicode.Nd, r):
+		return lbNU
+	case r >= 0x05D0 && r <= 0x05EA, r >= 0x05EF && r <= 0x05F2,
+		r >= 0xFB1D && r <= 0xFB4F:
+		return lbHL
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97F:
+		return lbJL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return lbJV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return lbJT
+	case r >= 0xAC00 && r <= 0xD7A3: // Hangul syllables
+		if (r-0xAC00)%28 == 0 {
+			return lbH2
+		}
+		return lbH3
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return lbRI
+	case unicode.In(r, unicode.Mn, unicode.Mc, unicode.Me),
+		r >= 0x1F3FB && r <= 0x1F3FF,    // emoji modifiers
+		r < 0x20, r >= 0x7F && r < 0xA0: // control characters
+		return lbCM
+	case r >= 0x2E80 && r <= 0x2FFF, r >= 0x3003 && r <= 0x303F,
+		r >= 0x3040 && r <= 0x30FF, r >= 0x3100 && r <= 0x31EF,
+		r >= 0x3200 && r <= 0x4DBF, r >= 0x4E00 && r <= 0x9FFF,
+		r >= 0xA000 && r <= 0xA4CF, r >= 0xF900 && r <= 0xFAFF,
+		r >= 0xFE30 && r <= 0xFE4F, r >= 0xFF00 && r <= 0xFF60,
+		r >= 0xFFE0 && r <= 0xFFE6, r >= 0x1F000 && r <= 0x1FAFF,
+		r >= 0x20000 && r <= 0x3FFFD:
+		return lbID
+	}
+	return lbAL
+} //                                                              lineBreakClass
+
+// lineBreakSmallKana returns true if 'r' is a small hiragana or katakana
+// letter, which should not start a line
+func lineBreakSmallKana(r rune) bool {
+	switch r {
+	case 0x3041, 0x3043, 0x3045, 0x3047, 0x3049, 0x3063, 0x3083, 0x3085,
+		0x3087, 0x308E, 0x3095, 0x3096, 0x30A1, 0x30A3, 0x30A5, 0x30A7,
+		0x30A9, 0x30C3, 0x30E3, 0x30E5, 0x30E7, 0x30EE, 0x30F5, 0x30F6:
+		return true
+	}
+	return false
+} //                                                          lineBreakSmallKana
+
+// lineBreaks returns the byte offsets in 's' where a line can be broken,
+// i.e. the offsets of the characters that can start a new line. Breaks
+// at the start and end of 's' are not included.
+func lineBreaks(s string) []int {
+	var (
+		ret          []int
+		before       = -1 // class of the previous character
+		lastNonSpace = -1
+		count        = 0 // consecutive regional indicators
+	)
+	for i, r := range s {
+		class := lineBreakClass(r)
+		if before == -1 { // the first character (LB2: no break before it)
+			if class == lbCM || class == lbZWJ {
+				class = lbAL //                                          LB10
+			}
+			before, lastNonSpace = class, class
+			if class == lbRI {
+				count = 1
+			}
+			continue
+		}
+		// LB9: combining marks and ZWJ take the class of the character
+		// they follow, except after spaces and breaks (LB10)
+		if class == lbCM || class == lbZWJ {
+			if before != lbSP && before != lbZW && before != lbBK &&
+				before != lbCR && before != lbLF && before != lbNL {
+				if class == lbZWJ {
+					before = lbZWJ // LB8a: no break after ZWJ
+				}
+				continue
+			}
+			class = lbAL
+		}
+		wide := r >= 0x2E80 // East Asian, e.g. fullwidth parentheses
+		if lineBreakAllowed(before, class, lastNonSpace, count, wide) {
+			ret = append(ret, i)
+		}
+		if class == lbRI && before == lbRI {
+			count++
+		} else if class == lbRI {
+			count = 1
+		} else {
+			count = 0
+		}
+		if class != lbSP {
+			lastNonSpace = class
+		}
+		before = class
+	}
+	return ret
+} //                                                                  lineBreaks
+
+// end
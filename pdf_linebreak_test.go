@@ -0,0 +1,117 @@
+// -----------------------------------------------------------------------------
+// github.com/balacode/one-file-pdf         one-file-pdf/[pdf_linebreak_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package pdf
+
+// # Line Breaking Algorithm Tests:
+//   Test_lineBreakClass_
+//   Test_lineBreaks_
+//   Test_lineBreaks_WrapTextLines_
+//
+// # Helper Functions
+//   tLineSegments(s string) []string
+
+import (
+	"testing"
+)
+
+// Test_lineBreakClass_ tests the line breaking classes of some characters
+func Test_lineBreakClass_(t *testing.T) {
+	for _, tc := range []struct {
+		r    rune
+		want int
+	}{
+		{'a', lbAL}, {'Ж', lbAL}, {'ก', lbAL}, {'א', lbHL}, {'5', lbNU},
+		{' ', lbSP}, {'\t', lbBA}, {'\n', lbLF}, {'\r', lbCR},
+		{'-', lbHY}, {'/', lbSY}, {'\u00AD', lbBA}, {'\u00A0', lbGL},
+		{'\u2011', lbGL}, {'\u200B', lbZW}, {'\u2060', lbWJ},
+		{'—', lbB2}, {'(', lbOP}, {')', lbCP}, {'}', lbCL},
+		{'"', lbQU}, {'«', lbQU}, {'!', lbEX}, {',', lbIS}, {'$', lbPR},
+		{'%', lbPO}, {'…', lbIN}, {'中', lbID}, {'あ', lbID}, {'ぁ', lbNS},
+		{'。', lbCL}, {'（', lbOP}, {'하', lbH2}, {'한', lbH3},
+		{'\u0301', lbCM}, {'\U0001F1FA', lbRI},
+	} {
+		if got := lineBreakClass(tc.r); got != tc.want {
+			t.Errorf("lineBreakClass(%q) = %d, want %d", tc.r, got, tc.want)
+		}
+	}
+} //                                                        Test_lineBreakClass_
+
+// Test_lineBreaks_ tests finding of line break opportunities
+func Test_lineBreaks_(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want []string
+	}{
+		{"", nil},
+		{"word", []string{"word"}},
+		{"two  words", []string{"two  ", "words"}},
+		{"well-known", []string{"well-", "known"}},
+		{"-5 and 1-2", []string{"-5 ", "and ", "1-2"}},
+		{"http://example.com/a/b",
+			[]string{"http://", "example.com/", "a/", "b"}},
+		{"100\u00A0km away", []string{"100\u00A0km ", "away"}},
+		{"hy\u00ADphen", []string{"hy\u00AD", "phen"}},
+		{"Hello, world! (Yes.)", []string{"Hello, ", "world! ", "(Yes.)"}},
+		{"$100 50% 3.14", []string{"$100 ", "50% ", "3.14"}},
+		{"a \"quote\" b", []string{"a ", "\"quote\" ", "b"}},
+		{"中文字。好", []string{"中", "文", "字。", "好"}},
+		{"ちょっと", []string{"ちょっ", "と"}},
+		{"e\u0301té", []string{"e\u0301té"}},
+		{"zero\u200Bwidth", []string{"zero\u200B", "width"}},
+		{"line\nbreak", []string{"line\n", "break"}},
+		{"\U0001F1FA\U0001F1F8\U0001F1EC\U0001F1E7",
+			[]string{"\U0001F1FA\U0001F1F8", "\U0001F1EC\U0001F1E7"}},
+	} {
+		tEqual(t, tLineSegments(tc.s), tc.want)
+	}
+} //                                                            Test_lineBreaks_
+
+// Test_lineBreaks_WrapTextLines_ tests that WrapTextLines() breaks lines
+// where the line breaking algorithm allows, and handles soft hyphens
+func Test_lineBreaks_WrapTextLines_(t *testing.T) {
+	doc := NewPDF("A4")
+	doc.SetUnits("pt").SetFont("Helvetica", 10).DrawText("")
+	doc.applyFont()
+	//
+	// long URLs are broken after slashes, words after hyphens
+	tEqual(t, doc.WrapTextLines(100,
+		"See https://example.com/some/long/path/to/a/page"),
+		[]string{"See https://", "example.com/some/", "long/path/to/a/page"})
+	tEqual(t, doc.WrapTextLines(50, "A well-known fact"),
+		[]string{"A well-", "known fact"})
+	//
+	// a non-breaking space keeps words together
+	tEqual(t, doc.WrapTextLines(50, "It is 100\u00A0km away"),
+		[]string{"It is ", "100\u00A0km ", "away"})
+	//
+	// soft hyphens are shown only at the end of a line
+	tEqual(t, doc.WrapTextLines(50, "Re\u00ADcom\u00ADmen\u00ADda\u00ADtion"),
+		[]string{"Recom-", "mendation"})
+	tEqual(t, doc.WrapTextLines(100, "Re\u00ADcom\u00ADmen\u00ADda\u00ADtion"),
+		[]string{"Recommendation"})
+	//
+	// a word without break opportunities is split between characters,
+	// never inside a UTF-8 sequence
+	tEqual(t, doc.WrapTextLines(20, "ÀÉÎÕÜ"), []string{"ÀÉÎ", "ÕÜ"})
+} //                                              Test_lineBreaks_WrapTextLines_
+
+// -----------------------------------------------------------------------------
+// # Helper Functions
+
+// tLineSegments returns the parts of 's' between line break opportunities
+func tLineSegments(s string) (ret []string) {
+	at := 0
+	for _, n := range lineBreaks(s) {
+		ret = append(ret, s[at:n])
+		at = n
+	}
+	if at < len(s) {
+		ret = append(ret, s[at:])
+	}
+	return ret
+} //                                                               tLineSegments
+
+// end
@@ -7,6 +7,7 @@ package pdf
 
 // # Public Tests:
 //   Test_NewPDF_
+//   Test_PDF_CharSpacing_
 //   Test_PDF_Clean_
 //   Test_PDF_Color_
 //   Test_PDF_Compression_
@@ -19,34 +20,51 @@ package pdf
 //   Test_PDF_DrawBox_
 //   Test_PDF_DrawCircle_
 //   Test_PDF_DrawImage_
+//   Test_PDF_DrawRichTextInBox_
 //   Test_PDF_DrawTextAt_
 //   Test_PDF_DrawTextInBox_
+//   Test_PDF_DrawTextInBoxOverflow_
+//   Test_PDF_DrawTextInFrames_
 //   Test_PDF_DrawText_
 //   Test_PDF_DrawUnitGrid_
 //   Test_PDF_Errors_
 //   Test_PDF_FillBox_
 //   Test_PDF_FillCircle_
+//   Test_PDF_FontFallbacks_
+//   Test_PDF_FontFeatures_
+//   Test_PDF_FontMetrics_
 //   Test_PDF_FontName_
 //   Test_PDF_FontSize_
+//   Test_PDF_FontStyle_
 //   Test_PDF_HorizontalScaling_
+//   Test_PDF_LineHeight_
 //   Test_PDF_LineWidth_
 //   Test_PDF_PageCount_
 //   Test_PDF_PageHeight_
 //   Test_PDF_PageWidth_
+//   Test_PDF_ParagraphSpacing_
 //   Test_PDF_PullError_
+//   Test_PDF_RegisterFont_
 //   Test_PDF_Reset_
 //   Test_PDF_SetFont_
 //   Test_PDF_SetXY_
+//   Test_PDF_TextRenderMode_
+//   Test_PDF_TextWidth_
 //   Test_PDF_ToColor_1_
 //   Test_PDF_ToColor_2_
 //   Test_PDF_ToPoints_
 //   Test_PDF_ToUnits_
 //   Test_PDF_Units_
+//   Test_PDF_WordSpacing_
 //   Test_PDF_X_
 //   Test_PDF_Y_
 //
 // # Internal Tests
+//   Test_builtInCode_
+//   Test_builtInRuns_
 //   Test_getPapreSize_
+//   Test_kerning_
+//   Test_wrapTotalFit_
 //
 // # Helper Functions
 //   callerList() []string
@@ -71,6 +89,7 @@ import (
 	"bytes"
 	"fmt"
 	"image/color"
+	"math"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -83,6 +102,32 @@ import (
 
 // to run all tests
 
+// Test_PDF_CharSpacing_ tests PDF.CharSpacing() and SetCharSpacing()
+func Test_PDF_CharSpacing_(t *testing.T) {
+	var doc PDF // uninitialized PDF
+	tEqual(t, doc.CharSpacing(), 0.0)
+	tEqual(t, doc.SetCharSpacing(-0.5).CharSpacing(), -0.5)
+	//
+	// character spacing is added after each character, and written as Tc
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		DrawText("AV").SetCharSpacing(1).SetXY(10, 100).DrawText("AV")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, floatStr(doc.TextWidth("AV")), "14.640") // 12.64 + 2 * 1
+	tEqual(t, doc.X(), 24.64)
+	tEqual(t, strings.Contains(doc.pages[0].content.String(),
+		"BT 1.000 Tc ET\n"+"BT 10.000 741.890 Td [(A) 70 (V)] TJ ET\n"), true)
+	//
+	// horizontal scaling also scales character spacing
+	doc.SetHorizontalScaling(50)
+	tEqual(t, floatStr(doc.TextWidth("AV")), "7.320")
+	//
+	// spaced characters are not joined in ligatures, unless specified
+	tEqual(t, doc.fontFeature("liga"), 0)
+	doc.SetFontFeatures("liga")
+	tEqual(t, doc.fontFeature("liga"), 1)
+} //                                                       Test_PDF_CharSpacing_
+
 // Test_PDF_Clean_ is the unit test for PDF.Clean()
 func Test_PDF_Clean_(t *testing.T) {
 	//
@@ -428,18 +473,19 @@ func Test_PDF_Compression_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Filter/FlateDecode/Length 81>> stream
-		0A 78 9C 72 0A 51 D0 77 F3 0B 31 54 30 34 50 08
-		49 53 70 0D E1 52 30 D0 33 30 30 40 21 8B D2 B9
-		30 05 83 DC B9 9C 42 14 8C 2C 14 2C 0C 8D 15 42
-		52 14 34 3C 52 73 72 F2 15 C2 F3 8B 72 52 14 15
-		90 39 9A 0A 21 59 20 93 01 01 00 00 FF FF F6 FE
-		19 77 0A
+		4 0 obj <</Filter/FlateDecode/Length 108>> stream
+		0A 78 9C 84 C9 A1 0E C2 30 14 46 61 DF A7 38 B8
+		56 50 6E B9 1D 99 5E 32 20 08 04 F9 13 04 C1 0D
+		30 4D 96 EC FD 05 9A 20 66 8E 38 DF 20 76 C7 AB
+		0A C5 D0 9B 51 01 CB 66 F6 D3 E5 13 FE E7 ED 14
+		06 B1 EF B3 D7 03 7D F1 DC 55 47 13 8F 78 7E B5
+		36 93 A8 46 BC 27 DC 88 F3 92 D8 96 8E D8 A6 0D
+		2B 9E 9E E8 C2 A8 F0 1D 00 11 F1 21 BE 0A
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Helvetica
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -448,11 +494,11 @@ func Test_PDF_Compression_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000000378 00000 n
+		0000000406 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		479
+		506
 		%%EOF
 		`
 		doc := NewPDF("A4") // initialized PDF
@@ -472,16 +518,16 @@ func Test_PDF_Compression_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 108>> stream
+		4 0 obj <</Length 156>> stream
 		BT /FNT1 10 Tf ET
 		0.000 0.000 0.000 rg
 		0.000 0.000 0.000 RG
-		BT 28 813 Td (Hello World! Hello World!) Tj ET
+		BT 28.346 813.543 Td [(Hello ) 40 (W) 30 (or) -15 (ld! Hello ) 40 (W) 30 (or) -15 (ld!)] TJ ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Helvetica
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -490,11 +536,11 @@ func Test_PDF_Compression_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000000387 00000 n
+		0000000435 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		488
+		535
 		%%EOF
 		`
 		doc := NewPDF("A4") // initialized PDF
@@ -1419,18 +1465,18 @@ func Test_PDF_DrawText_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 143>> stream
+		4 0 obj <</Length 167>> stream
 		BT /FNT1 10 Tf ET
 		0.000 0.420 0.235 rg
 		0.000 0.420 0.235 RG
-		BT 0 700 Td (FIRST) Tj ET
-		BT 28 700 Td (SECOND) Tj ET
-		BT 141 700 Td (THIRD) Tj ET
+		BT 0.000 700.157 Td (FIRST) Tj ET
+		BT 28.346 700.157 Td (SECOND) Tj ET
+		BT 141.732 700.157 Td (THIRD) Tj ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Helvetica-Bold
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -1439,11 +1485,11 @@ func Test_PDF_DrawText_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000000422 00000 n
+		0000000446 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		528
+		551
 		%%EOF
 		`
 		pdfCompare(t, doc.Bytes(), want)
@@ -1467,17 +1513,17 @@ func Test_PDF_DrawText_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 113>> stream
+		4 0 obj <</Length 137>> stream
 		BT /FNT1 10 Tf ET
 		BT 150 Tz ET
 		0.000 0.000 0.000 rg
 		0.000 0.000 0.000 RG
-		BT 141 700 Td (Ye-Olde-Scriptte) Tj ET
+		BT 141.732 700.157 Td [(Y) 140 (e-Olde-Scr) -15 (iptte)] TJ ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Helvetica
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -1486,11 +1532,11 @@ func Test_PDF_DrawText_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000000392 00000 n
+		0000000416 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		493
+		516
 		%%EOF
 		`
 		pdfCompare(t, doc.Bytes(), want)
@@ -1500,6 +1546,86 @@ func Test_PDF_DrawText_(t *testing.T) {
 	}()
 } //                                                          Test_PDF_DrawText_
 
+// Test_PDF_DrawRichTextInBox_ tests drawing of text with markup tags
+// and how the markup is parsed into runs of text in the same style
+func Test_PDF_DrawRichTextInBox_(t *testing.T) {
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		DrawRichTextInBox(0, 100, 100, 100, "LT",
+			"<b>Widget</b> with <color=red>red</color> details\n"+
+				"<size=20>Big</size> <i>it</i>al<x>")
+	failIfHasErrors(t, doc.Errors)
+	//
+	// lines wrap across runs, and are as high as their largest font.
+	// unknown tags are drawn as text
+	tEqual(t, doc.pages[0].content.String(),
+		"BT /FNT1 10 Tf ET\n"+
+			"BT /FNT2 10 Tf ET\n"+
+			" 0.000 0.000 0.000 rg\n0.000 0.000 0.000 RG\n"+
+			"BT 1.667 731.890 Td [(Widg) -10 (et)] TJ ET\n"+
+			"BT /FNT1 10 Tf ET\n"+
+			"BT 35.097 731.890 Td ( with ) Tj ET\n"+
+			" 1.000 0.000 0.000 rg\n1.000 0.000 0.000 RG\n"+
+			"BT 58.437 731.890 Td (red) Tj ET\n"+
+			" 0.000 0.000 0.000 rg\n0.000 0.000 0.000 RG\n"+
+			"BT 1.667 721.890 Td (details) Tj ET\n"+
+			"BT /FNT1 20 Tf ET\n"+
+			"BT 1.667 701.890 Td (Big) Tj ET\n"+
+			"BT /FNT1 10 Tf ET\n"+
+			"BT 30.567 701.890 Td ( ) Tj ET\n"+
+			"BT /FNT3 10 Tf ET\n"+
+			"BT 33.347 701.890 Td (it) Tj ET\n"+
+			"BT /FNT1 10 Tf ET\n"+
+			"BT 38.347 701.890 Td (al<x>) Tj ET\n")
+	//
+	// the current style is restored after drawing
+	tEqual(t, doc.fontStyle, "")
+	tEqual(t, doc.font.name, "Helvetica")
+	tEqual(t, doc.FontSize(), 10.0)
+	//
+	// tags can be nested, and start from the current style
+	doc.SetFontStyle("I")
+	red, blue := color.RGBA{255, 0, 0, 255}, color.RGBA{0, 0, 255, 255}
+	tEqual(t, doc.parseRichText(
+		"a<b>b<color=red>c<color=#0000FF><size=8>d</size></color>e"+
+			"</color></b>\n\nf</b>"),
+		[][]pdfStyleRun{
+			{{"a", "I", pdfBlack, 10}, {"b", "BI", pdfBlack, 10},
+				{"c", "BI", red, 10}, {"d", "BI", blue, 8},
+				{"e", "BI", red, 10}},
+			nil,
+			{{"f", "I", pdfBlack, 10}},
+		})
+	failIfHasErrors(t, doc.Errors)
+	//
+	// invalid colors and sizes are logged
+	doc.parseRichText("<color=nocolor>a</color><size=x>b</size>")
+	tEqual(t, doc.ErrorInfo(doc.PullError()).Msg, "Unknown color name")
+	tEqual(t, doc.ErrorInfo(doc.PullError()).Msg, "Invalid font size")
+	//
+	// entities are drawn as '<', '>' and '&', and unknown tags as text
+	tEqual(t, doc.parseRichText(
+		"<b>&lt;b&gt;</b> &amp;lt; a < b & c > d <u>e</u>"),
+		[][]pdfStyleRun{{{"<b>", "BI", pdfBlack, 10},
+			{" &lt; a < b & c > d <u>e</u>", "I", pdfBlack, 10}}})
+	failIfHasErrors(t, doc.Errors)
+	//
+	// words too wide for a line are broken between characters
+	doc = NewPDF("A4")
+	doc.SetUnits("pt").SetFont("Helvetica", 10).DrawText("")
+	doc.applyFont()
+	var got []string
+	for _, line := range doc.wrapRuns(20, []pdfStyleRun{
+		{"WWWW", "", pdfBlack, 10}, {"ab", "B", pdfBlack, 10}}) {
+		var s string
+		for _, run := range line {
+			s += run.text + "|"
+		}
+		got = append(got, s)
+	}
+	tEqual(t, got, []string{"WW|", "WW|", "ab|"})
+} //                                                 Test_PDF_DrawRichTextInBox_
+
 // Test_PDF_DrawTextAt_ is the unit test for
 // DrawTextAt(x, y float64, text string) *PDF
 func Test_PDF_DrawTextAt_(t *testing.T) {
@@ -1529,13 +1655,13 @@ func Test_PDF_DrawTextAt_(t *testing.T) {
 	3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 	/Resources <</Font <</FNT1 5 0 R>> >> >>
 	endobj
-	4 0 obj <</Length 297>> stream
+	4 0 obj <</Length 321>> stream
 	BT /FNT1 20 Tf ET
 	0.212 0.271 0.310 rg
 	0.212 0.271 0.310 RG
-	BT 141 700 Td (\(5,5\)) Tj ET
-	BT 283 558 Td (\(10,10\)) Tj ET
-	BT 425 416 Td (\(15,15\)) Tj ET
+	BT 141.732 700.157 Td (\(5,5\)) Tj ET
+	BT 283.465 558.425 Td (\(10,10\)) Tj ET
+	BT 425.197 416.693 Td (\(15,15\)) Tj ET
 	0.878 0.235 0.192 rg
 	0.878 0.235 0.192 RG
 	141.732 697.323 2.835 2.835 re b
@@ -1545,7 +1671,7 @@ func Test_PDF_DrawTextAt_(t *testing.T) {
 	endobj
 	5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 	/BaseFont/Helvetica-Bold
-	/Encoding/StandardEncoding>>
+	/Encoding/WinAnsiEncoding>>
 	endobj
 	xref
 	0 6
@@ -1554,11 +1680,11 @@ func Test_PDF_DrawTextAt_(t *testing.T) {
 	0000000056 00000 n
 	0000000130 00000 n
 	0000000228 00000 n
-	0000000576 00000 n
+	0000000600 00000 n
 	trailer
 	<</Size 6/Root 1 0 R>>
 	startxref
-	682
+	705
 	%%EOF
 	`
 	pdfCompare(t, doc.Bytes(), want)
@@ -1602,47 +1728,48 @@ func Test_PDF_DrawTextInBox_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 1252>> stream
+		4 0 obj <</Length 1623>> stream
 		0.827 0.827 0.827 rg
 		0.827 0.827 0.827 RG
 		141.732 274.961 85.039 425.197 re b
 		BT /FNT1 10 Tf ET
 		0.000 0.000 0.000 rg
 		0.000 0.000 0.000 RG
-		BT 153 624 Td (Lorem ipsum ) Tj ET
-		BT 151 614 Td (dolor sit amet, ) Tj ET
-		BT 157 604 Td (consectetur ) Tj ET
-		BT 142 594 Td (adipiscing elit, sed ) Tj ET
-		BT 157 584 Td (do eiusmod ) Tj ET
-		BT 144 574 Td (tempor incididunt ) Tj ET
-		BT 142 564 Td (ut labore et dolore ) Tj ET
-		BT 145 554 Td (magna aliqua. Ut ) Tj ET
-		BT 150 544 Td (enim ad minim ) Tj ET
-		BT 154 534 Td (veniam, quis ) Tj ET
-		BT 166 524 Td (nostrud ) Tj ET
-		BT 157 514 Td (exercitation ) Tj ET
-		BT 149 504 Td (ullamco laboris ) Tj ET
-		BT 147 494 Td (nisi ut aliquip ex ) Tj ET
-		BT 153 484 Td (ea commodo ) Tj ET
-		BT 147 474 Td (consequat. Duis ) Tj ET
-		BT 143 464 Td (aute irure dolor in ) Tj ET
-		BT 147 454 Td (reprehenderit in ) Tj ET
-		BT 152 444 Td (voluptate velit ) Tj ET
-		BT 142 434 Td (esse cillum dolore ) Tj ET
-		BT 151 424 Td (eu fugiat nulla ) Tj ET
-		BT 164 414 Td (pariatur. ) Tj ET
-		BT 151 404 Td (Excepteur sint ) Tj ET
-		BT 162 394 Td (occaecat ) Tj ET
-		BT 152 384 Td (cupidatat non ) Tj ET
-		BT 147 374 Td (proident, sunt in ) Tj ET
-		BT 148 364 Td (culpa qui officia ) Tj ET
-		BT 150 354 Td (deserunt mollit ) Tj ET
-		BT 139 344 Td (anim id est laborum.) Tj ET
+		BT 155.357 628.969 Td (Lorem ipsum) Tj ET
+		BT 152.852 618.969 Td (dolor sit amet,) Tj ET
+		BT 158.407 608.969 Td (consectetur) Tj ET
+		BT 143.402 598.969 Td (adipiscing elit, sed) Tj ET
+		BT 158.407 588.969 Td (do eiusmod) Tj ET
+		BT 146.182 578.969 Td (tempor incididunt) Tj ET
+		BT 143.952 568.969 Td (ut labore et dolore) Tj ET
+		BT 146.757 558.969 Td [(magna aliqua.) 60 ( Ut)] TJ ET
+		BT 151.747 548.969 Td (enim ad minim) Tj ET
+		BT 156.312 538.969 Td [(v) 25 (eniam, quis)] TJ ET
+		BT 167.502 528.969 Td [(nostr) -15 (ud)] TJ ET
+		BT 158.987 518.969 Td [(e) 30 (x) 30 (ercitation)] TJ ET
+		BT 150.837 508.969 Td [(ullamco labor) -15 (is)] TJ ET
+		BT 148.832 498.969 Td [(nisi ut aliquip e) 30 (x)] TJ ET
+		BT 155.352 488.969 Td (ea commodo) Tj ET
+		BT 148.702 478.969 Td [(consequat.) 60 ( Duis)] TJ ET
+		BT 145.272 468.969 Td [(aute ir) -15 (ure dolor in)] TJ ET
+		BT 149.162 458.969 Td [(reprehender) -15 (it in)] TJ ET
+		BT 153.932 448.969 Td [(v) 25 (oluptate v) 25 (elit)] TJ ET
+		BT 144.242 438.969 Td (esse cillum dolore) Tj ET
+		BT 153.172 428.969 Td [(eu fugiat n) 10 (ulla)] TJ ET
+		BT 166.087 418.969 Td [(par) -15 (iatur) 50 (.)] TJ ET
+		BT 152.572 408.969 Td (Excepteur sint) Tj ET
+		BT 164.242 398.969 Td (occaecat) Tj ET
+		BT 154.232 388.969 Td (cupidatat non) Tj ET
+		BT 148.677 378.969 Td (proident, sunt in) Tj ET
+		BT 149.792 368.969 Td (culpa qui officia) Tj ET
+		BT 151.667 358.969 Td [(deser) -15 (unt mollit)] TJ ET
+		BT 160.077 348.969 Td (anim id est) Tj ET
+		BT 164.727 338.969 Td [(labor) -15 (um.)] TJ ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Helvetica
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -1651,11 +1778,11 @@ func Test_PDF_DrawTextInBox_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000001532 00000 n
+		0000001903 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		1633
+		2003
 		%%EOF
 		`
 		pdfCompare(t, doc.Bytes(), want)
@@ -1679,58 +1806,59 @@ func Test_PDF_DrawTextInBox_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 1505>> stream
+		4 0 obj <</Length 1816>> stream
 		0.827 0.827 0.827 rg
 		0.827 0.827 0.827 RG
 		141.732 274.961 85.039 425.197 re b
 		BT /FNT1 10 Tf ET
 		0.000 0.000 0.000 rg
 		0.000 0.000 0.000 RG
-		BT 148 679 Td (Lorem ipsum ) Tj ET
-		BT 154 669 Td (dolor sit ) Tj ET
-		BT 166 659 Td (amet, ) Tj ET
-		BT 148 649 Td (consectetur ) Tj ET
-		BT 151 639 Td (adipiscing ) Tj ET
-		BT 145 629 Td (elit, sed do ) Tj ET
-		BT 160 619 Td (eiusmod ) Tj ET
-		BT 163 609 Td (tempor ) Tj ET
-		BT 142 599 Td (incididunt ut ) Tj ET
-		BT 154 589 Td (labore et ) Tj ET
-		BT 145 579 Td (dolore magna ) Tj ET
-		BT 151 569 Td (aliqua. Ut ) Tj ET
-		BT 142 559 Td (enim ad minim ) Tj ET
-		BT 145 549 Td (veniam, quis ) Tj ET
-		BT 160 539 Td (nostrud ) Tj ET
-		BT 145 529 Td (exercitation ) Tj ET
-		BT 160 519 Td (ullamco ) Tj ET
-		BT 145 509 Td (laboris nisi ) Tj ET
-		BT 142 499 Td (ut aliquip ex ) Tj ET
-		BT 151 489 Td (ea commodo ) Tj ET
-		BT 151 479 Td (consequat. ) Tj ET
-		BT 154 469 Td (Duis aute ) Tj ET
-		BT 148 459 Td (irure dolor ) Tj ET
-		BT 175 449 Td (in ) Tj ET
-		BT 142 439 Td (reprehenderit ) Tj ET
-		BT 145 429 Td (in voluptate ) Tj ET
-		BT 151 419 Td (velit esse ) Tj ET
-		BT 142 409 Td (cillum dolore ) Tj ET
-		BT 154 399 Td (eu fugiat ) Tj ET
-		BT 166 389 Td (nulla ) Tj ET
-		BT 154 379 Td (pariatur. ) Tj ET
-		BT 154 369 Td (Excepteur ) Tj ET
-		BT 142 359 Td (sint occaecat ) Tj ET
-		BT 142 349 Td (cupidatat non ) Tj ET
-		BT 154 339 Td (proident, ) Tj ET
-		BT 142 329 Td (sunt in culpa ) Tj ET
-		BT 148 319 Td (qui officia ) Tj ET
-		BT 157 309 Td (deserunt ) Tj ET
-		BT 148 299 Td (mollit anim ) Tj ET
-		BT 139 289 Td (id est laborum.) Tj ET
+		BT 151.252 684.749 Td (Lorem ipsum) Tj ET
+		BT 157.252 674.749 Td (dolor sit) Tj ET
+		BT 169.252 664.749 Td (amet,) Tj ET
+		BT 151.252 654.749 Td (consectetur) Tj ET
+		BT 154.252 644.749 Td (adipiscing) Tj ET
+		BT 148.252 634.749 Td (elit, sed do) Tj ET
+		BT 163.252 624.749 Td (eiusmod) Tj ET
+		BT 166.252 614.749 Td (tempor) Tj ET
+		BT 145.252 604.749 Td (incididunt ut) Tj ET
+		BT 157.252 594.749 Td (labore et) Tj ET
+		BT 148.252 584.749 Td (dolore magna) Tj ET
+		BT 154.252 574.749 Td (aliqua. Ut) Tj ET
+		BT 145.252 564.749 Td (enim ad minim) Tj ET
+		BT 148.252 554.749 Td (veniam, quis) Tj ET
+		BT 163.252 544.749 Td (nostrud) Tj ET
+		BT 148.252 534.749 Td (exercitation) Tj ET
+		BT 163.252 524.749 Td (ullamco) Tj ET
+		BT 148.252 514.749 Td (laboris nisi) Tj ET
+		BT 145.252 504.749 Td (ut aliquip ex) Tj ET
+		BT 154.252 494.749 Td (ea commodo) Tj ET
+		BT 154.252 484.749 Td (consequat.) Tj ET
+		BT 157.252 474.749 Td (Duis aute) Tj ET
+		BT 151.252 464.749 Td (irure dolor) Tj ET
+		BT 178.252 454.749 Td (in) Tj ET
+		BT 145.252 444.749 Td (reprehenderit) Tj ET
+		BT 148.252 434.749 Td (in voluptate) Tj ET
+		BT 154.252 424.749 Td (velit esse) Tj ET
+		BT 145.252 414.749 Td (cillum dolore) Tj ET
+		BT 157.252 404.749 Td (eu fugiat) Tj ET
+		BT 169.252 394.749 Td (nulla) Tj ET
+		BT 157.252 384.749 Td (pariatur.) Tj ET
+		BT 157.252 374.749 Td (Excepteur) Tj ET
+		BT 145.252 364.749 Td (sint occaecat) Tj ET
+		BT 145.252 354.749 Td (cupidatat non) Tj ET
+		BT 157.252 344.749 Td (proident,) Tj ET
+		BT 145.252 334.749 Td (sunt in culpa) Tj ET
+		BT 151.252 324.749 Td (qui officia) Tj ET
+		BT 160.252 314.749 Td (deserunt) Tj ET
+		BT 151.252 304.749 Td (mollit anim) Tj ET
+		BT 166.252 294.749 Td (id est) Tj ET
+		BT 160.252 284.749 Td (laborum.) Tj ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Courier
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -1739,17 +1867,171 @@ func Test_PDF_DrawTextInBox_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000001785 00000 n
+		0000002096 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		1884
+		2194
 		%%EOF
 		`
 		pdfCompare(t, doc.Bytes(), want)
 	}()
+	//
+	// lines are wrapped to fit between margins of 1/6 of the font size,
+	// and aligned right or centered without their trailing spaces
+	func() {
+		doc := NewPDF("A4")
+		doc.SetCompression(false).SetUnits("pt").SetFont("Courier", 10).
+			DrawTextInBox(0, 100, 100, 100, "RT", "Hello   ").
+			DrawTextInBox(0, 200, 100, 100, "CT", "Hello   ").
+			DrawTextInBox(0, 300, 98, 100, "LT", "1234567890 12345")
+		failIfHasErrors(t, doc.Errors)
+		got := doc.pages[0].content.String()
+		for _, want := range []string{
+			"BT 68.333 731.890 Td (Hello) Tj ET\n", // 100 - 30 - 10/6
+			"BT 35.000 631.890 Td (Hello) Tj ET\n", // (100 - 30) / 2
+			"BT 1.667 531.890 Td (1234567890 ) Tj ET\n" +
+				"BT 1.667 521.890 Td (12345) Tj ET\n", // 96 > 98 - 10/3
+		} {
+			tEqual(t, strings.Contains(got, want), true)
+		}
+	}()
 } //                                                     Test_PDF_DrawTextInBox_
 
+// Test_PDF_DrawTextInBoxOverflow_ tests returning of text that doesn't fit
+// in a box, and the 'S' (shrink) and 'X' (clip) flags of DrawTextInBox()
+func Test_PDF_DrawTextInBoxOverflow_(t *testing.T) {
+	const text = "The quick brown fox jumps over the lazy dog.\n" +
+		"Pack my box with five dozen liquor jugs."
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10)
+	//
+	// the first box fits 3 lines: the first paragraph
+	rest := doc.DrawTextInBoxOverflow(10, 10, 100, 30, "LT", text)
+	tEqual(t, rest, "Pack my box with five dozen liquor jugs.")
+	tEqual(t, doc.DrawTextInBoxOverflow(10, 50, 100, 30, "LT", rest), "")
+	//
+	// text continues from the middle of a paragraph, without the soft
+	// hyphens or hyphens added to the lines that were drawn
+	tEqual(t, doc.DrawTextInBoxOverflow(10, 90, 60, 20, "LT",
+		"Re\u00ADcom\u00ADmen\u00ADda\u00ADtion of the board"), "board")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, doc.pages[0].content.String(), ""+
+		"BT /FNT1 10 Tf ET\n"+
+		" 0.000 0.000 0.000 rg\n"+
+		"0.000 0.000 0.000 RG\n"+
+		"BT 11.667 821.890 Td [(The quic) 20 (k bro) 15 (wn f) 30 (o) 30 "+
+		"(x )] TJ ET\n"+
+		"BT 11.667 811.890 Td [(jumps o) 15 (v) 25 (er the lazy )] TJ ET\n"+
+		"BT 11.667 801.890 Td (dog.) Tj ET\n"+
+		"BT 11.667 781.890 Td [(P) 40 (ac) 20 (k m) 15 (y bo) 30 "+
+		"(x with fiv) 25 (e )] TJ ET\n"+
+		"BT 11.667 771.890 Td [(doz) 15 (en liquor jugs) 15 (.)] TJ ET\n"+
+		"BT 11.667 741.890 Td (Recommen-) Tj ET\n"+
+		"BT 11.667 731.890 Td (dation of the ) Tj ET\n")
+	//
+	// 'S' shrinks the font size until the text fits, then restores it
+	doc.pages[0].content.Reset()
+	doc.DrawTextInBox(10, 200, 100, 30, "LTS", text)
+	tEqual(t, doc.pages[0].content.String(), ""+
+		"BT /FNT1 7 Tf ET\n"+
+		"BT 11.167 634.890 Td [(The quic) 20 (k bro) 15 (wn f) 30 (o) 30 "+
+		"(x jumps )] TJ ET\n"+
+		"BT 11.167 627.890 Td [(o) 15 (v) 25 (er the lazy dog.)] TJ ET\n"+
+		"BT 11.167 620.890 Td [(P) 40 (ac) 20 (k m) 15 (y bo) 30 "+
+		"(x with fiv) 25 (e doz) 15 (en )] TJ ET\n"+
+		"BT 11.167 613.890 Td [(liquor jugs) 15 (.)] TJ ET\n")
+	tEqual(t, doc.FontSize(), 10.0)
+	//
+	// a fractional size shrinks to whole sizes, which are measured and
+	// written with the same size
+	doc.pages[0].content.Reset()
+	doc.SetFontSize(10.5).DrawTextInBox(10, 200, 100, 30, "LTS", text)
+	tEqual(t, doc.pages[0].content.String(), ""+
+		"BT /FNT1 10 Tf ET\n"+
+		"BT /FNT1 7 Tf ET\n"+
+		"BT 11.167 634.890 Td [(The quic) 20 (k bro) 15 (wn f) 30 (o) 30 "+
+		"(x jumps )] TJ ET\n"+
+		"BT 11.167 627.890 Td [(o) 15 (v) 25 (er the lazy dog.)] TJ ET\n"+
+		"BT 11.167 620.890 Td [(P) 40 (ac) 20 (k m) 15 (y bo) 30 "+
+		"(x with fiv) 25 (e doz) 15 (en )] TJ ET\n"+
+		"BT 11.167 613.890 Td [(liquor jugs) 15 (.)] TJ ET\n")
+	tEqual(t, doc.FontSize(), 10.5)
+	doc.SetFontSize(10)
+	//
+	// 'X' clips the text to the box (cutting off the second line), then
+	// restores the graphics state
+	doc.pages[0].content.Reset()
+	doc.DrawTextInBox(10, 300, 100, 15, "LTX", "The quick brown fox jumps").
+		DrawTextAt(10, 400, "x")
+	tEqual(t, doc.pages[0].content.String(), ""+
+		"BT /FNT1 10 Tf ET\n"+
+		"q\n"+
+		"10.000 526.890 100.000 15.000 re W n\n"+
+		"BT 11.667 531.890 Td [(The quic) 20 (k bro) 15 (wn f) 30 (o) 30 "+
+		"(x )] TJ ET\n"+
+		"BT 11.667 521.890 Td (jumps) Tj ET\n"+
+		"Q\n"+
+		"BT /FNT1 10 Tf ET\n"+
+		"BT 100 Tz ET\n"+
+		"BT 0 Tr ET\n"+
+		"BT 0.000 Tc ET\n"+
+		"BT 0.000 Tw ET\n"+
+		" 0.000 0.000 0.000 rg\n"+
+		"0.000 0.000 0.000 RG\n"+
+		"1.000 w\n"+
+		"BT 10.000 441.890 Td (x) Tj ET\n")
+	failIfHasErrors(t, doc.Errors)
+} //                                             Test_PDF_DrawTextInBoxOverflow_
+
+// Test_PDF_DrawTextInFrames_ tests flowing of text through a chain of
+// frames set by SetTextFrames(), across columns and pages
+func Test_PDF_DrawTextInFrames_(t *testing.T) {
+	doc := NewPDF("100pt x 80pt")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		SetTextFrames(5, 5, 40, 30, 55, 5, 40, 30).
+		DrawTextInFrames("L",
+			"One two three four five six seven eight nine ten eleven").
+		DrawTextInFrames("L", "Twelve")
+	failIfHasErrors(t, doc.Errors)
+	//
+	// the text fills both columns, then continues on a new page,
+	// where the next text continues after it
+	tEqual(t, doc.PageCount(), 2)
+	tEqual(t, doc.pages[0].content.String(), ""+
+		"BT /FNT1 10 Tf ET\n"+
+		" 0.000 0.000 0.000 rg\n"+
+		"0.000 0.000 0.000 RG\n"+
+		"BT 6.667 65.000 Td (One ) Tj ET\n"+
+		"BT 6.667 55.000 Td [(tw) 10 (o )] TJ ET\n"+
+		"BT 6.667 45.000 Td (three ) Tj ET\n"+
+		"BT 56.667 65.000 Td [(f) 30 (our fiv) 25 (e )] TJ ET\n"+
+		"BT 56.667 55.000 Td (six ) Tj ET\n"+
+		"BT 56.667 45.000 Td [(se) 30 (v) 25 (en )] TJ ET\n")
+	tEqual(t, doc.pages[1].content.String(), ""+
+		"BT /FNT1 10 Tf ET\n"+
+		" 0.000 0.000 0.000 rg\n"+
+		"0.000 0.000 0.000 RG\n"+
+		"BT 6.667 65.000 Td (eight ) Tj ET\n"+
+		"BT 6.667 55.000 Td (nine ten ) Tj ET\n"+
+		"BT 6.667 45.000 Td [(ele) 30 (v) 25 (en)] TJ ET\n"+
+		"BT 56.667 65.000 Td [(T) 120 (w) 10 (elv) 25 (e)] TJ ET\n")
+	//
+	// errors
+	doc = NewPDF("A4")
+	doc.DrawTextInFrames("", "text")
+	tEqual(t, doc.PullError(), `No text frames "" @DrawTextInFrames`)
+	doc.SetTextFrames(1, 2, 3)
+	tEqual(t, doc.PullError(), `Invalid text frames "[1 2 3]" @SetTextFrames`)
+	doc.SetTextFrames(1, 1, 10, 10, 20, 1, 0, 10)
+	tEqual(t, doc.PullError(),
+		`Invalid text frames "[20 1 0 10]" @SetTextFrames`)
+	doc.SetTextFrames(1, 1, 100, 5).DrawTextInFrames("", "text")
+	tEqual(t, doc.PullError(),
+		`Text frames too small for text "text" @DrawTextInFrames`)
+	tEqual(t, doc.PageCount(), 1)
+} //                                                  Test_PDF_DrawTextInFrames_
+
 // Test_PDF_DrawUnitGrid_ is the unit test for PDF.DrawUnitGrid()
 func Test_PDF_DrawUnitGrid_(t *testing.T) {
 	got := func() []byte {
@@ -1766,7 +2048,7 @@ func Test_PDF_DrawUnitGrid_(t *testing.T) {
 	3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 	/Resources <</Font <</FNT1 5 0 R>> >> >>
 	endobj
-	4 0 obj <</Length 7417>> stream
+	4 0 obj <</Length 7825>> stream
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.100 w
@@ -1774,312 +2056,312 @@ func Test_PDF_DrawUnitGrid_(t *testing.T) {
 	BT /FNT1 8 Tf ET
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 833 Td (0) Tj ET
+	BT 2.835 833.386 Td (0) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	28.346 841.890 m 28.346 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 31 833 Td (1) Tj ET
+	BT 31.181 833.386 Td (1) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	56.693 841.890 m 56.693 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 59 833 Td (2) Tj ET
+	BT 59.528 833.386 Td (2) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	85.039 841.890 m 85.039 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 87 833 Td (3) Tj ET
+	BT 87.874 833.386 Td (3) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	113.386 841.890 m 113.386 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 116 833 Td (4) Tj ET
+	BT 116.220 833.386 Td (4) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	141.732 841.890 m 141.732 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 144 833 Td (5) Tj ET
+	BT 144.567 833.386 Td (5) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	170.079 841.890 m 170.079 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 172 833 Td (6) Tj ET
+	BT 172.913 833.386 Td (6) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	198.425 841.890 m 198.425 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 201 833 Td (7) Tj ET
+	BT 201.260 833.386 Td (7) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	226.772 841.890 m 226.772 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 229 833 Td (8) Tj ET
+	BT 229.606 833.386 Td (8) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	255.118 841.890 m 255.118 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 257 833 Td (9) Tj ET
+	BT 257.953 833.386 Td (9) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	283.465 841.890 m 283.465 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 286 833 Td (10) Tj ET
+	BT 286.299 833.386 Td (10) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	311.811 841.890 m 311.811 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 314 833 Td (11) Tj ET
+	BT 314.646 833.386 Td (11) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	340.157 841.890 m 340.157 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 342 833 Td (12) Tj ET
+	BT 342.992 833.386 Td (12) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	368.504 841.890 m 368.504 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 371 833 Td (13) Tj ET
+	BT 371.339 833.386 Td (13) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	396.850 841.890 m 396.850 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 399 833 Td (14) Tj ET
+	BT 399.685 833.386 Td (14) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	425.197 841.890 m 425.197 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 428 833 Td (15) Tj ET
+	BT 428.031 833.386 Td (15) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	453.543 841.890 m 453.543 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 456 833 Td (16) Tj ET
+	BT 456.378 833.386 Td (16) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	481.890 841.890 m 481.890 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 484 833 Td (17) Tj ET
+	BT 484.724 833.386 Td (17) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	510.236 841.890 m 510.236 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 513 833 Td (18) Tj ET
+	BT 513.071 833.386 Td (18) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	538.583 841.890 m 538.583 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 541 833 Td (19) Tj ET
+	BT 541.417 833.386 Td (19) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	566.929 841.890 m 566.929 0.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 569 833 Td (20) Tj ET
+	BT 569.764 833.386 Td (20) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 841.890 m 595.276 841.890 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 833 Td (0) Tj ET
+	BT 2.835 833.386 Td (0) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 813.543 m 595.276 813.543 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 805 Td (1) Tj ET
+	BT 2.835 805.039 Td (1) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 785.197 m 595.276 785.197 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 776 Td (2) Tj ET
+	BT 2.835 776.693 Td (2) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 756.850 m 595.276 756.850 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 748 Td (3) Tj ET
+	BT 2.835 748.346 Td (3) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 728.504 m 595.276 728.504 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 720 Td (4) Tj ET
+	BT 2.835 720.000 Td (4) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 700.157 m 595.276 700.157 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 691 Td (5) Tj ET
+	BT 2.835 691.654 Td (5) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 671.811 m 595.276 671.811 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 663 Td (6) Tj ET
+	BT 2.835 663.307 Td (6) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 643.465 m 595.276 643.465 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 634 Td (7) Tj ET
+	BT 2.835 634.961 Td (7) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 615.118 m 595.276 615.118 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 606 Td (8) Tj ET
+	BT 2.835 606.614 Td (8) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 586.772 m 595.276 586.772 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 578 Td (9) Tj ET
+	BT 2.835 578.268 Td (9) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 558.425 m 595.276 558.425 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 549 Td (10) Tj ET
+	BT 2.835 549.921 Td (10) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 530.079 m 595.276 530.079 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 521 Td (11) Tj ET
+	BT 2.835 521.575 Td (11) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 501.732 m 595.276 501.732 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 493 Td (12) Tj ET
+	BT 2.835 493.228 Td (12) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 473.386 m 595.276 473.386 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 464 Td (13) Tj ET
+	BT 2.835 464.882 Td (13) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 445.039 m 595.276 445.039 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 436 Td (14) Tj ET
+	BT 2.835 436.535 Td (14) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 416.693 m 595.276 416.693 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 408 Td (15) Tj ET
+	BT 2.835 408.189 Td (15) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 388.346 m 595.276 388.346 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 379 Td (16) Tj ET
+	BT 2.835 379.843 Td (16) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 360.000 m 595.276 360.000 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 351 Td (17) Tj ET
+	BT 2.835 351.496 Td (17) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 331.654 m 595.276 331.654 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 323 Td (18) Tj ET
+	BT 2.835 323.150 Td (18) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 303.307 m 595.276 303.307 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 294 Td (19) Tj ET
+	BT 2.835 294.803 Td (19) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 274.961 m 595.276 274.961 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 266 Td (20) Tj ET
+	BT 2.835 266.457 Td (20) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 246.614 m 595.276 246.614 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 238 Td (21) Tj ET
+	BT 2.835 238.110 Td (21) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 218.268 m 595.276 218.268 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 209 Td (22) Tj ET
+	BT 2.835 209.764 Td (22) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 189.921 m 595.276 189.921 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 181 Td (23) Tj ET
+	BT 2.835 181.417 Td (23) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 161.575 m 595.276 161.575 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 153 Td (24) Tj ET
+	BT 2.835 153.071 Td (24) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 133.228 m 595.276 133.228 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 124 Td (25) Tj ET
+	BT 2.835 124.724 Td (25) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 104.882 m 595.276 104.882 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 96 Td (26) Tj ET
+	BT 2.835 96.378 Td (26) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 76.535 m 595.276 76.535 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 68 Td (27) Tj ET
+	BT 2.835 68.031 Td (27) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 48.189 m 595.276 48.189 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 39 Td (28) Tj ET
+	BT 2.835 39.685 Td (28) Tj ET
 	0.784 0.784 0.784 rg
 	0.784 0.784 0.784 RG
 	0.000 19.843 m 595.276 19.843 l S
 	0.294 0.000 0.510 rg
 	0.294 0.000 0.510 RG
-	BT 2 11 Td (29) Tj ET
+	BT 2.835 11.339 Td (29) Tj ET
 	endstream
 	endobj
 	5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 	/BaseFont/Helvetica
-	/Encoding/StandardEncoding>>
+	/Encoding/WinAnsiEncoding>>
 	endobj
 	xref
 	0 6
@@ -2088,11 +2370,11 @@ func Test_PDF_DrawUnitGrid_(t *testing.T) {
 	0000000056 00000 n
 	0000000130 00000 n
 	0000000228 00000 n
-	0000007697 00000 n
+	0000008105 00000 n
 	trailer
 	<</Size 6/Root 1 0 R>>
 	startxref
-	7798
+	8205
 	%%EOF
 	`
 	pdfCompare(t, got, want)
@@ -2212,6 +2494,121 @@ func Test_PDF_FillCircle_(t *testing.T) {
 	pdfCompare(t, doc.Bytes(), want)
 } //                                                        Test_PDF_FillCircle_
 
+// Test_PDF_FontFallbacks_ tests SetFontFallbacks() and drawing and
+// measuring of text with characters that are not in the current font
+func Test_PDF_FontFallbacks_(t *testing.T) {
+	func() {
+		var doc PDF // uninitialized PDF
+		tEqual(t, len(doc.FontFallbacks()), 0)
+	}()
+	// glyphs: space=1 alpha=2 beta=3, each 500 units wide
+	font := tTrueTypeFont("TestGreek", " αβ", 500)
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").RegisterFont("Greek", font).
+		SetFontFallbacks("Courier", "Greek").
+		SetFont("Helvetica", 10).SetXY(10, 10).DrawText("ab αβ!")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, doc.FontFallbacks(), []string{"Courier", "Greek"})
+	//
+	// the characters missing from Helvetica are drawn in the first
+	// fallback font that has them, then Helvetica is used again
+	tEqual(t, doc.pages[0].content.String(),
+		"BT /FNT1 10 Tf ET\n"+
+			" 0.000 0.000 0.000 rg\n0.000 0.000 0.000 RG\n"+
+			"BT 10.000 831.890 Td (ab ) Tj ET\n"+
+			"BT /FNT2 10 Tf ET\n"+
+			"BT 23.900 831.890 Td [<00020003>] TJ ET\n"+
+			"BT /FNT1 10 Tf ET\n"+
+			"BT 33.900 831.890 Td (!) Tj ET\n")
+	//
+	// widths: "ab " 13.9pt in Helvetica, "αβ" 10pt, "!" 2.78pt
+	tEqual(t, floatStr(doc.TextWidth("ab αβ!")), "26.680")
+	//
+	// without fallbacks, the Greek letters can't be measured
+	doc.SetFontFallbacks()
+	doc.TextWidth("ab αβ!")
+	tEqual(t, len(doc.Errors()), 1)
+} //                                                     Test_PDF_FontFallbacks_
+
+// Test_PDF_FontFeatures_ tests SetFontFeatures() and how feature
+// settings are parsed
+func Test_PDF_FontFeatures_(t *testing.T) {
+	func() {
+		var doc PDF // uninitialized PDF
+		tEqual(t, doc.FontFeatures(), "")
+		tEqual(t, doc.fontFeature("liga"), 1) // on by default
+		tEqual(t, doc.fontFeature("kern"), 1)
+		tEqual(t, doc.fontFeature("smcp"), 0)
+	}()
+	func() {
+		doc := NewPDF("A4")
+		doc.SetFontFeatures("smcp, -kern +tnum salt=3 ss01")
+		failIfHasErrors(t, doc.Errors)
+		tEqual(t, doc.FontFeatures(), "smcp, -kern +tnum salt=3 ss01")
+		tEqual(t, doc.fontFeature("smcp"), 1)
+		tEqual(t, doc.fontFeature("kern"), 0)
+		tEqual(t, doc.fontFeature("tnum"), 1)
+		tEqual(t, doc.fontFeature("salt"), 3)
+		tEqual(t, doc.fontFeature("ss01"), 1)
+		tEqual(t, doc.fontFeature("liga"), 1)
+		//
+		// turning off 'kern' also turns off kerning of built-in fonts
+		doc.SetFont("Helvetica", 10).DrawText("AV") // applies the font
+		tEqual(t, doc.kerning('A', 'V'), 0)
+		doc.SetFontFeatures("")
+		tEqual(t, doc.kerning('A', 'V'), -70)
+	}()
+	// invalid settings are logged and leave the features unchanged
+	for _, s := range []string{"smallcaps", "sm-cp", "salt=x", "salt=-1",
+		"li\u00e7a"} {
+		doc := NewPDF("A4")
+		doc.SetFontFeatures("tnum").SetFontFeatures("onum " + s)
+		tEqual(t, doc.FontFeatures(), "tnum")
+		tEqual(t, doc.PullError(),
+			`Invalid font feature "`+s+`" @SetFontFeatures`)
+	}
+} //                                                      Test_PDF_FontFeatures_
+
+// Test_PDF_FontMetrics_ is the unit test for
+// (p *PDF) FontMetrics() (ret struct {...})
+func Test_PDF_FontMetrics_(t *testing.T) {
+	doc := NewPDF("A4")
+	doc.SetUnits("pt")
+	for _, tc := range []struct {
+		font string
+		want [6]float64
+	}{
+		{"Helvetica", [6]float64{7.18, -2.07, 11.56, 7.18, 5.23, -1}},
+		{"Times-Bold", [6]float64{6.83, -2.17, 11.53, 6.76, 4.61, -1}},
+		{"Courier-Oblique", [6]float64{6.29, -1.57, 10.55, 5.62, 4.26, -1}},
+		{"TestSans", [6]float64{7.5, -2.5, 12, 7, 5, -1}}, // OS/2 metrics
+	} {
+		doc.RegisterFont("TestSans", tTrueTypeFont("TestSans", "A")).
+			SetFont(tc.font, 10)
+		m := doc.FontMetrics()
+		tEqual(t, [6]float64{m.Ascent, m.Descent, m.Leading, m.CapHeight,
+			m.XHeight, m.UnderlinePosition}, tc.want)
+	}
+	failIfHasErrors(t, doc.Errors)
+	//
+	// metrics are in the current units, at the current font size
+	doc.SetUnits("mm").SetFont("Helvetica", 20)
+	tEqual(t, floatStr(doc.FontMetrics().Ascent), "5.066") // 14.36pt
+	//
+	// an invalid font reports an error and uses Helvetica's metrics
+	doc.SetFont("NoSuchFont", 20)
+	tEqual(t, floatStr(doc.FontMetrics().Ascent), "5.066")
+	tEqual(t, doc.ErrorInfo(doc.PullError()).Msg, "Invalid font")
+	//
+	// DrawTextInBox() centers the space from the top of capital letters
+	// to the baseline: baseline y = 841.89 - (100 + 20/2 + 7.18/2)
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		DrawTextInBox(0, 100, 100, 20, "C", "Hello")
+	got := doc.pages[0].content.String()
+	tEqual(t, strings.Contains(got, " 728.300 Td (Hello) Tj ET"), true)
+} //                                                       Test_PDF_FontMetrics_
+
 // Test_PDF_FontName_ is the unit test for
 func Test_PDF_FontName_(t *testing.T) {
 	//
@@ -2256,16 +2653,16 @@ func Test_PDF_FontName_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 95>> stream
+		4 0 obj <</Length 117>> stream
 		BT /FNT1 10 Tf ET
 		0.000 0.000 0.000 rg
 		0.000 0.000 0.000 RG
-		BT 28 813 Td (Hello World!) Tj ET
+		BT 28.346 813.543 Td [(Hello ) 30 (W) 80 (orld!)] TJ ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Times-Roman
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -2274,11 +2671,11 @@ func Test_PDF_FontName_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000000373 00000 n
+		0000000396 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		476
+		498
 		%%EOF
 		`
 		pdfCompare(t, doc.Bytes(), want)
@@ -2341,11 +2738,11 @@ func Test_PDF_FontSize_(t *testing.T) {
 		/FNT1 5 0 R
 		/FNT2 6 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 2440>> stream
+		4 0 obj <</Length 2616>> stream
 		BT /FNT1 20 Tf ET
 		0.000 0.000 0.000 rg
 		0.000 0.000 0.000 RG
-		BT 28 813 Td (Font Sizes) Tj ET
+		BT 28.346 813.543 Td [(F) 25 (ont Sizes)] TJ ET
 		0.745 0.745 0.745 RG
 		0.100 w
 		28.346 775.197 283.465 10.000 re S
@@ -2354,7 +2751,7 @@ func Test_PDF_FontSize_(t *testing.T) {
 		0.000 0.000 0.000 RG
 		28.346 755.197 283.465 30.000 re S
 		BT /FNT2 5 Tf ET
-		BT 157 780 Td (Helvetica 5) Tj ET
+		BT 157.776 780.197 Td [(Helv) 25 (etica 5)] TJ ET
 		0.745 0.745 0.745 RG
 		28.346 745.197 283.465 10.000 re S
 		28.346 735.197 283.465 10.000 re S
@@ -2362,7 +2759,7 @@ func Test_PDF_FontSize_(t *testing.T) {
 		0.000 0.000 0.000 RG
 		28.346 725.197 283.465 30.000 re S
 		BT /FNT2 6 Tf ET
-		BT 155 749 Td (Helvetica 6) Tj ET
+		BT 155.316 749.197 Td [(Helv) 25 (etica 6)] TJ ET
 		0.745 0.745 0.745 RG
 		28.346 715.197 283.465 10.000 re S
 		28.346 705.197 283.465 10.000 re S
@@ -2370,7 +2767,7 @@ func Test_PDF_FontSize_(t *testing.T) {
 		0.000 0.000 0.000 RG
 		28.346 695.197 283.465 30.000 re S
 		BT /FNT2 7 Tf ET
-		BT 152 718 Td (Helvetica 7) Tj ET
+		BT 152.855 718.197 Td [(Helv) 25 (etica 7)] TJ ET
 		0.745 0.745 0.745 RG
 		28.346 685.197 283.465 10.000 re S
 		28.346 675.197 283.465 10.000 re S
@@ -2378,7 +2775,7 @@ func Test_PDF_FontSize_(t *testing.T) {
 		0.000 0.000 0.000 RG
 		28.346 665.197 283.465 30.000 re S
 		BT /FNT2 8 Tf ET
-		BT 150 687 Td (Helvetica 8) Tj ET
+		BT 150.395 687.197 Td [(Helv) 25 (etica 8)] TJ ET
 		0.745 0.745 0.745 RG
 		28.346 655.197 283.465 10.000 re S
 		28.346 645.197 283.465 10.000 re S
@@ -2386,7 +2783,7 @@ func Test_PDF_FontSize_(t *testing.T) {
 		0.000 0.000 0.000 RG
 		28.346 635.197 283.465 30.000 re S
 		BT /FNT2 9 Tf ET
-		BT 147 656 Td (Helvetica 9) Tj ET
+		BT 147.934 656.197 Td [(Helv) 25 (etica 9)] TJ ET
 		0.745 0.745 0.745 RG
 		28.346 625.197 283.465 10.000 re S
 		28.346 615.197 283.465 10.000 re S
@@ -2394,7 +2791,7 @@ func Test_PDF_FontSize_(t *testing.T) {
 		0.000 0.000 0.000 RG
 		28.346 605.197 283.465 30.000 re S
 		BT /FNT2 10 Tf ET
-		BT 142 625 Td (Helvetica 10) Tj ET
+		BT 142.694 625.197 Td [(Helv) 25 (etica 10)] TJ ET
 		0.745 0.745 0.745 RG
 		28.346 595.197 283.465 10.000 re S
 		28.346 585.197 283.465 10.000 re S
@@ -2402,7 +2799,7 @@ func Test_PDF_FontSize_(t *testing.T) {
 		0.000 0.000 0.000 RG
 		28.346 575.197 283.465 30.000 re S
 		BT /FNT2 15 Tf ET
-		BT 128 590 Td (Helvetica 15) Tj ET
+		BT 129.001 590.197 Td [(Helv) 25 (etica 15)] TJ ET
 		0.745 0.745 0.745 RG
 		28.346 565.197 283.465 10.000 re S
 		28.346 555.197 283.465 10.000 re S
@@ -2410,7 +2807,7 @@ func Test_PDF_FontSize_(t *testing.T) {
 		0.000 0.000 0.000 RG
 		28.346 545.197 283.465 30.000 re S
 		BT /FNT2 20 Tf ET
-		BT 115 555 Td (Helvetica 20) Tj ET
+		BT 115.309 555.197 Td [(Helv) 25 (etica 20)] TJ ET
 		0.745 0.745 0.745 RG
 		28.346 535.197 283.465 10.000 re S
 		28.346 525.197 283.465 10.000 re S
@@ -2418,7 +2815,7 @@ func Test_PDF_FontSize_(t *testing.T) {
 		0.000 0.000 0.000 RG
 		28.346 515.197 283.465 30.000 re S
 		BT /FNT2 25 Tf ET
-		BT 101 520 Td (Helvetica 25) Tj ET
+		BT 101.616 520.197 Td [(Helv) 25 (etica 25)] TJ ET
 		0.745 0.745 0.745 RG
 		28.346 505.197 283.465 10.000 re S
 		28.346 495.197 283.465 10.000 re S
@@ -2426,16 +2823,16 @@ func Test_PDF_FontSize_(t *testing.T) {
 		0.000 0.000 0.000 RG
 		28.346 485.197 283.465 30.000 re S
 		BT /FNT2 30 Tf ET
-		BT 87 485 Td (Helvetica 30) Tj ET
+		BT 87.924 485.197 Td [(Helv) 25 (etica 30)] TJ ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Times-Bold
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		6 0 obj <</Type/Font/Subtype/Type1/Name/FNT2
 		/BaseFont/Helvetica
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 7
@@ -2444,12 +2841,12 @@ func Test_PDF_FontSize_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000241 00000 n
-		0000002733 00000 n
-		0000002835 00000 n
+		0000002909 00000 n
+		0000003010 00000 n
 		trailer
 		<</Size 7/Root 1 0 R>>
 		startxref
-		2936
+		3110
 		%%EOF
 		`
 		pdfCompare(t, doc.Bytes(), want)
@@ -2457,6 +2854,43 @@ func Test_PDF_FontSize_(t *testing.T) {
 	}()
 } //                                                          Test_PDF_FontSize_
 
+// Test_PDF_FontStyle_ is the unit test for
+// PDF.FontStyle() and PDF.SetFontStyle()
+func Test_PDF_FontStyle_(t *testing.T) {
+	//
+	// the style selects the font from a built-in font family
+	for _, tc := range []struct {
+		family, style, wantStyle, wantFont string
+	}{
+		{"Helvetica", "", "", "Helvetica"},
+		{"Helvetica", "B", "B", "Helvetica-Bold"},
+		{"helvetica", "i", "I", "Helvetica-Oblique"},
+		{"Helvetica", "IB", "BI", "Helvetica-BoldOblique"},
+		{"Times", "", "", "Times-Roman"},
+		{"Times-Roman", "BI", "BI", "Times-BoldItalic"},
+		{"Courier", "I", "I", "Courier-Oblique"},
+		{"Courier-Bold", "", "", "Courier-Bold"},
+		{"Symbol", "B", "B", "Symbol"}, // not a family
+	} {
+		doc := NewPDF("A4")
+		doc.SetFont(tc.family, 10).SetFontStyle(tc.style).DrawText("1")
+		failIfHasErrors(t, doc.Errors)
+		tEqual(t, doc.FontName(), tc.family)
+		tEqual(t, doc.FontStyle(), tc.wantStyle)
+		tEqual(t, doc.font.name, tc.wantFont)
+	}
+	// an invalid style is logged and leaves the style unchanged
+	func() {
+		var doc PDF
+		tEqual(t, doc.FontStyle(), "")
+		doc.SetFontStyle("B").SetFontStyle("Bold")
+		tEqual(t, doc.FontStyle(), "B")
+		tEqual(t, doc.PullError(), `Invalid font style "Bold" @SetFontStyle`)
+		doc.SetFontStyle("BB")
+		tEqual(t, len(doc.Errors()), 1)
+	}()
+} //                                                         Test_PDF_FontStyle_
+
 // Test_PDF_HorizontalScaling_ is the unit test for PDF.HorizontalScaling()
 func Test_PDF_HorizontalScaling_(t *testing.T) {
 	//
@@ -2515,47 +2949,47 @@ func Test_PDF_HorizontalScaling_(t *testing.T) {
 		/FNT1 5 0 R
 		/FNT2 6 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 899>> stream
+		4 0 obj <</Length 1170>> stream
 		BT /FNT1 20 Tf ET
 		0.000 0.000 0.000 rg
 		0.000 0.000 0.000 RG
-		BT 28 566 Td (Horizontal Scaling Property) Tj ET
+		BT 28.346 566.929 Td [(Horizontal Scaling Pr) 18 (operty)] TJ ET
 		BT /FNT2 10 Tf ET
-		BT 28 524 Td (Horizontal Scaling = 50) Tj ET
+		BT 28.346 524.409 Td [(Hor) -15 (iz) 15 (ontal Scaling = 50)] TJ ET
 		BT /FNT2 20 Tf ET
 		BT 50 Tz ET
-		BT 28 504 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 504.567 Td [(Fiv) 25 (e he) 30 (xing wizard bots jump quic) 20 (kly)] TJ ET
 		BT /FNT2 10 Tf ET
 		BT 100 Tz ET
-		BT 28 453 Td (Horizontal Scaling = 100) Tj ET
+		BT 28.346 453.543 Td [(Hor) -15 (iz) 15 (ontal Scaling = 100)] TJ ET
 		BT /FNT2 20 Tf ET
-		BT 28 433 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 433.701 Td [(Fiv) 25 (e he) 30 (xing wizard bots jump quic) 20 (kly)] TJ ET
 		BT /FNT2 10 Tf ET
-		BT 28 382 Td (Horizontal Scaling = 150) Tj ET
+		BT 28.346 382.677 Td [(Hor) -15 (iz) 15 (ontal Scaling = 150)] TJ ET
 		BT /FNT2 20 Tf ET
 		BT 150 Tz ET
-		BT 28 362 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 362.835 Td [(Fiv) 25 (e he) 30 (xing wizard bots jump quic) 20 (kly)] TJ ET
 		BT /FNT2 10 Tf ET
 		BT 100 Tz ET
-		BT 28 311 Td (Horizontal Scaling = 200) Tj ET
+		BT 28.346 311.811 Td [(Hor) -15 (iz) 15 (ontal Scaling = 200)] TJ ET
 		BT /FNT2 20 Tf ET
 		BT 200 Tz ET
-		BT 28 291 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 291.969 Td [(Fiv) 25 (e he) 30 (xing wizard bots jump quic) 20 (kly)] TJ ET
 		BT /FNT2 10 Tf ET
 		BT 100 Tz ET
-		BT 28 240 Td (Horizontal Scaling = 250) Tj ET
+		BT 28.346 240.945 Td [(Hor) -15 (iz) 15 (ontal Scaling = 250)] TJ ET
 		BT /FNT2 20 Tf ET
 		BT 250 Tz ET
-		BT 28 221 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 221.102 Td [(Fiv) 25 (e he) 30 (xing wizard bots jump quic) 20 (kly)] TJ ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Times-Bold
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		6 0 obj <</Type/Font/Subtype/Type1/Name/FNT2
 		/BaseFont/Helvetica
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 7
@@ -2564,12 +2998,12 @@ func Test_PDF_HorizontalScaling_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000241 00000 n
-		0000001191 00000 n
-		0000001293 00000 n
+		0000001463 00000 n
+		0000001564 00000 n
 		trailer
 		<</Size 7/Root 1 0 R>>
 		startxref
-		1394
+		1664
 		%%EOF
 		`
 		pdfCompare(t, doc.Bytes(), want)
@@ -2577,6 +3011,63 @@ func Test_PDF_HorizontalScaling_(t *testing.T) {
 	}()
 } //                                                 Test_PDF_HorizontalScaling_
 
+// Test_PDF_LineHeight_ tests the line height used by NextLine(),
+// DrawText() with columns, DrawTextInBox() and DrawRichTextInBox()
+func Test_PDF_LineHeight_(t *testing.T) {
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("mm").SetFont("Helvetica", 10)
+	tEqual(t, doc.LineHeight(), 10.0) // the font size by default
+	//
+	// a multiple of the font size, or a fixed height
+	doc.SetLineHeight("1.5")
+	tEqual(t, doc.LineHeight(), 15.0)
+	doc.SetFontSize(12)
+	tEqual(t, doc.LineHeight(), 18.0)
+	doc.SetLineHeight("5mm")
+	tEqual(t, floatStr(doc.LineHeight()), "14.173")
+	doc.SetLineHeight("")
+	tEqual(t, doc.LineHeight(), 12.0)
+	failIfHasErrors(t, doc.Errors)
+	//
+	// NextLine() and DrawText() with columns advance Y by the line height
+	doc.SetFontSize(10).SetLineHeight("1.5").SetXY(10, 10).DrawText("A").
+		NextLine()
+	tEqual(t, floatStr(doc.Y()), "15.292") // 10mm + 15pt
+	doc.SetColumnWidths(20, 20).SetXY(0, 50).
+		DrawText("A1").DrawText("A2").DrawText("B1")
+	tEqual(t, floatStr(doc.Y()), "55.292")
+	//
+	// lines in a box are spaced by the line height
+	doc.pages[0].content.Reset()
+	doc.SetLineHeight("12pt").
+		DrawTextInBox(10, 100, 50, 50, "LT", "Two lines\nof text")
+	tEqual(t, doc.pages[0].content.String(), ""+
+		"BT 30.013 548.425 Td [(T) 120 (w) 10 (o lines)] TJ ET\n"+
+		"BT 30.013 536.425 Td [(of te) 30 (xt)] TJ ET\n")
+	//
+	// lines of rich text are spaced by the line height of their largest font
+	doc.pages[0].content.Reset()
+	doc.SetLineHeight("1.5").DrawRichTextInBox(10, 100, 50, 50, "LT",
+		"<size=20>Big</size>\nsmall\n<size=20>Big</size>")
+	tEqual(t, doc.pages[0].content.String(), ""+
+		"BT /FNT1 20 Tf ET\n"+
+		"BT 30.013 538.425 Td (Big) Tj ET\n"+
+		"BT /FNT1 10 Tf ET\n"+
+		"BT 30.013 523.425 Td (small) Tj ET\n"+
+		"BT /FNT1 20 Tf ET\n"+
+		"BT 30.013 493.425 Td (Big) Tj ET\n")
+	doc.SetLineHeight("12pt")
+	failIfHasErrors(t, doc.Errors)
+	//
+	// errors
+	for _, height := range []string{"x", "-2pt", "Inf", "NaN", "Infpt"} {
+		doc.SetLineHeight(height)
+		tEqual(t, doc.PullError(),
+			`Invalid line height "`+height+`" @SetLineHeight`)
+	}
+	tEqual(t, doc.LineHeight(), 12.0)
+} //                                                        Test_PDF_LineHeight_
+
 // Test_PDF_LineWidth_ is the unit test for PDF.LineWidth()
 // go test --run Test_PDF_LineWidth_
 func Test_PDF_LineWidth_(t *testing.T) {
@@ -2632,16 +3123,16 @@ func Test_PDF_LineWidth_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 2617>> stream
+		4 0 obj <</Length 2785>> stream
 		BT /FNT1 16 Tf ET
 		0.294 0.000 0.510 rg
 		0.294 0.000 0.510 RG
-		BT 28 799 Td (Test PDF.LineWidth\(\)) Tj ET
+		BT 28.346 799.370 Td [(T) 120 (est PDF) 150 (.LineWidth\(\))] TJ ET
 		BT /FNT1 9 Tf ET
 		0.663 0.663 0.663 rg
 		0.663 0.663 0.663 RG
-		BT 28 776 Td ( y = 2) Tj ET
-		BT 65 776 Td ( w = 0.1) Tj ET
+		BT 28.346 776.693 Td ( y = 2) Tj ET
+		BT 65.197 776.693 Td ( w = 0.1) Tj ET
 		0.745 0.745 0.745 rg
 		0.745 0.745 0.745 RG
 		0.100 w
@@ -2651,8 +3142,8 @@ func Test_PDF_LineWidth_(t *testing.T) {
 		113.386 785.197 m 425.197 785.197 l S
 		0.663 0.663 0.663 rg
 		0.663 0.663 0.663 RG
-		BT 28 748 Td ( y = 3) Tj ET
-		BT 65 748 Td ( w = 0.2) Tj ET
+		BT 28.346 748.346 Td ( y = 3) Tj ET
+		BT 65.197 748.346 Td ( w = 0.2) Tj ET
 		0.745 0.745 0.745 rg
 		0.745 0.745 0.745 RG
 		28.346 756.850 m 566.929 756.850 l S
@@ -2662,8 +3153,8 @@ func Test_PDF_LineWidth_(t *testing.T) {
 		113.386 756.850 m 425.197 756.850 l S
 		0.663 0.663 0.663 rg
 		0.663 0.663 0.663 RG
-		BT 28 720 Td ( y = 4) Tj ET
-		BT 65 720 Td ( w = 0.5) Tj ET
+		BT 28.346 720.000 Td ( y = 4) Tj ET
+		BT 65.197 720.000 Td ( w = 0.5) Tj ET
 		0.745 0.745 0.745 rg
 		0.745 0.745 0.745 RG
 		0.100 w
@@ -2674,8 +3165,8 @@ func Test_PDF_LineWidth_(t *testing.T) {
 		113.386 728.504 m 425.197 728.504 l S
 		0.663 0.663 0.663 rg
 		0.663 0.663 0.663 RG
-		BT 28 691 Td ( y = 5) Tj ET
-		BT 65 691 Td ( w = 1.0) Tj ET
+		BT 28.346 691.654 Td ( y = 5) Tj ET
+		BT 65.197 691.654 Td ( w = 1.0) Tj ET
 		0.745 0.745 0.745 rg
 		0.745 0.745 0.745 RG
 		0.100 w
@@ -2686,8 +3177,8 @@ func Test_PDF_LineWidth_(t *testing.T) {
 		113.386 700.157 m 425.197 700.157 l S
 		0.663 0.663 0.663 rg
 		0.663 0.663 0.663 RG
-		BT 28 663 Td ( y = 6) Tj ET
-		BT 65 663 Td ( w = 5.0) Tj ET
+		BT 28.346 663.307 Td ( y = 6) Tj ET
+		BT 65.197 663.307 Td ( w = 5.0) Tj ET
 		0.745 0.745 0.745 rg
 		0.745 0.745 0.745 RG
 		0.100 w
@@ -2698,8 +3189,8 @@ func Test_PDF_LineWidth_(t *testing.T) {
 		113.386 671.811 m 425.197 671.811 l S
 		0.663 0.663 0.663 rg
 		0.663 0.663 0.663 RG
-		BT 28 634 Td ( y = 7) Tj ET
-		BT 65 634 Td ( w = 10.0) Tj ET
+		BT 28.346 634.961 Td ( y = 7) Tj ET
+		BT 65.197 634.961 Td ( w = 10.0) Tj ET
 		0.745 0.745 0.745 rg
 		0.745 0.745 0.745 RG
 		0.100 w
@@ -2710,8 +3201,8 @@ func Test_PDF_LineWidth_(t *testing.T) {
 		113.386 643.465 m 425.197 643.465 l S
 		0.663 0.663 0.663 rg
 		0.663 0.663 0.663 RG
-		BT 28 606 Td ( y = 8) Tj ET
-		BT 65 606 Td ( w = 15.0) Tj ET
+		BT 28.346 606.614 Td ( y = 8) Tj ET
+		BT 65.197 606.614 Td ( w = 15.0) Tj ET
 		0.745 0.745 0.745 rg
 		0.745 0.745 0.745 RG
 		0.100 w
@@ -2722,8 +3213,8 @@ func Test_PDF_LineWidth_(t *testing.T) {
 		113.386 615.118 m 425.197 615.118 l S
 		0.663 0.663 0.663 rg
 		0.663 0.663 0.663 RG
-		BT 28 578 Td ( y = 9) Tj ET
-		BT 65 578 Td ( w = 20.0) Tj ET
+		BT 28.346 578.268 Td ( y = 9) Tj ET
+		BT 65.197 578.268 Td ( w = 20.0) Tj ET
 		0.745 0.745 0.745 rg
 		0.745 0.745 0.745 RG
 		0.100 w
@@ -2734,8 +3225,8 @@ func Test_PDF_LineWidth_(t *testing.T) {
 		113.386 586.772 m 425.197 586.772 l S
 		0.663 0.663 0.663 rg
 		0.663 0.663 0.663 RG
-		BT 28 549 Td ( y = 10) Tj ET
-		BT 65 549 Td ( w = 25.0) Tj ET
+		BT 28.346 549.921 Td ( y = 10) Tj ET
+		BT 65.197 549.921 Td ( w = 25.0) Tj ET
 		0.745 0.745 0.745 rg
 		0.745 0.745 0.745 RG
 		0.100 w
@@ -2748,7 +3239,7 @@ func Test_PDF_LineWidth_(t *testing.T) {
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Helvetica
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -2757,11 +3248,11 @@ func Test_PDF_LineWidth_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000002897 00000 n
+		0000003065 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		2998
+		3165
 		%%EOF
         `
 		pdfCompare(t, doc.Bytes(), want)
@@ -2917,6 +3408,64 @@ func Test_PDF_PageWidth_(t *testing.T) {
 	}()
 } //                                                         Test_PDF_PageWidth_
 
+// Test_PDF_ParagraphSpacing_ tests the space added between paragraphs
+// by DrawTextInBox(), DrawRichTextInBox() and DrawTextInFrames()
+func Test_PDF_ParagraphSpacing_(t *testing.T) {
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		SetParagraphSpacing(6, 4)
+	before, after := doc.ParagraphSpacing()
+	tEqual(t, []float64{before, after}, []float64{6, 4})
+	//
+	// the space is added between paragraphs, not between their lines
+	doc.DrawTextInBox(10, 10, 40, 100, "LT", "One two three\nFour")
+	tEqual(t, doc.pages[0].content.String(), ""+
+		"BT /FNT1 10 Tf ET\n"+
+		" 0.000 0.000 0.000 rg\n"+
+		"0.000 0.000 0.000 RG\n"+
+		"BT 11.667 821.890 Td (One ) Tj ET\n"+
+		"BT 11.667 811.890 Td [(tw) 10 (o )] TJ ET\n"+
+		"BT 11.667 801.890 Td (three) Tj ET\n"+
+		"BT 11.667 781.890 Td [(F) 30 (our)] TJ ET\n")
+	//
+	// ...also in rich text
+	doc.pages[0].content.Reset()
+	doc.DrawRichTextInBox(10, 10, 100, 100, "LT",
+		"One\n<size=20>Two</size>")
+	tEqual(t, doc.pages[0].content.String(), ""+
+		"BT 11.667 821.890 Td (One) Tj ET\n"+
+		"BT /FNT1 20 Tf ET\n"+
+		"BT 11.667 791.890 Td [(T) 120 (w) 10 (o)] TJ ET\n")
+	//
+	// ...and between texts that continue in the same frame
+	doc.pages[0].content.Reset()
+	doc.SetTextFrames(10, 100, 40, 100).
+		DrawTextInFrames("L", "One").DrawTextInFrames("L", "Two")
+	tEqual(t, doc.pages[0].content.String(), ""+
+		"BT /FNT1 10 Tf ET\n"+
+		"BT 11.667 731.890 Td (One) Tj ET\n"+
+		"BT 11.667 711.890 Td [(T) 120 (w) 10 (o)] TJ ET\n")
+	failIfHasErrors(t, doc.Errors)
+	//
+	// NextLine() and DrawText() with columns don't know where paragraphs
+	// end, so they only advance by the line height
+	doc.SetXY(10, 300).DrawText("One").NextLine().NextLine()
+	tEqual(t, doc.Y(), 320.0)
+	doc.SetColumnWidths(20, 20).SetXY(0, 400).DrawText("A").DrawText("B")
+	tEqual(t, doc.Y(), 410.0)
+	doc.SetColumnWidths()
+	//
+	// errors
+	doc.SetParagraphSpacing(-1, 0)
+	tEqual(t, doc.PullError(),
+		`Invalid paragraph spacing "-1 0" @SetParagraphSpacing`)
+	doc.SetParagraphSpacing(0, math.Inf(1))
+	tEqual(t, doc.PullError(),
+		`Invalid paragraph spacing "0 +Inf" @SetParagraphSpacing`)
+	before, after = doc.ParagraphSpacing()
+	tEqual(t, []float64{before, after}, []float64{6, 4})
+} //                                                  Test_PDF_ParagraphSpacing_
+
 // Test_PDF_PullError_ is the unit test for PullError() error
 func Test_PDF_PullError_(t *testing.T) {
 	func() {
@@ -2939,6 +3488,89 @@ func Test_PDF_PullError_(t *testing.T) {
 	}()
 } //                                                         Test_PDF_PullError_
 
+// Test_PDF_RegisterFont_ is the unit test for
+// PDF.RegisterFont() and PDF.RegisterFontFamily()
+func Test_PDF_RegisterFont_(t *testing.T) {
+	dir := t.TempDir()
+	boldFile := filepath.Join(dir, "bold.ttf")
+	err := os.WriteFile(boldFile, tTrueTypeFont("BrandBold", "Hi", 700), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ttc := tBuildCollection(tTrueTypeFont("BrandItalic", "Hi"),
+		tTrueTypeFont("BrandBoldItalic", "Hi"))
+	//
+	// register fonts from bytes, a reader, a file, and a collection,
+	// then select them using a font family and styles
+	doc := NewPDF("A4")
+	doc.SetCompression(false).
+		RegisterFont("Brand", tTrueTypeFont("BrandRegular", "Hi", 500)).
+		RegisterFont("Brand Bold", bytes.NewReader(tTrueTypeFont(
+			"BrandBold", "Hi", 700))).
+		RegisterFont("Brand Italic", ttc, "0").
+		RegisterFont("Brand Bold Italic", ttc, "BrandBoldItalic").
+		RegisterFontFamily("Brand Family", "brand", "BRAND-BOLD",
+			"Brand Italic", "Brand Bold Italic")
+	failIfHasErrors(t, doc.Errors)
+	for _, tc := range []struct {
+		name, style, wantFont string
+		wantWidth             float64
+	}{
+		{"Brand", "", "BrandRegular", 10},
+		{"Brand", "B", "BrandRegular", 10}, // not a family: style ignored
+		{"Brand Bold", "", "BrandBold", 14},
+		{"Brand Family", "", "BrandRegular", 10},
+		{"Brand Family", "B", "BrandBold", 14},
+		{"Brand Family", "I", "BrandItalic", 12},
+		{"Brand Family", "BI", "BrandBoldItalic", 12},
+	} {
+		doc.SetFont(tc.name, 10).SetFontStyle(tc.style).DrawText("Hi")
+		f, _ := doc.font.handler.(*pdfTTFont)
+		tEqual(t, f.NAME.PostScriptName, tc.wantFont)
+		tEqual(t, doc.TextWidth("Hi"), tc.wantWidth)
+	}
+	failIfHasErrors(t, doc.Errors)
+	//
+	// each registered font is embedded once
+	tEqual(t, len(doc.fonts), 4)
+	ar := doc.Bytes()
+	tVerifyXref(t, ar)
+	tEqual(t, strings.Count(string(ar), "/Subtype/Type0/"), 4)
+	//
+	// a family can use built-in fonts and font files
+	doc.RegisterFontFamily("Mixed", "Helvetica", boldFile, "", "")
+	doc.SetFont("Mixed", 10).SetFontStyle("").DrawText("Hi")
+	tEqual(t, doc.font.name, "Helvetica")
+	doc.SetFontStyle("BI").DrawText("Hi")
+	tEqual(t, doc.font.name, "Helvetica") // no bold-italic: uses regular
+	doc.SetFontStyle("B").DrawText("Hi")
+	tEqual(t, doc.font.name, boldFile)
+	failIfHasErrors(t, doc.Errors)
+	//
+	// invalid registrations log errors
+	for _, tc := range []struct {
+		register func(doc *PDF)
+		wantErr  string
+	}{
+		{func(doc *PDF) { doc.RegisterFont("", tTrueTypeFont("A", "A")) },
+			`Invalid font alias "" @RegisterFont`},
+		{func(doc *PDF) { doc.RegisterFont("X", filepath.Join(dir, "no.ttf")) },
+			`Invalid font "X" @RegisterFont`},
+		{func(doc *PDF) { doc.RegisterFont("X", ttc, "2") },
+			`Font not in collection "\"2\" of 2 in []byte len(` +
+				strconv.Itoa(len(ttc)) + `)" @RegisterFont`},
+		{func(doc *PDF) { doc.RegisterFontFamily("F", "", "B", "I", "BI") },
+			`Invalid font family "F: " @RegisterFontFamily`},
+	} {
+		doc := NewPDF("A4")
+		tc.register(&doc)
+		tEqual(t, len(doc.Errors()), 1)
+		tEqual(t, doc.PullError(), tc.wantErr)
+		doc.SetFont("X", 10).DrawText("Hi")
+		tEqual(t, doc.PullError(), `Invalid font "X" @DrawText`)
+	}
+} //                                                      Test_PDF_RegisterFont_
+
 // Test_PDF_Reset_ tests PDF.Reset()
 func Test_PDF_Reset_(t *testing.T) {
 	//
@@ -2964,18 +3596,18 @@ func Test_PDF_Reset_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 143>> stream
+		4 0 obj <</Length 167>> stream
 		BT /FNT1 10 Tf ET
 		0.000 0.420 0.235 rg
 		0.000 0.420 0.235 RG
-		BT 0 700 Td (FIRST) Tj ET
-		BT 28 700 Td (SECOND) Tj ET
-		BT 141 700 Td (THIRD) Tj ET
+		BT 0.000 700.157 Td (FIRST) Tj ET
+		BT 28.346 700.157 Td (SECOND) Tj ET
+		BT 141.732 700.157 Td (THIRD) Tj ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Helvetica-Bold
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -2984,11 +3616,11 @@ func Test_PDF_Reset_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000000422 00000 n
+		0000000446 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		528
+		551
 		%%EOF
 		`
 		got := doc.Bytes()
@@ -3074,12 +3706,19 @@ func Test_PDF_SetFont_(t *testing.T) {
 			"ZapfDingbats",
 		} {
 			y := 2.5 + float64(i)*1.8
+			text := "Five hexing wizard bots jump quickly"
+			if font == "Symbol" || font == "ZapfDingbats" {
+				// select the glyphs of the letters' codes
+				text = strings.Map(func(r rune) rune {
+					return 0xF000 + r
+				}, text)
+			}
 			doc.SetXY(1, y).
 				SetFont("Helvetica", 10).
 				DrawText(font).
 				SetXY(1, y+0.7).
 				SetFont(font, 20).
-				DrawText("Five hexing wizard bots jump quickly")
+				DrawText(text)
 
 		}
 		const want = `
@@ -3105,124 +3744,122 @@ func Test_PDF_SetFont_(t *testing.T) {
 		/FNT13 17 0 R
 		/FNT14 18 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 1910>> stream
+		4 0 obj <</Length 2414>> stream
 		BT /FNT1 20 Tf ET
 		0.000 0.000 0.000 rg
 		0.000 0.000 0.000 RG
-		BT 28 813 Td (Built-in PDF Fonts) Tj ET
+		BT 28.346 813.543 Td [(Built-in PDF F) 25 (onts)] TJ ET
 		BT /FNT2 10 Tf ET
-		BT 28 771 Td (Courier) Tj ET
+		BT 28.346 771.024 Td [(Cour) -15 (ier)] TJ ET
 		BT /FNT3 20 Tf ET
-		BT 28 751 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 751.181 Td (Five hexing wizard bots jump quickly) Tj ET
 		BT /FNT2 10 Tf ET
-		BT 28 720 Td (Courier-Bold) Tj ET
+		BT 28.346 720.000 Td [(Cour) -15 (ier-Bold)] TJ ET
 		BT /FNT4 20 Tf ET
-		BT 28 700 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 700.157 Td (Five hexing wizard bots jump quickly) Tj ET
 		BT /FNT2 10 Tf ET
-		BT 28 668 Td (Courier-BoldOblique) Tj ET
+		BT 28.346 668.976 Td [(Cour) -15 (ier-BoldOb) 20 (lique)] TJ ET
 		BT /FNT5 20 Tf ET
-		BT 28 649 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 649.134 Td (Five hexing wizard bots jump quickly) Tj ET
 		BT /FNT2 10 Tf ET
-		BT 28 617 Td (Courier-Oblique) Tj ET
+		BT 28.346 617.953 Td [(Cour) -15 (ier-Ob) 20 (lique)] TJ ET
 		BT /FNT6 20 Tf ET
-		BT 28 598 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 598.110 Td (Five hexing wizard bots jump quickly) Tj ET
 		BT /FNT2 10 Tf ET
-		BT 28 566 Td (Helvetica) Tj ET
+		BT 28.346 566.929 Td [(Helv) 25 (etica)] TJ ET
 		BT /FNT2 20 Tf ET
-		BT 28 547 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 547.087 Td [(Fiv) 25 (e he) 30 (xing wizard bots jump quic) 20 (kly)] TJ ET
 		BT /FNT2 10 Tf ET
-		BT 28 515 Td (Helvetica-Bold) Tj ET
+		BT 28.346 515.906 Td [(Helv) 25 (etica-Bold)] TJ ET
 		BT /FNT7 20 Tf ET
-		BT 28 496 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 496.063 Td [(Five he) 15 (xing wizar) 20 (d bots jump quic) 20 (kl) 15 (y)] TJ ET
 		BT /FNT2 10 Tf ET
-		BT 28 464 Td (Helvetica-BoldOblique) Tj ET
+		BT 28.346 464.882 Td [(Helv) 25 (etica-BoldOb) 20 (lique)] TJ ET
 		BT /FNT8 20 Tf ET
-		BT 28 445 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 445.039 Td [(Five he) 15 (xing wizar) 20 (d bots jump quic) 20 (kl) 15 (y)] TJ ET
 		BT /FNT2 10 Tf ET
-		BT 28 413 Td (Helvetica-Oblique) Tj ET
+		BT 28.346 413.858 Td [(Helv) 25 (etica-Ob) 20 (lique)] TJ ET
 		BT /FNT9 20 Tf ET
-		BT 28 394 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 394.016 Td [(Fiv) 25 (e he) 30 (xing wizard bots jump quic) 20 (kly)] TJ ET
 		BT /FNT2 10 Tf ET
-		BT 28 362 Td (Symbol) Tj ET
+		BT 28.346 362.835 Td (Symbol) Tj ET
 		BT /FNT10 20 Tf ET
-		BT 28 342 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 342.992 Td (Five hexing wizard bots jump quickly) Tj ET
 		BT /FNT2 10 Tf ET
-		BT 28 311 Td (Times-Bold) Tj ET
+		BT 28.346 311.811 Td (Times-Bold) Tj ET
 		BT /FNT1 20 Tf ET
-		BT 28 291 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 291.969 Td [(Fi) 10 (v) 10 (e hexing wizard bots jump quickly)] TJ ET
 		BT /FNT2 10 Tf ET
-		BT 28 260 Td (Times-BoldItalic) Tj ET
+		BT 28.346 260.787 Td (Times-BoldItalic) Tj ET
 		BT /FNT11 20 Tf ET
-		BT 28 240 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 240.945 Td [(F) 40 (iv) 15 (e hexing wizard bots jump quic) 10 (kly)] TJ ET
 		BT /FNT2 10 Tf ET
-		BT 28 209 Td (Times-Italic) Tj ET
+		BT 28.346 209.764 Td (Times-Italic) Tj ET
 		BT /FNT12 20 Tf ET
-		BT 28 189 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 189.921 Td [(F) 45 (ive he) 20 (xing wizar) 37 (d bots jump quic) 20 (kly)] TJ ET
 		BT /FNT2 10 Tf ET
-		BT 28 158 Td (Times-Roman) Tj ET
+		BT 28.346 158.740 Td (Times-Roman) Tj ET
 		BT /FNT13 20 Tf ET
-		BT 28 138 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 138.898 Td [(Fi) 25 (v) 15 (e he) 15 (xing wizard bots jump quickly)] TJ ET
 		BT /FNT2 10 Tf ET
-		BT 28 107 Td (ZapfDingbats) Tj ET
+		BT 28.346 107.717 Td (ZapfDingbats) Tj ET
 		BT /FNT14 20 Tf ET
-		BT 28 87 Td (Five hexing wizard bots jump quickly) Tj ET
+		BT 28.346 87.874 Td (Five hexing wizard bots jump quickly) Tj ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Times-Bold
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		6 0 obj <</Type/Font/Subtype/Type1/Name/FNT2
 		/BaseFont/Helvetica
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		7 0 obj <</Type/Font/Subtype/Type1/Name/FNT3
 		/BaseFont/Courier
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		8 0 obj <</Type/Font/Subtype/Type1/Name/FNT4
 		/BaseFont/Courier-Bold
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		9 0 obj <</Type/Font/Subtype/Type1/Name/FNT5
 		/BaseFont/Courier-BoldOblique
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		10 0 obj <</Type/Font/Subtype/Type1/Name/FNT6
 		/BaseFont/Courier-Oblique
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		11 0 obj <</Type/Font/Subtype/Type1/Name/FNT7
 		/BaseFont/Helvetica-Bold
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		12 0 obj <</Type/Font/Subtype/Type1/Name/FNT8
 		/BaseFont/Helvetica-BoldOblique
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		13 0 obj <</Type/Font/Subtype/Type1/Name/FNT9
 		/BaseFont/Helvetica-Oblique
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		14 0 obj <</Type/Font/Subtype/Type1/Name/FNT10
-		/BaseFont/Symbol
-		/Encoding/StandardEncoding>>
+		/BaseFont/Symbol>>
 		endobj
 		15 0 obj <</Type/Font/Subtype/Type1/Name/FNT11
 		/BaseFont/Times-BoldItalic
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		16 0 obj <</Type/Font/Subtype/Type1/Name/FNT12
 		/BaseFont/Times-Italic
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		17 0 obj <</Type/Font/Subtype/Type1/Name/FNT13
 		/BaseFont/Times-Roman
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		18 0 obj <</Type/Font/Subtype/Type1/Name/FNT14
-		/BaseFont/ZapfDingbats
-		/Encoding/StandardEncoding>>
+		/BaseFont/ZapfDingbats>>
 		endobj
 		xref
 		0 19
@@ -3231,24 +3868,24 @@ func Test_PDF_SetFont_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000399 00000 n
-		0000002361 00000 n
-		0000002463 00000 n
-		0000002564 00000 n
-		0000002663 00000 n
-		0000002767 00000 n
-		0000002878 00000 n
-		0000002986 00000 n
-		0000003093 00000 n
-		0000003207 00000 n
-		0000003317 00000 n
-		0000003417 00000 n
-		0000003527 00000 n
-		0000003633 00000 n
-		0000003738 00000 n
+		0000002865 00000 n
+		0000002966 00000 n
+		0000003066 00000 n
+		0000003164 00000 n
+		0000003267 00000 n
+		0000003377 00000 n
+		0000003484 00000 n
+		0000003590 00000 n
+		0000003703 00000 n
+		0000003812 00000 n
+		0000003885 00000 n
+		0000003994 00000 n
+		0000004099 00000 n
+		0000004203 00000 n
 		trailer
 		<</Size 19/Root 1 0 R>>
 		startxref
-		3844
+		4282
 		%%EOF
 		`
 		pdfCompare(t, doc.Bytes(), want)
@@ -3291,19 +3928,19 @@ func Test_PDF_SetXY_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 197>> stream
+		4 0 obj <</Length 261>> stream
 		BT /FNT1 10 Tf ET
 		0.000 0.000 0.000 rg
 		0.000 0.000 0.000 RG
-		BT 28 756 Td (X=1cm Y=3cm) Tj ET
-		BT 85 813 Td (X=3cm Y=1cm) Tj ET
-		BT 283 700 Td (X=10cm Y=5cm) Tj ET
-		BT 141 558 Td (X=5cm Y=10cm) Tj ET
+		BT 28.346 756.850 Td [(X=1cm ) 90 (Y=3cm)] TJ ET
+		BT 85.039 813.543 Td [(X=3cm ) 90 (Y=1cm)] TJ ET
+		BT 283.465 700.157 Td [(X=10cm ) 90 (Y=5cm)] TJ ET
+		BT 141.732 558.425 Td [(X=5cm ) 90 (Y=10cm)] TJ ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Helvetica
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -3312,11 +3949,11 @@ func Test_PDF_SetXY_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000000476 00000 n
+		0000000540 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		577
+		640
 		%%EOF
 		`
 		pdfCompare(t, doc.Bytes(), want)
@@ -3324,6 +3961,95 @@ func Test_PDF_SetXY_(t *testing.T) {
 	}()
 } //                                                             Test_PDF_SetXY_
 
+// Test_PDF_TextRenderMode_ tests SetTextRenderMode() and the Tr operator
+func Test_PDF_TextRenderMode_(t *testing.T) {
+	var doc PDF // uninitialized PDF
+	tEqual(t, doc.TextRenderMode(), "fill")
+	for _, tc := range []struct{ mode, want string }{
+		{"Stroke", "stroke"}, {"fill+stroke", "fill-stroke"},
+		{" invisible ", "invisible"}, {"fill_stroke_clip", "fill-stroke-clip"},
+	} {
+		tEqual(t, doc.SetTextRenderMode(tc.mode).TextRenderMode(), tc.want)
+	}
+	doc.SetTextRenderMode("outline")
+	tEqual(t, doc.TextRenderMode(), "fill-stroke-clip")
+	tEqual(t, doc.PullError(),
+		`Invalid text render mode "outline" @SetTextRenderMode`)
+	//
+	// the mode is written when it changes, stroked text uses the line width
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		SetLineWidth(0.5).SetTextRenderMode("stroke").
+		SetXY(10, 100).DrawText("A").DrawText("B").
+		SetTextRenderMode("invisible").DrawText("C")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, doc.pages[0].content.String(),
+		"BT /FNT1 10 Tf ET\n"+
+			"BT 1 Tr ET\n"+
+			" 0.000 0.000 0.000 rg\n0.000 0.000 0.000 RG\n0.500 w\n"+
+			"BT 10.000 741.890 Td (A) Tj ET\n"+
+			"BT 16.670 741.890 Td (B) Tj ET\n"+
+			"BT 3 Tr ET\n"+
+			"BT 23.340 741.890 Td (C) Tj ET\n")
+	//
+	// clipping starts with 'q' and ends with 'Q' when the mode is changed
+	// to one that doesn't clip, or at the end of the page
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		SetTextRenderMode("clip").SetXY(10, 100).DrawText("A").
+		SetTextRenderMode("fill").DrawText("B").
+		AddPage().SetTextRenderMode("clip").DrawText("C")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, doc.pages[0].content.String(),
+		"BT /FNT1 10 Tf ET\n"+
+			"q\n"+
+			"BT 7 Tr ET\n"+
+			" 0.000 0.000 0.000 rg\n0.000 0.000 0.000 RG\n"+
+			"BT 10.000 741.890 Td (A) Tj ET\n"+
+			"Q\n"+
+			"BT /FNT1 10 Tf ET\n"+
+			"BT 100 Tz ET\n"+
+			"BT 0 Tr ET\n"+
+			"BT 0.000 Tc ET\nBT 0.000 Tw ET\n"+
+			" 0.000 0.000 0.000 rg\n0.000 0.000 0.000 RG\n1.000 w\n"+
+			"BT 16.670 741.890 Td (B) Tj ET\n")
+	got := string(doc.Bytes())
+	tEqual(t, strings.Count(got, "q\n"), 2)
+	tEqual(t, strings.Count(got, "Q\n"), 2)
+} //                                                    Test_PDF_TextRenderMode_
+
+// Test_PDF_TextWidth_ tests the width of kerned text in built-in fonts
+func Test_PDF_TextWidth_(t *testing.T) {
+	doc := NewPDF("A4")
+	doc.SetUnits("pt")
+	for _, tc := range []struct {
+		font, text string
+		want       float64
+	}{
+		{"Helvetica", "AV", 12.64},         // (667+667-70)/1000 * 10pt
+		{"Helvetica", "AB", 13.34},         // no kerning
+		{"Helvetica-Oblique", "AV", 12.64}, // same kerning as Helvetica
+		{"Helvetica-Bold", "To", 11.42},    // (611+611-80)/1000 * 10pt
+		{"Times-Roman", "AV", 13.09},       // (722+722-135)/1000 * 10pt
+		{"Courier", "AV", 12},              // fixed width: no kerning
+		{"ZapfDingbats", "✓✓", 15.1},       // no kerning
+	} {
+		doc.SetFont(tc.font, 10).DrawText(tc.text) // applies the font
+		tEqual(t, doc.TextWidth(tc.text), tc.want)
+	}
+	failIfHasErrors(t, doc.Errors)
+	//
+	// kerned text is written in a TJ array
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica-Bold", 100).
+		SetXY(10, 100).DrawText("AVATAR").SetXY(10, 200).DrawText("HELLO")
+	failIfHasErrors(t, doc.Errors)
+	got := doc.pages[0].content.String()
+	tEqual(t, strings.Contains(got,
+		"BT 10.000 741.890 Td [(A) 80 (V) 80 (A) 90 (T) 90 (AR)] TJ ET"), true)
+	tEqual(t, strings.Contains(got, "BT 10.000 641.890 Td (HELLO) Tj ET"), true)
+} //                                                         Test_PDF_TextWidth_
+
 // Test_PDF_ToColor_1_ is the unit test for
 // (p *PDF) ToColor(nameOrHTMLColor string) (color.RGBA, error)
 func Test_PDF_ToColor_1_(t *testing.T) {
@@ -3706,6 +4432,52 @@ func Test_PDF_Units_(t *testing.T) {
 	}()
 } //                                                             Test_PDF_Units_
 
+// Test_PDF_WordSpacing_ tests PDF.WordSpacing(), SetWordSpacing()
+// and text justified by DrawTextInBox()
+func Test_PDF_WordSpacing_(t *testing.T) {
+	var doc PDF // uninitialized PDF
+	tEqual(t, doc.WordSpacing(), 0.0)
+	tEqual(t, doc.SetWordSpacing(2).WordSpacing(), 2.0)
+	//
+	// word spacing is added after each space, and written as Tw
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		SetWordSpacing(2).SetXY(10, 100).DrawText("A V")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, floatStr(doc.TextWidth("A V")), "17.620") // 15.62 + 2
+	tEqual(t, strings.Contains(doc.pages[0].content.String(),
+		"BT 2.000 Tw ET\n"), true)
+	//
+	// Tw doesn't apply to TrueType fonts' two-byte codes, so spacing is
+	// written in the TJ array after each space, with character spacing
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").
+		RegisterFont("TestSans", tTrueTypeFont("TestSans", "A B")).
+		SetFont("TestSans", 10).SetCharSpacing(1).SetWordSpacing(2).
+		SetXY(10, 100).DrawText("A B")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, doc.TextWidth("A B"), 23.0) // 3 * 6 + 3 * 1 + 2
+	tEqual(t, strings.Contains(doc.pages[0].content.String(),
+		"BT 10.000 741.890 Td [<00010002>-200.000<0003>] TJ ET\n"), true)
+	//
+	// 'J' stretches the spaces of each line of a paragraph except the
+	// last to fill the box: (100 - 2 * 10/6 - 3 words' width) / 3 spaces
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		DrawTextInBox(0, 100, 100, 100, "JT",
+			"Hello big world of justified text.\nAnd more")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, doc.pages[0].content.String(),
+		"BT /FNT1 10 Tf ET\n"+
+			"BT 6.642 Tw ET\n"+
+			" 0.000 0.000 0.000 rg\n0.000 0.000 0.000 RG\n"+
+			"BT 1.667 731.890 Td [(Hello big w) 10 (or) -15 (ld of)] TJ ET\n"+
+			"BT 0.000 Tw ET\n"+
+			"BT 1.667 721.890 Td [(justified te) 30 (xt.)] TJ ET\n"+
+			"BT 1.667 711.890 Td (And more) Tj ET\n")
+	tEqual(t, doc.WordSpacing(), 0.0)
+} //                                                       Test_PDF_WordSpacing_
+
 // Test_PDF_X_ is the unit test for PDF.X()
 func Test_PDF_X_(t *testing.T) {
 	//
@@ -3748,16 +4520,16 @@ func Test_PDF_X_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 92>> stream
+		4 0 obj <</Length 108>> stream
 		BT /FNT1 20 Tf ET
 		0.000 0.000 0.000 rg
 		0.000 0.000 0.000 RG
-		BT 283 813 Td (X=10 Y=1) Tj ET
+		BT 283.465 813.543 Td [(X=10 ) 55 (Y=1)] TJ ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Times-Bold
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -3766,11 +4538,11 @@ func Test_PDF_X_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000000370 00000 n
+		0000000387 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		472
+		488
 		%%EOF
 		`
 		pdfCompare(t, doc.Bytes(), want)
@@ -3820,16 +4592,16 @@ func Test_PDF_Y_(t *testing.T) {
 		3 0 obj <</Type/Page/Parent 2 0 R/Contents 4 0 R
 		/Resources <</Font <</FNT1 5 0 R>> >> >>
 		endobj
-		4 0 obj <</Length 91>> stream
+		4 0 obj <</Length 107>> stream
 		BT /FNT1 20 Tf ET
 		0.000 0.000 0.000 rg
 		0.000 0.000 0.000 RG
-		BT 28 558 Td (X=1 Y=10) Tj ET
+		BT 28.346 558.425 Td [(X=1 ) 55 (Y=10)] TJ ET
 		endstream
 		endobj
 		5 0 obj <</Type/Font/Subtype/Type1/Name/FNT1
 		/BaseFont/Times-Bold
-		/Encoding/StandardEncoding>>
+		/Encoding/WinAnsiEncoding>>
 		endobj
 		xref
 		0 6
@@ -3838,11 +4610,11 @@ func Test_PDF_Y_(t *testing.T) {
 		0000000056 00000 n
 		0000000130 00000 n
 		0000000228 00000 n
-		0000000369 00000 n
+		0000000386 00000 n
 		trailer
 		<</Size 6/Root 1 0 R>>
 		startxref
-		471
+		487
 		%%EOF
 		`
 		pdfCompare(t, doc.Bytes(), want)
@@ -3853,6 +4625,102 @@ func Test_PDF_Y_(t *testing.T) {
 // -----------------------------------------------------------------------------
 // # Internal Tests
 
+// go test --run Test_builtInCode_
+func Test_builtInCode_(t *testing.T) {
+	var doc PDF
+	for _, tc := range []struct {
+		r    rune
+		code byte
+		ok   bool
+	}{
+		{'A', 65, true}, {'é', 0xE9, true}, {'€', 0x80, true},
+		{'“', 0x93, true}, {'”', 0x94, true}, {'–', 0x96, true},
+		{'•', 0x95, true}, {'Ÿ', 0x9F, true}, {'\u0080', 0, false},
+		{'ł', 0, false}, {'中', 0, false},
+	} {
+		code, ok := doc.builtInCode(&pdfFont{}, tc.r) // Helvetica
+		if code != tc.code || ok != tc.ok {
+			t.Errorf("builtInCode(0, %q) = %d, %v; want %d, %v",
+				tc.r, code, ok, tc.code, tc.ok)
+		}
+	}
+	tEqual(t, doc.builtInText(&pdfFont{}, "“€1–2”"), "\x93\x801\x962\x94")
+	tEqual(t, doc.builtInText(&pdfFont{}, "a中b"), "ab")
+	//
+	// text is written and measured in WinAnsiEncoding
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		SetXY(10, 10).DrawText("€ • é")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, strings.Contains(doc.pages[0].content.String(),
+		"(\x80 \x95 \xE9) Tj"), true)
+	// € 556 + space 278 + • 350 + space 278 + é 556 = 2018
+	tEqual(t, floatStr(doc.TextWidth("€ • é")), "20.180")
+	doc.TextWidth("中")
+	tEqual(t, len(doc.Errors()), 1)
+	//
+	// Symbol and ZapfDingbats translate Unicode characters to their codes
+	tEqual(t, len(pdfSymbolChars), 224)
+	tEqual(t, len(pdfZapfDingbatsChars), 224)
+	symbol, dingbats := pdfFont{builtInIndex: 4}, pdfFont{builtInIndex: 9}
+	tEqual(t, doc.builtInText(&symbol, "αΩ≤∑ 1+1"), "aW\xA3\xE5 1+1")
+	tEqual(t, doc.builtInText(&dingbats, "✓✗★☎➔"), "37H%\xD4")
+	tEqual(t, doc.builtInText(&dingbats, "\uF061\uF0A8"), "a\xA8") // codes
+	//
+	// letters are not drawn as the glyphs of their codes
+	tEqual(t, doc.builtInText(&symbol, "abc 1"), " 1")
+	tEqual(t, doc.builtInText(&dingbats, "Hello"), "")
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("ZapfDingbats", 10).
+		SetXY(10, 10).DrawText("✓ ★")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, strings.Contains(doc.pages[0].content.String(),
+		"(3 H) Tj"), true)
+	tEqual(t, floatStr(doc.TextWidth("✓")), "7.550") // a19: 755
+	doc.TextWidth("中")
+	tEqual(t, doc.PullError().Error(),
+		`Character not in font "at 0 = '中' (U+4E2D) in ZapfDingbats" @TextWidth`)
+	doc.DrawText("Hello")
+	tEqual(t, doc.PullError().Error(),
+		`Character not in font "at 0 = 'H' (U+0048) in ZapfDingbats" @DrawText`)
+} //                                                           Test_builtInCode_
+
+// go test --run Test_builtInRuns_
+func Test_builtInRuns_(t *testing.T) {
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		SetXY(10, 10).DrawText("Łódź ő")
+	failIfHasErrors(t, doc.Errors)
+	//
+	// characters not in WinAnsiEncoding get codes from /Differences
+	tEqual(t, len(doc.fonts), 1)
+	tEqual(t, doc.fonts[0].differences, []rune{'Ł', 'ź', 'ő'})
+	tEqual(t, strings.Contains(doc.pages[0].content.String(),
+		"(\x01\xF3d\x02 \x03) Tj"), true)
+	tEqual(t, strings.Contains(string(doc.Bytes()), "/BaseFont/Helvetica\n"+
+		"/Encoding<</Type/Encoding/BaseEncoding/WinAnsiEncoding"+
+		"/Differences[1/Lslash/zacute/ohungarumlaut]>>"), true)
+	//
+	// widths are those of the glyphs, e.g. ł is as wide as l: 222
+	tEqual(t, floatStr(doc.TextWidth("łl")), "4.440")
+	tEqual(t, floatStr(doc.TextWidth("ď")), "6.430")
+	//
+	// when all free codes are taken, another font resource is added
+	var sb strings.Builder
+	for r := 'Ā'; r <= 'ſ'; r++ {
+		if _, found := pdfBuiltInGlyphs[r]; found {
+			sb.WriteRune(r)
+		}
+	}
+	doc.DrawText(sb.String())
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, len(doc.fonts), 3)
+	tEqual(t, len(doc.fonts[0].differences), len(pdfDifferenceCodes))
+	tEqual(t, doc.fonts[2].name, "Helvetica")
+	tEqual(t, strings.Contains(doc.pages[0].content.String(),
+		"BT /FNT2 10 Tf ET"), true)
+} //                                                           Test_builtInRuns_
+
 // go test --run Test_getPapreSize_
 func Test_getPapreSize_(t *testing.T) {
 	//
@@ -3943,6 +4811,103 @@ func Test_getPapreSize_(t *testing.T) {
 	test("TABLOID", 279, 432, nil)
 } //                                                          Test_getPapreSize_
 
+// Test_kerning_ tests the kerning tables of the built-in fonts,
+// which kerngen generates from Adobe's AFM files
+func Test_kerning_(t *testing.T) {
+	var doc PDF
+	for _, tc := range []struct {
+		builtInIndex int
+		left, right  rune
+		kern         int
+	}{
+		// Helvetica.afm: KPX A V -70, KPX Agrave V -70, KPX T a -120,
+		// KPX T aacute -120, KPX T amacron -60
+		{0, 'A', 'V', -70}, {0, 'À', 'V', -70}, {0, 'T', 'a', -120},
+		{0, 'T', 'á', -120}, {0, 'T', 'ā', -60}, {3, 'T', 'ā', -60},
+		//
+		// Times-Roman.afm: KPX A V -135, KPX Agrave V -135, KPX T a -80,
+		// KPX T aacute -80, KPX T amacron -40
+		{8, 'A', 'V', -135}, {8, 'À', 'V', -135}, {8, 'T', 'a', -80},
+		{8, 'T', 'á', -80}, {8, 'T', 'ā', -40},
+		//
+		// pairs with spaces are kerned, Symbol has no kerning
+		{0, ' ', 'T', -50}, {0, 'V', 'a', -70}, {4, 'A', 'V', 0},
+	} {
+		doc.font = &pdfFont{builtInIndex: tc.builtInIndex}
+		if got := doc.kerning(tc.left, tc.right); got != tc.kern {
+			t.Errorf("kerning(%q, %q) in font %d = %d; want %d",
+				tc.left, tc.right, tc.builtInIndex, got, tc.kern)
+		}
+	}
+	//
+	// every pair is of characters that the font can draw
+	for id, table := range pdfFontKerning {
+		for pair := range table {
+			for _, r := range pair {
+				if _, ok := doc.builtInWidth(id, r); !ok {
+					t.Errorf("kerning pair %q of font %d", pair, id)
+				}
+			}
+		}
+	}
+} //                                                               Test_kerning_
+
+// Test_wrapTotalFit_ tests total-fit line breaking, with justification
+// and hyphenation, and its use by DrawTextInBox() with the 'K' flag
+func Test_wrapTotalFit_(t *testing.T) {
+	const lorem = "Lorem ipsum dolor sit amet, consectetur adipiscing elit," +
+		" sed do eiusmod tempor incididunt ut labore et dolore magna" +
+		" aliqua. Ut enim ad minim veniam, quis nostrud exercitation."
+	doc := NewPDF("A4")
+	doc.SetUnits("pt").SetFont("Helvetica", 10).DrawText("")
+	doc.applyFont()
+	//
+	// greedy wrapping leaves a short line that justification stretches
+	tEqual(t, doc.WrapTextLines(150, lorem), []string{
+		"Lorem ipsum dolor sit amet, ", "consectetur adipiscing elit, sed ",
+		"do eiusmod tempor incididunt ut ",
+		"labore et dolore magna aliqua. Ut ",
+		"enim ad minim veniam, quis ", "nostrud exercitation."})
+	tEqual(t, doc.wrapTotalFit(150, lorem, true), []string{
+		"Lorem ipsum dolor sit amet, ", "consectetur adipiscing elit, sed ",
+		"do eiusmod tempor incididunt ut ", "labore et dolore magna aliqua. ",
+		"Ut enim ad minim veniam, quis ", "nostrud exercitation."})
+	//
+	// a line is hyphenated only if that makes the paragraph better
+	doc.RegisterHyphenation("en", []byte(tHyphenPatterns())).
+		SetHyphenation("en")
+	text := "A concatenation of hyphenation tables"
+	tEqual(t, doc.WrapTextLines(100, text),
+		[]string{"A concatenation of hy-", "phenation tables"})
+	tEqual(t, doc.wrapTotalFit(100, text, false),
+		[]string{"A concatenation of ", "hyphenation tables"})
+	tEqual(t, doc.wrapTotalFit(40, text, false), []string{
+		"A con-", "catena-", "tion of ", "hyphen-", "ation ", "tables"})
+	failIfHasErrors(t, doc.Errors)
+	//
+	// paragraphs that fit or can't be broken are wrapped as usual
+	tEqual(t, doc.wrapTotalFit(100, "One\ntwo", true),
+		[]string{"One", "two"})
+	tEqual(t, doc.wrapTotalFit(20, "Concatenation", true),
+		doc.WrapTextLines(20, "Concatenation"))
+	//
+	// DrawTextInBox() uses total-fit breaking with the 'K' flag
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10).
+		DrawTextInBox(10, 10, 150, 100, "TJK", lorem)
+	failIfHasErrors(t, doc.Errors)
+	got := doc.pages[0].content.String()
+	for _, want := range []string{
+		"BT 2.899 Tw ET\n" +
+			"BT 11.667 791.890 Td (labore et dolore magna aliqua.) Tj ET",
+		"BT 11.667 781.890 Td [(Ut enim ad minim v) 25 (eniam, quis)] TJ ET",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("page content does not contain %q", want)
+		}
+	}
+} //                                                          Test_wrapTotalFit_
+
 // -----------------------------------------------------------------------------
 // # Helper Functions
 
@@ -3,9 +3,9 @@
 // (c) balarabe@protonmail.com                                      License: MIT
 // -----------------------------------------------------------------------------
 
-// THIS FILE IS A WORK IN PROGRESS
-
 // This file contains a TTF font parser and PDF font-related functionality.
+// It reads TrueType fonts and OpenType fonts with CFF or CFF2 outlines,
+// including fonts in WOFF (web font) files.
 // It augments PDF in pdf_core.go to support Unicode and font embedding,
 // but is not required for basic PDF functionality.
 
@@ -13,37 +13,168 @@
 //   init()
 //
 // # pdfFontHandler Interface (f *pdfTTFont)
-//   readFont(owner *PDF, font interface{}) bool
+//   readFont(owner *PDF, font interface{}, optFace ...string) bool
 //   textWidthPt(s string) float64
-//   writeText(s string)
+//   hasGlyph(r rune) bool
+//   fontMetrics() pdfFontMetrics
+//   writeText(s string, rtl bool)
 //   writeFontObjects(font *pdfFont)
+//
+// # TTF Parsing Methods (f *pdfTTFont)
+//   readTTF(reader io.Reader)
+//   readWOFF(data []byte) []byte
+//   readTableDir(rd *bytes.Reader, offset uint32)
+//   readHEAD(rd *bytes.Reader)
+//   readHHEA(rd *bytes.Reader)
+//   readMAXP(rd *bytes.Reader)
+//   readHMTX(rd *bytes.Reader)
+//   readCMAP(rd *bytes.Reader)
+//   readNAME(rd *bytes.Reader)
+//   readOS2(rd *bytes.Reader)
+//   readPOST(rd *bytes.Reader)
+//   readLOCA(rd *bytes.Reader)
+//
+// # CFF Parsing Methods (f *pdfTTFont)
+//   readCFF(rd *bytes.Reader)
+//   readCFFDict(data []byte) []pdfCFFOp
+//   readCFFIndex(rd *bytes.Reader) (items [][]byte, raw []byte)
+//   readCFFPrivate(table []byte, op pdfCFFOp) pdfCFFPrivate
+//   readCFFRaw(rd *bytes.Reader, offset int, lengthOf func() int) []byte
+//
+// # OpenType Layout Methods (f *pdfTTFont)
+//   applyLookup(lookup pdfGSUBLookup, val int, form string,
+//       glyphs []pdfGlyph) []pdfGlyph
+//   kerning(left, right uint16) int
+//   readClassDef(rd *bytes.Reader, offset int64) map[uint16]uint16
+//   readCoverage(rd *bytes.Reader, offset int64) []uint16
+//   readFeatureList(rd *bytes.Reader, offset int64) map[string][]int
+//   readGPOS(rd *bytes.Reader)
+//   readGSUB(rd *bytes.Reader)
+//   readKERN(rd *bytes.Reader)
+//   readLookup(rd *bytes.Reader, offset int64, index int,
+//       extension uint16) (kind uint16, subtables []int64)
+//   readPairPos(rd *bytes.Reader, offset int64) pdfKernSubtable
+//   readSubst(rd *bytes.Reader, offset int64, kind uint16) pdfGSUBSubtable
+//   shape(s string) []pdfGlyph
+//   substitute(glyphs []pdfGlyph) []pdfGlyph
+//
+// # Font Embedding Methods (f *pdfTTFont)
+//   pdfVersion() string
+//   subsetCFF(glyphs []uint16) []byte
+//   subsetFont(glyphs []uint16) []byte
+//   subsetGlyf(glyphs []uint16) (glyf, loca []byte)
+//   subsetName(glyphs []uint16) string
+//   toUnicodeCMap(glyphs []uint16) []byte
+//   usedGlyphs() []uint16
+//   widthsArray(glyphs []uint16) string
+//
+// # Helper Methods (f *pdfTTFont)
+//   glyph(r rune) (glyph uint16, found bool)
+//   glyphData(glyph uint16) []byte
+//   pos(rd *bytes.Reader) int64
+//   read(rd *bytes.Reader, size int, useData ...bool) []byte
+//   readI16(rd *bytes.Reader) int16
+//   readUI16(rd *bytes.Reader) uint16
+//   readUI16Array(rd *bytes.Reader) []uint16
+//   readUI32(rd *bytes.Reader) uint32
+//   seek(rd *bytes.Reader, offset int64)
+//   seekTable(rd *bytes.Reader, tag string, required bool) bool
+//   table(tag string) []byte
+//   unitsToPt1000(units int) int
+//
+// # Functions
+//   cffDict(ops []pdfCFFOp, offsets map[int][]int) []byte
+//   cffIndex(items [][]byte, cff2 bool) []byte
+//   ttfChecksum(data []byte) uint32
+//   ttfJoiningType(r rune) byte
+//   ttfPackTables(version []byte, tables map[string][]byte) []byte
 
 package pdf
 
 import (
 	"bytes"
+	"compress/zlib"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
 )
 
+// pdfCFFOp is an operator in a CFF DICT, with its operands
+type pdfCFFOp struct {
+	Op   int    // 0 to 27, or 1200 + n for two-byte operators (12 n)
+	Args []int  // operands as integers (real numbers are zero)
+	Raw  []byte // operands as encoded in the font
+}
+
+// pdfCFFPrivate is a CFF Private DICT with its local subroutines
+type pdfCFFPrivate struct {
+	Dict  []pdfCFFOp
+	Subrs []byte // Local Subr INDEX (nil if none)
+}
+
+// pdfGlyph is a glyph to write, with the text it represents
+type pdfGlyph struct {
+	ID   uint16 // glyph index
+	Text string // characters represented by the glyph (can be empty)
+	Form string // Arabic joining form: "isol", "init", "medi", "fina" or ""
+}
+
+// pdfGSUBLookup is a GSUB lookup that substitutes glyphs
+type pdfGSUBLookup struct {
+	Kind      int // 1: single, 2: multiple, 3: alternate, 4: ligature
+	Subtables []pdfGSUBSubtable
+}
+
+// pdfGSUBSubtable is a subtable of a GSUB lookup
+type pdfGSUBSubtable struct {
+	Glyphs    map[uint16][]uint16      // single, multiple and alternate
+	Ligatures map[uint16][]pdfLigature // ligatures starting with each glyph
+}
+
+// pdfKernSubtable is a GPOS pair adjustment subtable (PairPos format 1 or 2)
+type pdfKernSubtable struct {
+	Coverage map[uint16]bool   // first glyphs of the pairs adjusted
+	Pairs    map[uint32]int16  // format 1: adjustment of each glyph pair
+	Class1   map[uint16]uint16 // format 2: class of each first glyph
+	Class2   map[uint16]uint16 // format 2: class of each second glyph
+	Values   [][]int16         // format 2: adjustment of each class pair
+}
+
+// pdfLigature is a glyph that replaces a sequence of glyphs
+type pdfLigature struct {
+	Glyph      uint16   // the ligature glyph
+	Components []uint16 // glyphs following the first glyph of the sequence
+}
+
 // pdfTTFont __
 type pdfTTFont struct {
 	Name string
+	Face string // font in a collection: index or PostScript name
 	Data []byte
 	Err  error
 	HEAD struct { //              font header table: general info about the font
-		UnitsPerEm uint16
-		XMin       int16
-		YMin       int16
-		XMax       int16
-		YMax       int16
+		UnitsPerEm       uint16
+		XMin             int16
+		YMin             int16
+		XMax             int16
+		YMax             int16
+		IndexToLocFormat int16
 	}
 	HHEA struct { //     horizontal header: layout of horizontally-written fonts
 		HMetricCount uint16
 		Ascent       int16
 		Descent      int16
+		LineGap      int16
 	}
 	MAXP struct { //        maximum profile table: specifies memory requirements
 		NumGlyphs uint16
@@ -59,33 +190,73 @@ type pdfTTFont struct {
 	}
 	OS2 struct { //                                     OS/2 compatibility table
 		Version        uint16
+		UsWeightClass  uint16
+		FsType         uint16
 		STypoAscender  int16
 		STypoDescender int16
 		STypoLineGap   int16
+		SxHeight       int16
+		SCapHeight     int16
 	}
 	POST struct { //                        glyph name and PostScript font table
-		ItalicAngle int16
+		ItalicAngle       int16
+		UnderlinePosition int16
+		IsFixedPitch      uint32
 	}
 	LOCA []uint32 //                                   glyph data location table
-	pdf  *PDF
+	CFF  struct { //         compact font format table: PostScript glyph outlines
+		Version     int             // 1: 'CFF ', 2: 'CFF2', 0: TrueType font
+		Names       []byte          // Name INDEX (CFF only)
+		TopDict     []pdfCFFOp      // Top DICT: font-wide values and offsets
+		Strings     []byte          // String INDEX (CFF only)
+		GlobalSubrs []byte          // Global Subr INDEX
+		CharStrings [][]byte        // outline of each glyph
+		Charset     []byte          // custom charset (nil if predefined)
+		FDSelect    []byte          // font DICT of each glyph (if any)
+		FDArray     [][]pdfCFFOp    // font DICTs (CID-keyed or CFF2 fonts)
+		Privates    []pdfCFFPrivate // Private DICT of font or each font DICT
+		VarStore    []byte          // item variation store (CFF2 only)
+	}
+	KERN struct { //           kerning: adjustment of spacing between glyphs
+		Pairs   map[uint32]int16    // 'kern' table: first<<16 | second glyph
+		Lookups [][]pdfKernSubtable // GPOS lookups of the 'kern' feature
+	}
+	GSUB struct { //                glyph substitution: ligatures, alternates etc.
+		Features map[string][]int // lookups used by each feature
+		Lookups  []pdfGSUBLookup  // all lookups (unused ones are empty)
+	}
+	//
+	Tables map[string]pdfTTFTable // table directory: location of each table
+	Used   map[uint16]string      // glyphs used in the PDF: text of each glyph
+	pdf    *PDF
 } //                                                                   pdfTTFont
 
+// pdfTTFTable specifies the location of a table in the font's data
+type pdfTTFTable struct {
+	Offset uint32 // offset of the table from the start of the font file
+	Length uint32 // length of the table in bytes
+} //                                                                 pdfTTFTable
+
 // -----------------------------------------------------------------------------
 // # Module Initialization
 
 // init __
 func init() {
-	// TODO: uncomment this line when font handler is implemented
-	// pdfNewFontHandler = func() pdfFontHandler { return &pdfTTFont{} }
+	pdfNewFontHandler = func() pdfFontHandler { return &pdfTTFont{} }
 } //                                                                        init
 
 // -----------------------------------------------------------------------------
 // # pdfFontHandler Interface (f *pdfTTFont)
 
-// readFont loads a font from a file name, slice of bytes, or io.Reader
-func (f *pdfTTFont) readFont(owner *PDF, font interface{}) bool {
+// readFont loads a font from a file name, slice of bytes, or io.Reader.
+// 'optFace' selects a font in a collection by index or PostScript name.
+func (f *pdfTTFont) readFont(owner *PDF, font interface{},
+	optFace ...string) bool {
 	f.Err = nil
 	f.pdf = owner
+	if len(optFace) > 0 {
+		f.Face = optFace[0]
+	}
 	var (
 		src string
 		rd  io.Reader
@@ -93,8 +264,17 @@ func (f *pdfTTFont) readFont(owner *PDF, font interface{}) bool {
 	switch arg := font.(type) {
 	case string:
 		{
+			// a font in a collection can follow '#', e.g. "fonts.ttc#2"
 			src = arg
+			if i := strings.LastIndex(arg, "#"); i != -1 && f.Face == "" {
+				if _, err := os.Stat(arg); err != nil {
+					arg, f.Face = arg[:i], arg[i+1:]
+				}
+			}
 			data, err := os.ReadFile(arg)
+			if errors.Is(err, fs.ErrNotExist) {
+				return false // not a font file: caller logs 'Invalid font'
+			}
 			if err != nil {
 				f.pdf.putError(0xE5445B, "Failed reading font file", src)
 				return false
@@ -116,7 +296,13 @@ func (f *pdfTTFont) readFont(owner *PDF, font interface{}) bool {
 			reflect.TypeOf(font).String())
 		return false
 	}
-	_ = rd // TODO: remove when reader is used
+	f.Name = src
+	f.readTTF(rd)
+	if err, isT := f.Err.(pdfError); isT {
+		f.pdf.putError(0xE2257E, err.msg, err.val+" in "+src)
+	} else if f.Err != nil {
+		f.pdf.putError(0xE2257E, f.Err.Error(), src)
+	}
 	return f.Err == nil
 } //                                                                    readFont
 
@@ -124,47 +310,182 @@ func (f *pdfTTFont) readFont(owner *PDF, font interface{}) bool {
 func (f *pdfTTFont) textWidthPt(s string) float64 {
 	f.Err = nil
 	var ret float64
-	for _, r := range s {
-		_ = r
-		glyph, found := 0, false // TODO: find out glyph entry
-		if !found {
-			// TODO: 0xE0074A: error
-			continue
+	prev := -1 // previous glyph, for kerning
+	// missing glyphs use .notdef's width
+	for _, glyph := range f.shape(s) {
+		w := float64(f.HMTX.Widths[glyph.ID])
+		if prev != -1 {
+			w += float64(f.kerning(uint16(prev), glyph.ID))
 		}
-		w := float64(f.HMTX.Widths[glyph])
+		prev = int(glyph.ID)
 		if f.HEAD.UnitsPerEm != 1000 {
 			w = w * 1000.0 / float64(f.HEAD.UnitsPerEm)
 		}
 		ret += w / 1000.0 * f.pdf.fontSizePt
 	}
-	return ret
+	return ret * float64(f.pdf.horzScaling) / 100.0
 } //                                                                 textWidthPt
 
-// writeText encodes text in the string 's'
-func (f *pdfTTFont) writeText(s string) {
+// hasGlyph returns true if the font has a glyph for the character 'r'
+func (f *pdfTTFont) hasGlyph(r rune) bool {
+	glyph, found := f.glyph(r)
+	return found && glyph != 0
+} //                                                                    hasGlyph
+
+// fontMetrics returns the vertical metrics of the font, in thousandths
+// of the font size. The typographic metrics of the OS/2 table are used
+// if the font has them, otherwise the metrics of the horizontal header.
+func (f *pdfTTFont) fontMetrics() pdfFontMetrics {
+	ascent, descent, lineGap := int(f.HHEA.Ascent), int(f.HHEA.Descent),
+		int(f.HHEA.LineGap)
+	if f.OS2.STypoAscender != 0 {
+		ascent, descent, lineGap = int(f.OS2.STypoAscender),
+			int(f.OS2.STypoDescender), int(f.OS2.STypoLineGap)
+	}
+	capHeight := int(f.OS2.SCapHeight)
+	if capHeight == 0 {
+		capHeight = int(f.HHEA.Ascent)
+	}
+	u := f.unitsToPt1000
+	return pdfFontMetrics{
+		ascent:    u(ascent),
+		descent:   u(descent),
+		leading:   u(ascent - descent + lineGap),
+		capHeight: u(capHeight),
+		xHeight:   u(int(f.OS2.SxHeight)),
+		underline: u(int(f.POST.UnderlinePosition)),
+	}
+} //                                                                 fontMetrics
+
+// writeText encodes text in the string 's'. If 'rtl' is true, the text
+// is shaped in logical order, then its glyphs are written in reverse.
+func (f *pdfTTFont) writeText(s string, rtl bool) {
 	f.Err = nil
 	f.pdf.write("BT ", f.pdf.page.x, " ", f.pdf.page.y, " Td ")
-	//
-	// TODO: add each rune of s, to determine glyphs to embed
-	//
-	// write hex encoded text to PDF
+	if f.Used == nil {
+		f.Used = make(map[uint16]string)
+	}
+	// write hex encoded text to PDF, noting glyphs to embed.
+	// kerning is written between strings in the TJ array, in thousandths
+	// of text space units, where positive numbers move glyphs closer
 	f.pdf.write("[<")
-	for _, r := range s {
-		_ = r
-		glyph, found := 0, false // TODO: find out glyph entry
-		if !found {
-			// TODO: 0xE1DC96: error
-			return
+	for i, r := range s {
+		if _, found := f.glyph(r); !found {
+			f.pdf.putError(0xE1DC96, "Glyph not in font",
+				fmt.Sprintf("at %d = '%s'", i, string(r)))
+		}
+	}
+	// glyph substitution can replace several characters with one glyph
+	// (a ligature), so the ToUnicode CMap maps each glyph to its text
+	glyphs := f.shape(s)
+	kerns := make([]int, len(glyphs)+1) // kerning before each glyph
+	for i := 1; i < len(glyphs); i++ {
+		kerns[i] = f.kerning(glyphs[i-1].ID, glyphs[i].ID)
+	}
+	// word spacing (Tw) only applies to single-byte codes, so it is also
+	// written in the TJ array, after each space
+	space, prev := f.pdf.wordSpacing*1000/f.pdf.fontSizePt, ""
+	for n := range glyphs {
+		i, kern := n, kerns[n]
+		if rtl {
+			i = len(glyphs) - 1 - n
+			kern = kerns[i+1]
+		}
+		glyph := glyphs[i]
+		adjust := -float64(kern) * 1000 / float64(f.HEAD.UnitsPerEm)
+		if prev == " " {
+			adjust -= space
+		}
+		if n > 0 && adjust != 0 {
+			f.pdf.write(">", adjust, "<")
+		}
+		prev = glyph.Text
+		if glyph.ID != 0 && f.Used[glyph.ID] == "" {
+			f.Used[glyph.ID] = glyph.Text
 		}
-		f.pdf.write(fmt.Sprintf("%04X", glyph))
+		f.pdf.write(fmt.Sprintf("%04X", glyph.ID))
 	}
 	f.pdf.write(">] TJ ET\n")
 } //                                                                   writeText
 
-// writeFontObjects writes the PDF objects that define the embedded font
+// writeFontObjects writes the PDF objects that define the embedded font:
+// a Type0 font with Identity-H encoding, its CIDFontType2 (or CIDFontType0
+// for CFF outlines) descendant font, which specifies glyph widths, the font
+// descriptor, the subset font program and a ToUnicode CMap, which allows
+// text to be extracted.
+// Character IDs (CIDs) in the PDF are the same as the glyph indices.
 func (f *pdfTTFont) writeFontObjects(font *pdfFont) {
 	f.Err = nil
-	// TODO: write font-related objects here (call writer methods)
+	var (
+		p        = f.pdf
+		glyphs   = f.usedGlyphs()
+		name     = f.subsetName(glyphs)
+		cidObj   = p.reserveObj()
+		descrObj = p.reserveObj()
+		cmapObj  = p.reserveObj()
+		fileObj  int
+		program  []byte
+	)
+	if f.OS2.FsType&0x000F == 0x0002 { // restricted license embedding
+		p.putError(0xEA5E3C, "Font license does not allow embedding",
+			f.NAME.PostScriptName)
+	} else {
+		fileObj, program = p.reserveObj(), f.subsetFont(glyphs)
+	}
+	p.writeObj("/Font").write("/Subtype/Type0/BaseFont/", name, "\n",
+		"/Encoding/Identity-H/DescendantFonts[", cidObj, " 0 R]\n",
+		"/ToUnicode ", cmapObj, " 0 R>>\n"+"endobj\n\n")
+	// CIDFontType2 has TrueType outlines, CIDFontType0 has CFF outlines
+	subtype, cidToGID, fontFile := "CIDFontType2", "/CIDToGIDMap/Identity",
+		"/FontFile2 "
+	if f.CFF.Version != 0 {
+		subtype, cidToGID, fontFile = "CIDFontType0", "", "/FontFile3 "
+	}
+	p.writeObj("/Font", cidObj).write("/Subtype/", subtype, "/BaseFont/",
+		name, "\n"+
+			"/CIDSystemInfo <</Registry(Adobe)/Ordering(Identity)"+
+			"/Supplement 0>>\n"+
+			"/FontDescriptor ", descrObj, " 0 R", cidToGID, "\n",
+		"/W[", f.widthsArray(glyphs), "]>>\n"+"endobj\n\n")
+	//
+	// font descriptor: flags 1=fixed pitch, 4=symbolic, 64=italic
+	flags := 4
+	if f.POST.IsFixedPitch != 0 {
+		flags |= 1
+	}
+	if f.POST.ItalicAngle != 0 {
+		flags |= 64
+	}
+	capHeight := f.OS2.SCapHeight
+	if capHeight == 0 {
+		capHeight = f.HHEA.Ascent
+	}
+	stemV := 50 + int(f.OS2.UsWeightClass)*int(f.OS2.UsWeightClass)/65/65
+	u := f.unitsToPt1000
+	p.writeObj("/FontDescriptor", descrObj).
+		write("/FontName/", name, "/Flags ", flags, "\n",
+			"/FontBBox[", u(int(f.HEAD.XMin)), " ", u(int(f.HEAD.YMin)), " ",
+			u(int(f.HEAD.XMax)), " ", u(int(f.HEAD.YMax)), "]",
+			"/ItalicAngle ", f.POST.ItalicAngle, "\n",
+			"/Ascent ", u(int(f.HHEA.Ascent)),
+			"/Descent ", u(int(f.HHEA.Descent)),
+			"/CapHeight ", u(int(capHeight)), "/StemV ", stemV)
+	if fileObj != 0 {
+		p.write("\n", fontFile, fileObj, " 0 R")
+	}
+	p.write(">>\n" + "endobj\n\n")
+	//
+	// ToUnicode CMap and the font program
+	p.writeStreamObj(f.toUnicodeCMap(glyphs), cmapObj)
+	if fileObj != 0 {
+		p.write(p.nextObj(fileObj), " 0 obj <<")
+		if f.CFF.Version == 0 {
+			p.write("/Length1 ", len(program))
+		} else {
+			p.write("/Subtype/OpenType")
+		}
+		p.writeStreamData(program).write("\n" + "endobj\n\n")
+	}
 	if f.Err != nil {
 		f.pdf.putError(0xED2CDF, f.Err.Error(), "")
 	}
@@ -173,7 +494,10 @@ func (f *pdfTTFont) writeFontObjects(font *pdfFont) {
 // -----------------------------------------------------------------------------
 // # TTF Parsing Methods (f *pdfTTFont)
 
-// readTTF __
+// readTTF reads a TrueType or OpenType font, or one font from a
+// collection (.ttc file). f.Face selects the font in the collection
+// by index (from 0) or PostScript name. The default is the first.
+// WOFF fonts are converted to sfnt fonts, then read the same way.
 func (f *pdfTTFont) readTTF(reader io.Reader) {
 	if f.Err != nil {
 		return
@@ -184,94 +508,1676 @@ func (f *pdfTTFont) readTTF(reader io.Reader) {
 		f.Err = err
 		return
 	}
+	if bytes.HasPrefix(f.Data, []byte("wOFF")) {
+		if f.Data = f.readWOFF(f.Data); f.Err != nil {
+			return
+		}
+	}
 	rd := bytes.NewReader(f.Data)
-	ver := f.read(rd, 4)
-	if !bytes.Equal(ver, []byte{0, 1, 0, 0}) {
-		// TODO: 0xE0E9AE: error
-		return
+	offsets := []uint32{0} // offset of each font's table directory
+	if ver := f.read(rd, 4); f.Err == nil && string(ver) == "ttcf" {
+		f.read(rd, 4, false) // majorVersion, minorVersion
+		count := int(f.readUI32(rd))
+		if f.Err == nil && (count == 0 || count > rd.Len()/4) {
+			f.Err = pdfError{id: 0xE5B7E1, msg: "Invalid font collection",
+				val: fmt.Sprintf("%d fonts", count)}
+			return
+		}
+		offsets = make([]uint32, count)
+		for i := range offsets {
+			offsets[i] = f.readUI32(rd)
+		}
+	}
+	index, err := strconv.Atoi(f.Face)
+	switch {
+	case f.Face == "":
+		f.readTableDir(rd, offsets[0])
+	case err == nil && index >= 0 && index < len(offsets):
+		f.readTableDir(rd, offsets[index])
+	default: // find the font by its PostScript name
+		found := false
+		for _, offset := range offsets {
+			f.NAME.PostScriptName = ""
+			f.readTableDir(rd, offset)
+			f.readNAME(rd)
+			if f.Err != nil || f.NAME.PostScriptName == f.Face {
+				found = true
+				break
+			}
+		}
+		if !found {
+			f.Err = pdfError{id: 0xE84F1D, msg: "Font not in collection",
+				val: fmt.Sprintf("%q of %d", f.Face, len(offsets))}
+		}
 	}
 	for _, fn := range []func(*bytes.Reader){
 		f.readHEAD, f.readHHEA, f.readMAXP, f.readHMTX, f.readCMAP,
-		f.readNAME, f.readOS2, f.readPOST, f.readLOCA} {
+		f.readNAME, f.readOS2, f.readPOST, f.readLOCA, f.readCFF,
+		f.readKERN, f.readGPOS, f.readGSUB} {
 		if f.Err != nil {
-			/// 0xE2257E: log error
-			break
+			break // error is logged by readFont()
 		}
 		fn(rd)
 	}
 } //                                                                     readTTF
 
-// readHEAD __
-func (f *pdfTTFont) readHEAD(rd *bytes.Reader) {
+// readWOFF returns the sfnt font (i.e. TTF or OTF file) in WOFF font data.
+// A WOFF font has a 44-byte header, followed by a directory of the tables,
+// with 20 bytes for each table, and the tables. Each table is compressed
+// with zlib, unless compression would not make it smaller.
+func (f *pdfTTFont) readWOFF(data []byte) []byte {
+	rd := bytes.NewReader(data)
+	f.read(rd, 4, false)    // signature 'wOFF'
+	flavor := f.read(rd, 4) // sfnt version
+	f.read(rd, 4, false)    // length
+	count := int(f.readUI16(rd))
+	f.read(rd, 30, false) // reserved, totalSfntSize, versions, metadata...
+	if f.Err == nil && (count == 0 || count > rd.Len()/20) {
+		f.Err = pdfError{id: 0xE3A8F5, msg: "Invalid WOFF font",
+			val: fmt.Sprintf("%d tables", count)}
+	}
+	tables := make(map[string][]byte, count)
+	for i := 0; i < count && f.Err == nil; i++ {
+		tag := string(f.read(rd, 4))
+		offset, compLength := f.readUI32(rd), f.readUI32(rd)
+		origLength := f.readUI32(rd)
+		f.readUI32(rd) // origChecksum: ttfPackTables() calculates it
+		if f.Err != nil {
+			break
+		}
+		if uint64(offset)+uint64(compLength) > uint64(len(data)) ||
+			compLength > origLength {
+			f.Err = pdfError{id: 0xE3A8F5, msg: "Invalid WOFF font",
+				val: fmt.Sprintf("table %q", tag)}
+			break
+		}
+		table := data[offset : offset+compLength]
+		if compLength < origLength {
+			zr, err := zlib.NewReader(bytes.NewReader(table))
+			if err == nil {
+				table, err = io.ReadAll(io.LimitReader(zr, int64(origLength)))
+			}
+			if err != nil || len(table) != int(origLength) {
+				f.Err = pdfError{id: 0xE9C14B, msg: "Failed decompressing",
+					val: fmt.Sprintf("WOFF table %q", tag)}
+				break
+			}
+		}
+		tables[tag] = table
+	}
 	if f.Err != nil {
+		return nil
+	}
+	return ttfPackTables(flavor, tables)
+} //                                                                    readWOFF
+
+// readTableDir reads the table directory of the font at 'offset', i.e.
+// the location of each table. The offset is 0, except in collections.
+func (f *pdfTTFont) readTableDir(rd *bytes.Reader, offset uint32) {
+	f.seek(rd, int64(offset))
+	ver := f.read(rd, 4)
+	if f.Err == nil && !bytes.Equal(ver, []byte{0, 1, 0, 0}) &&
+		!bytes.Equal(ver, []byte("true")) && !bytes.Equal(ver, []byte("OTTO")) {
+		f.Err = pdfError{id: 0xE0E9AE, msg: "Unsupported font format",
+			val: fmt.Sprintf("%q", ver)}
 		return
 	}
-	// TODO: implement
+	count := f.readUI16(rd)
+	f.read(rd, 6, false) // searchRange, entrySelector, rangeShift
+	f.Tables = make(map[string]pdfTTFTable, count)
+	for i := 0; i < int(count) && f.Err == nil; i++ {
+		tag := string(f.read(rd, 4))
+		f.read(rd, 4, false) // checksum
+		offset, length := f.readUI32(rd), f.readUI32(rd)
+		if int(offset)+int(length) > len(f.Data) {
+			f.Err = pdfError{id: 0xE6F0C3, msg: "Table out of range", val: tag}
+			return
+		}
+		f.Tables[tag] = pdfTTFTable{Offset: offset, Length: length}
+	}
+} //                                                                readTableDir
+
+// readHEAD reads the font header table, which specifies the units-per-em,
+// bounding box of all glyphs and the format of the glyph location table
+func (f *pdfTTFont) readHEAD(rd *bytes.Reader) {
+	if !f.seekTable(rd, "head", true) {
+		return
+	}
+	// skip version, fontRevision, checkSumAdjustment, magicNumber, flags
+	f.read(rd, 18, false)
+	f.HEAD.UnitsPerEm = f.readUI16(rd)
+	f.read(rd, 16, false) // created, modified
+	f.HEAD.XMin = f.readI16(rd)
+	f.HEAD.YMin = f.readI16(rd)
+	f.HEAD.XMax = f.readI16(rd)
+	f.HEAD.YMax = f.readI16(rd)
+	f.read(rd, 6, false) // macStyle, lowestRecPPEM, fontDirectionHint
+	f.HEAD.IndexToLocFormat = f.readI16(rd)
+	if f.Err == nil && f.HEAD.UnitsPerEm == 0 {
+		f.Err = pdfError{id: 0xE7A3D1, msg: "Invalid unitsPerEm", val: "head"}
+	}
 } //                                                                    readHEAD
 
-// readHHEA __
+// readHHEA reads the horizontal header table: the ascent, descent
+// and the number of entries in the horizontal metrics table
 func (f *pdfTTFont) readHHEA(rd *bytes.Reader) {
-	if f.Err != nil {
+	if !f.seekTable(rd, "hhea", true) {
 		return
 	}
-	// TODO: implement
+	f.read(rd, 4, false) // version
+	f.HHEA.Ascent = f.readI16(rd)
+	f.HHEA.Descent = f.readI16(rd)
+	f.HHEA.LineGap = f.readI16(rd)
+	// skip advanceWidthMax, minLeftSideBearing, minRightSideBearing,
+	// xMaxExtent, caretSlopeRise, caretSlopeRun, caretOffset, 4 reserved,
+	// and metricDataFormat
+	f.read(rd, 24, false)
+	f.HHEA.HMetricCount = f.readUI16(rd)
 } //                                                                    readHHEA
 
-// readMAXP __
+// readMAXP reads the number of glyphs from the maximum profile table
 func (f *pdfTTFont) readMAXP(rd *bytes.Reader) {
-	if f.Err != nil {
+	if !f.seekTable(rd, "maxp", true) {
 		return
 	}
-	// TODO: implement
+	f.read(rd, 4, false) // version
+	f.MAXP.NumGlyphs = f.readUI16(rd)
 } //                                                                    readMAXP
 
-// readHMTX __
+// readHMTX reads the advance width of every glyph. Glyphs after the
+// last full metric entry all have the same width as the last entry.
 func (f *pdfTTFont) readHMTX(rd *bytes.Reader) {
-	if f.Err != nil {
+	if !f.seekTable(rd, "hmtx", true) {
+		return
+	}
+	n, count := int(f.MAXP.NumGlyphs), int(f.HHEA.HMetricCount)
+	if count == 0 || count > n {
+		f.Err = pdfError{id: 0xE4B8F2, msg: "Invalid numberOfHMetrics",
+			val: fmt.Sprint(count)}
 		return
 	}
-	// TODO: implement
+	f.HMTX.Widths = make([]uint16, n)
+	for i := 0; i < count && f.Err == nil; i++ {
+		f.HMTX.Widths[i] = f.readUI16(rd)
+		f.read(rd, 2, false) // left side bearing
+	}
+	for i := count; i < n; i++ {
+		f.HMTX.Widths[i] = f.HMTX.Widths[count-1]
+	}
 } //                                                                    readHMTX
 
-// readCMAP __
+// readCMAP reads the mapping of Unicode characters to glyph indices.
+// Of all the subtables, the one that covers the most characters is used:
+// Unicode full repertoire (format 12), then Unicode BMP (format 4 or 6),
+// or the Windows symbol subtable, which is mapped to the range 0-255.
 func (f *pdfTTFont) readCMAP(rd *bytes.Reader) {
+	if !f.seekTable(rd, "cmap", true) {
+		return
+	}
+	start := int64(f.Tables["cmap"].Offset)
+	f.read(rd, 2, false) // version
+	count := f.readUI16(rd)
+	var (
+		best     int64 // offset of the subtable to use
+		bestRank int
+		isSymbol bool
+	)
+	for i := 0; i < int(count) && f.Err == nil; i++ {
+		platformID, encodingID := f.readUI16(rd), f.readUI16(rd)
+		offset := start + int64(f.readUI32(rd))
+		if offset+2 > int64(len(f.Data)) {
+			continue
+		}
+		format := uint16(f.Data[offset])<<8 | uint16(f.Data[offset+1])
+		rank := 0
+		switch {
+		case format != 4 && format != 6 && format != 12:
+			rank = 0 // unsupported format: skip
+		case platformID == 3 && encodingID == 10,
+			platformID == 0 && (encodingID == 4 || encodingID == 6):
+			rank = 4 // full Unicode repertoire
+		case platformID == 3 && encodingID == 1:
+			rank = 3 // Windows Unicode BMP
+		case platformID == 0:
+			rank = 2 // other Unicode subtables
+		case platformID == 3 && encodingID == 0:
+			rank = 1 // Windows symbol font
+		}
+		if rank > bestRank {
+			best, bestRank, isSymbol = offset, rank, rank == 1
+		}
+	}
 	if f.Err != nil {
 		return
 	}
-	// TODO: implement
+	if bestRank == 0 {
+		f.Err = pdfError{id: 0xE9D1A7, msg: "No Unicode cmap found",
+			val: "cmap"}
+		return
+	}
+	f.CMAP.Chars = make(map[int]uint16)
+	add := func(char int, glyph uint16) {
+		if glyph == 0 || glyph >= f.MAXP.NumGlyphs {
+			return
+		}
+		f.CMAP.Chars[char] = glyph
+		if isSymbol && char >= 0xF000 && char <= 0xF0FF {
+			f.CMAP.Chars[char-0xF000] = glyph
+		}
+	}
+	f.seek(rd, best)
+	switch f.readUI16(rd) {
+	case 4: // segment mapping to delta values
+		f.read(rd, 4, false) // length, language
+		segCount := int(f.readUI16(rd) / 2)
+		f.read(rd, 6, false) // searchRange, entrySelector, rangeShift
+		ends := make([]uint16, segCount)
+		for i := range ends {
+			ends[i] = f.readUI16(rd)
+		}
+		f.read(rd, 2, false) // reservedPad
+		starts := make([]uint16, segCount)
+		for i := range starts {
+			starts[i] = f.readUI16(rd)
+		}
+		deltas := make([]uint16, segCount)
+		for i := range deltas {
+			deltas[i] = f.readUI16(rd)
+		}
+		rangesAt, _ := rd.Seek(0, io.SeekCurrent)
+		for seg := 0; seg < segCount && f.Err == nil; seg++ {
+			f.seek(rd, rangesAt+int64(seg*2))
+			rangeOffset := f.readUI16(rd)
+			for c := int(starts[seg]); c <= int(ends[seg]); c++ {
+				if c == 0xFFFF {
+					break
+				}
+				if rangeOffset == 0 {
+					add(c, uint16(c)+deltas[seg])
+					continue
+				}
+				f.seek(rd, rangesAt+int64(seg*2)+int64(rangeOffset)+
+					int64(c-int(starts[seg]))*2)
+				if glyph := f.readUI16(rd); glyph != 0 {
+					add(c, glyph+deltas[seg])
+				}
+			}
+		}
+	case 6: // trimmed table mapping
+		f.read(rd, 4, false) // length, language
+		first, count := int(f.readUI16(rd)), int(f.readUI16(rd))
+		for i := 0; i < count && f.Err == nil; i++ {
+			add(first+i, f.readUI16(rd))
+		}
+	case 12: // segmented coverage
+		f.read(rd, 10, false) // reserved, length, language
+		groups := int(f.readUI32(rd))
+		for i := 0; i < groups && f.Err == nil; i++ {
+			first, last, glyph := f.readUI32(rd), f.readUI32(rd),
+				f.readUI32(rd)
+			if last < first || last > 0x10FFFF {
+				continue
+			}
+			for c := first; c <= last; c, glyph = c+1, glyph+1 {
+				if glyph <= 0xFFFF {
+					add(int(c), uint16(glyph))
+				}
+			}
+		}
+	}
 } //                                                                    readCMAP
 
-// readNAME __
+// readNAME reads the PostScript name of the font from the naming table.
+// Windows Unicode names (UTF-16) are preferred to Macintosh names.
 func (f *pdfTTFont) readNAME(rd *bytes.Reader) {
-	if f.Err != nil {
+	if !f.seekTable(rd, "name", false) {
 		return
 	}
-	// TODO: implement
+	start := int64(f.Tables["name"].Offset)
+	f.read(rd, 2, false) // format
+	count := f.readUI16(rd)
+	storage := start + int64(f.readUI16(rd))
+	for i := 0; i < int(count) && f.Err == nil; i++ {
+		platformID, _ := f.readUI16(rd), f.readUI16(rd) // and encodingID
+		f.read(rd, 2, false)                            // languageID
+		nameID, length := f.readUI16(rd), f.readUI16(rd)
+		offset := storage + int64(f.readUI16(rd))
+		if nameID != 6 || (platformID != 1 && platformID != 3) ||
+			offset+int64(length) > int64(len(f.Data)) {
+			continue
+		}
+		ar := f.Data[offset : offset+int64(length)]
+		if platformID == 1 {
+			if f.NAME.PostScriptName == "" {
+				f.NAME.PostScriptName = string(ar)
+			}
+			continue
+		}
+		u16 := make([]uint16, len(ar)/2)
+		for i := range u16 {
+			u16[i] = uint16(ar[i*2])<<8 | uint16(ar[i*2+1])
+		}
+		f.NAME.PostScriptName = string(utf16.Decode(u16))
+		break
+	}
 } //                                                                    readNAME
 
-// readOS2 __
+// readOS2 reads the weight, embedding permissions, typographic ascender,
+// descender, line gap and cap height from the OS/2 table (if present)
 func (f *pdfTTFont) readOS2(rd *bytes.Reader) {
-	if f.Err != nil {
+	if !f.seekTable(rd, "OS/2", false) {
 		return
 	}
-	// TODO: implement
+	f.OS2.Version = f.readUI16(rd)
+	f.read(rd, 2, false) // xAvgCharWidth
+	f.OS2.UsWeightClass = f.readUI16(rd)
+	f.read(rd, 2, false) // usWidthClass
+	f.OS2.FsType = f.readUI16(rd)
+	// skip ySubscriptXSize ... usLastCharIndex (fields before sTypoAscender)
+	f.read(rd, 58, false)
+	f.OS2.STypoAscender = f.readI16(rd)
+	f.OS2.STypoDescender = f.readI16(rd)
+	f.OS2.STypoLineGap = f.readI16(rd)
+	if f.OS2.Version >= 2 {
+		// skip usWinAscent, usWinDescent, ulCodePageRange1, ..Range2
+		f.read(rd, 12, false)
+		f.OS2.SxHeight = f.readI16(rd)
+		f.OS2.SCapHeight = f.readI16(rd)
+	}
 } //                                                                     readOS2
 
-// readPOST __
+// readPOST reads the italic angle, underline position and fixed pitch
+// flag from the PostScript table
 func (f *pdfTTFont) readPOST(rd *bytes.Reader) {
-	if f.Err != nil {
+	if !f.seekTable(rd, "post", false) {
 		return
 	}
-	// TODO: implement
+	f.read(rd, 4, false)               // version
+	f.POST.ItalicAngle = f.readI16(rd) // integer part of 16.16 fixed number
+	f.read(rd, 2, false)               // fraction
+	f.POST.UnderlinePosition = f.readI16(rd)
+	f.read(rd, 2, false) // underlineThickness
+	f.POST.IsFixedPitch = f.readUI32(rd)
 } //                                                                    readPOST
 
-// readLOCA __
+// readLOCA reads the offset of each glyph in the glyph data table.
+// The offsets are stored in bytes, even when the table uses
+// the short format (which stores offsets divided by 2)
 func (f *pdfTTFont) readLOCA(rd *bytes.Reader) {
+	if !f.seekTable(rd, "loca", false) {
+		return // no 'loca' in fonts without TrueType outlines
+	}
+	n := int(f.MAXP.NumGlyphs) + 1
+	f.LOCA = make([]uint32, n)
+	for i := 0; i < n && f.Err == nil; i++ {
+		if f.HEAD.IndexToLocFormat == 0 {
+			f.LOCA[i] = uint32(f.readUI16(rd)) * 2
+			continue
+		}
+		f.LOCA[i] = f.readUI32(rd)
+	}
+} //                                                                    readLOCA
+
+// -----------------------------------------------------------------------------
+// # CFF Parsing Methods (f *pdfTTFont)
+
+// readCFF reads the 'CFF ' or 'CFF2' table of an OpenType font that has
+// PostScript outlines. Only the structures needed for subsetting are
+// read: everything else is kept as encoded in the font.
+func (f *pdfTTFont) readCFF(rd *bytes.Reader) {
+	tag := "CFF "
+	if _, found := f.Tables["CFF2"]; found {
+		tag = "CFF2"
+	}
+	_, isTrueType := f.Tables["glyf"]
+	if !f.seekTable(rd, tag, !isTrueType) {
+		return
+	}
+	var (
+		cff   = &f.CFF
+		table = f.table(tag)
+		trd   = bytes.NewReader(table) // CFF offsets are from table start
+		hdr   = f.read(trd, 4)         // major, minor, hdrSize, offSize
+	)
 	if f.Err != nil {
 		return
 	}
-	// TODO: implement
-} //                                                                    readLOCA
+	cff.Version = int(hdr[0])
+	switch {
+	case tag == "CFF " && cff.Version == 1:
+		f.seek(trd, int64(hdr[2]))
+		_, cff.Names = f.readCFFIndex(trd)
+		dicts, _ := f.readCFFIndex(trd)
+		if f.Err == nil && len(dicts) == 0 {
+			f.Err = pdfError{id: 0xE4E1B0, msg: "Missing CFF Top DICT",
+				val: tag}
+			return
+		}
+		if f.Err == nil {
+			cff.TopDict = f.readCFFDict(dicts[0])
+		}
+		_, cff.Strings = f.readCFFIndex(trd)
+	case tag == "CFF2" && cff.Version == 2:
+		f.seek(trd, 3)
+		size := int(f.readUI16(trd)) // topDictLength
+		if f.Err == nil && int(hdr[2])+size > len(table) {
+			f.Err = pdfError{id: 0xE6F0C3, msg: "Table out of range",
+				val: "CFF2 Top DICT"}
+		}
+		if f.Err != nil {
+			return
+		}
+		cff.TopDict = f.readCFFDict(table[hdr[2] : int(hdr[2])+size])
+		f.seek(trd, int64(hdr[2])+int64(size))
+	default:
+		f.Err = pdfError{id: 0xE8A92C, msg: "Unsupported CFF version",
+			val: fmt.Sprintf("%d in %q", hdr[0], tag)}
+		return
+	}
+	_, cff.GlobalSubrs = f.readCFFIndex(trd)
+	//
+	// read the structures that the Top DICT refers to
+	top := make(map[int]pdfCFFOp, len(cff.TopDict))
+	for _, op := range cff.TopDict {
+		top[op.Op] = op
+	}
+	charStrings, found := top[17]
+	if !found || len(charStrings.Args) != 1 {
+		f.Err = pdfError{id: 0xE3C81F, msg: "Missing font table",
+			val: "CharStrings in " + tag}
+		return
+	}
+	f.seek(trd, int64(charStrings.Args[0]))
+	cff.CharStrings, _ = f.readCFFIndex(trd)
+	if f.Err == nil && len(cff.CharStrings) != int(f.MAXP.NumGlyphs) {
+		f.Err = pdfError{id: 0xE1F8D5, msg: "Invalid CFF glyph count",
+			val: fmt.Sprintf("%d", len(cff.CharStrings))}
+		return
+	}
+	n := len(cff.CharStrings)
+	if op, found := top[15]; found && len(op.Args) == 1 && op.Args[0] > 2 {
+		cff.Charset = f.readCFFRaw(trd, op.Args[0], func() int {
+			switch format := f.read(trd, 1); {
+			case format == nil:
+				return 0
+			case format[0] == 0:
+				return 1 + (n-1)*2
+			case format[0] == 1 || format[0] == 2: // ranges: first, nLeft
+				size, rangeSize := 1, 2+int(format[0])
+				for covered := 1; covered < n && f.Err == nil; {
+					ar := f.read(trd, rangeSize)
+					if ar == nil {
+						break
+					}
+					nLeft := int(ar[2]) // 8-bit in format 1, 16 in format 2
+					if rangeSize == 4 {
+						nLeft = int(binary.BigEndian.Uint16(ar[2:]))
+					}
+					covered += 1 + nLeft
+					size += rangeSize
+				}
+				return size
+			}
+			f.Err = pdfError{id: 0xE5C02E, msg: "Invalid CFF charset"}
+			return 0
+		})
+	}
+	if op, found := top[1237]; found && len(op.Args) == 1 {
+		cff.FDSelect = f.readCFFRaw(trd, op.Args[0], func() int {
+			switch format := f.read(trd, 1); {
+			case format == nil:
+				return 0
+			case format[0] == 0:
+				return 1 + n
+			case format[0] == 3: // ranges: first, fd; then sentinel
+				return 1 + 2 + int(f.readUI16(trd))*3 + 2
+			case format[0] == 4: // CFF2 only: 32-bit ranges
+				return 1 + 4 + int(f.readUI32(trd))*6 + 4
+			}
+			f.Err = pdfError{id: 0xE2AF61, msg: "Invalid CFF FDSelect"}
+			return 0
+		})
+	}
+	if op, found := top[24]; found && len(op.Args) == 1 { // vstore
+		cff.VarStore = f.readCFFRaw(trd, op.Args[0], func() int {
+			return 2 + int(f.readUI16(trd))
+		})
+	}
+	if op, found := top[1236]; found && len(op.Args) == 1 {
+		f.seek(trd, int64(op.Args[0]))
+		dicts, _ := f.readCFFIndex(trd)
+		for _, dict := range dicts {
+			ops := f.readCFFDict(dict)
+			cff.FDArray = append(cff.FDArray, ops)
+			for _, op := range ops {
+				if op.Op == 18 {
+					cff.Privates = append(cff.Privates,
+						f.readCFFPrivate(table, op))
+				}
+			}
+			if len(cff.Privates) != len(cff.FDArray) && f.Err == nil {
+				f.Err = pdfError{id: 0xE9C4B3, msg: "Missing CFF Private DICT",
+					val: "in Font DICT"}
+			}
+		}
+	} else if op, found := top[18]; found {
+		cff.Privates = []pdfCFFPrivate{f.readCFFPrivate(table, op)}
+	}
+} //                                                                     readCFF
+
+// readCFFDict decodes the operators and operands of a CFF DICT
+func (f *pdfTTFont) readCFFDict(data []byte) []pdfCFFOp {
+	var (
+		ret   []pdfCFFOp
+		start = 0 // where the current operands start
+		args  []int
+	)
+	for i := 0; i < len(data) && f.Err == nil; {
+		b0, size, val := data[i], 1, 0
+		switch {
+		case b0 <= 27 && b0 != 12 || b0 == 31: // one-byte operator
+			ret = append(ret, pdfCFFOp{Op: int(b0), Args: args,
+				Raw: data[start:i]})
+		case b0 == 12: // two-byte operator
+			size = 2
+			if i+1 < len(data) {
+				ret = append(ret, pdfCFFOp{Op: 1200 + int(data[i+1]),
+					Args: args, Raw: data[start:i]})
+			}
+		case b0 == 28:
+			size = 3
+			if i+2 < len(data) {
+				val = int(int16(binary.BigEndian.Uint16(data[i+1:])))
+			}
+		case b0 == 29:
+			size = 5
+			if i+4 < len(data) {
+				val = int(int32(binary.BigEndian.Uint32(data[i+1:])))
+			}
+		case b0 == 30: // real number: nibbles up to 0xF
+			for size = 1; i+size < len(data); size++ {
+				if data[i+size]&0x0F == 0x0F || data[i+size]>>4 == 0x0F {
+					break
+				}
+			}
+			size++
+		case b0 <= 246:
+			val = int(b0) - 139
+		case b0 <= 250:
+			size = 2
+			if i+1 < len(data) {
+				val = (int(b0)-247)*256 + int(data[i+1]) + 108
+			}
+		case b0 <= 254:
+			size = 2
+			if i+1 < len(data) {
+				val = -(int(b0)-251)*256 - int(data[i+1]) - 108
+			}
+		default:
+			f.Err = pdfError{id: 0xE7D26A, msg: "Invalid CFF DICT",
+				val: fmt.Sprintf("byte %d at %d", b0, i)}
+			return nil
+		}
+		if i+size > len(data) {
+			f.Err = pdfError{id: 0xE7D26A, msg: "Invalid CFF DICT",
+				val: fmt.Sprintf("truncated at %d", i)}
+			return nil
+		}
+		i += size
+		if b0 <= 27 || b0 == 31 { // operator: next operands start here
+			start, args = i, nil
+			continue
+		}
+		args = append(args, val)
+	}
+	return ret
+} //                                                                 readCFFDict
+
+// readCFFIndex reads a CFF INDEX structure at the current position.
+// Returns the items of the INDEX and the INDEX as encoded in the font.
+// In CFF2 tables, the count of items is a 32-bit number.
+func (f *pdfTTFont) readCFFIndex(rd *bytes.Reader) (items [][]byte,
+	raw []byte) {
+	start := rd.Size() - int64(rd.Len())
+	count := 0
+	if f.CFF.Version == 2 {
+		count = int(f.readUI32(rd))
+	} else {
+		count = int(f.readUI16(rd))
+	}
+	if f.Err != nil || count == 0 { // empty INDEX: just the count
+		end := rd.Size() - int64(rd.Len())
+		f.seek(rd, start)
+		return nil, f.read(rd, int(end-start))
+	}
+	offSize := 0
+	if ar := f.read(rd, 1); ar != nil {
+		offSize = int(ar[0])
+	}
+	if f.Err == nil && (offSize < 1 || offSize > 4 || count > rd.Len()) {
+		f.Err = pdfError{id: 0xE4D3A8, msg: "Invalid CFF INDEX",
+			val: fmt.Sprintf("count %d, offSize %d", count, offSize)}
+	}
+	if f.Err != nil {
+		return nil, nil
+	}
+	offsets := make([]int, count+1)
+	for i := range offsets {
+		for _, b := range f.read(rd, offSize) {
+			offsets[i] = offsets[i]<<8 | int(b)
+		}
+		if f.Err == nil && (offsets[i] < 1 ||
+			i > 0 && offsets[i] < offsets[i-1]) {
+			f.Err = pdfError{id: 0xE4D3A8, msg: "Invalid CFF INDEX",
+				val: fmt.Sprintf("offset %d", offsets[i])}
+		}
+	}
+	var data []byte
+	if f.Err == nil && offsets[count] > 1 {
+		data = f.read(rd, offsets[count]-1)
+	}
+	if f.Err != nil {
+		return nil, nil
+	}
+	items = make([][]byte, count)
+	for i := range items {
+		items[i] = data[offsets[i]-1 : offsets[i+1]-1]
+	}
+	end := rd.Size() - int64(rd.Len())
+	f.seek(rd, start)
+	return items, f.read(rd, int(end-start))
+} //                                                                readCFFIndex
+
+// readCFFPrivate reads the Private DICT that the Private operator 'op'
+// refers to, and the local subroutines the Private DICT refers to
+func (f *pdfTTFont) readCFFPrivate(table []byte,
+	op pdfCFFOp) pdfCFFPrivate {
+	var ret pdfCFFPrivate
+	if len(op.Args) != 2 || op.Args[0] < 0 || op.Args[1] < 0 ||
+		op.Args[0]+op.Args[1] > len(table) {
+		if f.Err == nil {
+			f.Err = pdfError{id: 0xE6F0C3, msg: "Table out of range",
+				val: "CFF Private DICT"}
+		}
+		return ret
+	}
+	size, offset := op.Args[0], op.Args[1]
+	ret.Dict = f.readCFFDict(table[offset : offset+size])
+	for _, op := range ret.Dict {
+		if op.Op == 19 && len(op.Args) == 1 { // Subrs: offset from Private
+			rd := bytes.NewReader(table)
+			f.seek(rd, int64(offset+op.Args[0]))
+			_, ret.Subrs = f.readCFFIndex(rd)
+		}
+	}
+	return ret
+} //                                                              readCFFPrivate
+
+// readCFFRaw returns the encoded bytes of a CFF structure at 'offset'.
+// 'lengthOf' reads the start of the structure and returns its length.
+func (f *pdfTTFont) readCFFRaw(rd *bytes.Reader, offset int,
+	lengthOf func() int) []byte {
+	f.seek(rd, int64(offset))
+	size := lengthOf()
+	f.seek(rd, int64(offset))
+	if f.Err != nil {
+		return nil
+	}
+	return f.read(rd, size)
+} //                                                                  readCFFRaw
+
+// -----------------------------------------------------------------------------
+// # OpenType Layout Methods (f *pdfTTFont)
+
+// applyLookup applies a GSUB lookup to 'glyphs'. 'val' is the value of
+// the OpenType feature that uses the lookup, which selects alternates.
+// If 'form' is not blank, only glyphs in that joining form are changed.
+func (f *pdfTTFont) applyLookup(lookup pdfGSUBLookup, val int, form string,
+	glyphs []pdfGlyph) []pdfGlyph {
+	ret := make([]pdfGlyph, 0, len(glyphs))
+	for i := 0; i < len(glyphs); i++ {
+		var (
+			g    = glyphs[i]
+			done = false
+		)
+		for _, sub := range lookup.Subtables {
+			if form != "" && g.Form != form {
+				break
+			}
+			if lookup.Kind == 4 { // ligature: the longest listed first
+				for _, lig := range sub.Ligatures[g.ID] {
+					n := len(lig.Components)
+					if i+n >= len(glyphs) {
+						continue
+					}
+					text := g.Text
+					for j, component := range lig.Components {
+						if glyphs[i+1+j].ID != component {
+							text = ""
+							break
+						}
+						text += glyphs[i+1+j].Text
+					}
+					if text == "" && n > 0 {
+						continue
+					}
+					ret = append(ret, pdfGlyph{lig.Glyph, text, g.Form})
+					i, done = i+n, true
+					break
+				}
+			} else if subst := sub.Glyphs[g.ID]; len(subst) > 0 {
+				switch lookup.Kind {
+				case 1: // single
+					ret = append(ret, pdfGlyph{subst[0], g.Text, g.Form})
+				case 2: // multiple: the first glyph represents the text
+					ret = append(ret, pdfGlyph{subst[0], g.Text, g.Form})
+					for _, id := range subst[1:] {
+						ret = append(ret, pdfGlyph{ID: id, Form: g.Form})
+					}
+				case 3: // alternate: 1 is the first alternate
+					n := val - 1
+					if n >= len(subst) {
+						n = len(subst) - 1
+					}
+					ret = append(ret, pdfGlyph{subst[n], g.Text, g.Form})
+				}
+				done = true
+			}
+			if done {
+				break // only the first subtable that applies is used
+			}
+		}
+		if !done {
+			ret = append(ret, g)
+		}
+	}
+	return ret
+} //                                                                 applyLookup
+
+// kerning returns the adjustment of the space between glyphs 'left' and
+// 'right' in font design units (negative values move glyphs closer).
+// GPOS kerning takes precedence over the 'kern' table, if a font has both.
+// Returns zero if the 'kern' feature is turned off with SetFontFeatures().
+func (f *pdfTTFont) kerning(left, right uint16) int {
+	if f.pdf.fontFeature("kern") == 0 {
+		return 0
+	}
+	if f.KERN.Lookups == nil {
+		return int(f.KERN.Pairs[uint32(left)<<16|uint32(right)])
+	}
+	ret := 0
+	for _, lookup := range f.KERN.Lookups {
+		// only the first subtable that covers the pair applies in a lookup
+		for _, sub := range lookup {
+			if !sub.Coverage[left] {
+				continue
+			}
+			if sub.Values == nil { // format 1: individual pairs
+				val, found := sub.Pairs[uint32(left)<<16|uint32(right)]
+				if !found {
+					continue
+				}
+				ret += int(val)
+				break
+			}
+			// format 2: glyph classes (a missing glyph is in class 0)
+			c1, c2 := int(sub.Class1[left]), int(sub.Class2[right])
+			if c1 < len(sub.Values) && c2 < len(sub.Values[c1]) {
+				ret += int(sub.Values[c1][c2])
+			}
+			break
+		}
+	}
+	return ret
+} //                                                                     kerning
+
+// readClassDef reads the class definition table at 'offset' (from the
+// start of the font data) and returns the class of each listed glyph
+func (f *pdfTTFont) readClassDef(rd *bytes.Reader,
+	offset int64) map[uint16]uint16 {
+	f.seek(rd, offset)
+	ret := make(map[uint16]uint16)
+	setRange := func(first, last int, class uint16) {
+		for g := first; g <= last && g < int(f.MAXP.NumGlyphs); g++ {
+			ret[uint16(g)] = class
+		}
+	}
+	switch format := f.readUI16(rd); format {
+	case 1: // classes of consecutive glyphs
+		first := int(f.readUI16(rd))
+		count := int(f.readUI16(rd))
+		for i := 0; i < count && f.Err == nil; i++ {
+			class := f.readUI16(rd)
+			setRange(first+i, first+i, class)
+		}
+	case 2: // ranges of glyphs in the same class
+		count := int(f.readUI16(rd))
+		for i := 0; i < count && f.Err == nil; i++ {
+			first, last := int(f.readUI16(rd)), int(f.readUI16(rd))
+			setRange(first, last, f.readUI16(rd))
+		}
+	default:
+		if f.Err == nil {
+			f.Err = pdfError{id: 0xE6B1F4, msg: "Invalid class definition",
+				val: fmt.Sprintf("format %d", format)}
+		}
+	}
+	return ret
+} //                                                                readClassDef
+
+// readCoverage reads the coverage table at 'offset' (from the start of
+// the font data) and returns the glyphs in order of their coverage index
+func (f *pdfTTFont) readCoverage(rd *bytes.Reader, offset int64) []uint16 {
+	f.seek(rd, offset)
+	var ret []uint16
+	switch format := f.readUI16(rd); format {
+	case 1: // list of glyphs
+		count := int(f.readUI16(rd))
+		for i := 0; i < count && f.Err == nil; i++ {
+			ret = append(ret, f.readUI16(rd))
+		}
+	case 2: // ranges of glyphs
+		count := int(f.readUI16(rd))
+		for i := 0; i < count && f.Err == nil; i++ {
+			first, last := int(f.readUI16(rd)), int(f.readUI16(rd))
+			f.read(rd, 2, false) // startCoverageIndex
+			for g := first; g <= last && g < int(f.MAXP.NumGlyphs); g++ {
+				ret = append(ret, uint16(g))
+			}
+		}
+	default:
+		if f.Err == nil {
+			f.Err = pdfError{id: 0xE2C6D7, msg: "Invalid coverage table",
+				val: fmt.Sprintf("format %d", format)}
+		}
+	}
+	return ret
+} //                                                                readCoverage
+
+// readFeatureList reads the feature list at 'offset' (from the start
+// of the font data) and returns the lookup indices of each feature.
+// Text isn't tagged with a script or language, so the features
+// of all scripts and languages are used.
+func (f *pdfTTFont) readFeatureList(rd *bytes.Reader,
+	offset int64) map[string][]int {
+	f.seek(rd, offset)
+	ret := make(map[string][]int)
+	count := int(f.readUI16(rd))
+	for i := 0; i < count && f.Err == nil; i++ {
+		f.seek(rd, offset+2+int64(i)*6)
+		tag := string(f.read(rd, 4))
+		feature := offset + int64(f.readUI16(rd))
+		f.seek(rd, feature+2) // skip featureParamsOffset
+		for _, index := range f.readUI16Array(rd) {
+			ret[tag] = append(ret[tag], int(index))
+		}
+	}
+	return ret
+} //                                                             readFeatureList
+
+// readGPOS reads the pair adjustment lookups of the 'kern' feature
+// from the glyph positioning table
+func (f *pdfTTFont) readGPOS(rd *bytes.Reader) {
+	if !f.seekTable(rd, "GPOS", false) {
+		return
+	}
+	base := int64(f.Tables["GPOS"].Offset)
+	f.read(rd, 6, false) // majorVersion, minorVersion, scriptListOffset
+	featureList := base + int64(f.readUI16(rd))
+	lookupList := base + int64(f.readUI16(rd))
+	used := make(map[int]bool)
+	for _, index := range f.readFeatureList(rd, featureList)["kern"] {
+		used[index] = true
+	}
+	// read lookups in the order of the lookup list, which is the order
+	// in which they are applied
+	f.seek(rd, lookupList)
+	count := int(f.readUI16(rd))
+	for i := 0; i < count && f.Err == nil; i++ {
+		if !used[i] {
+			continue
+		}
+		kind, offsets := f.readLookup(rd, lookupList, i, 9)
+		if kind != 2 {
+			continue // not a pair adjustment
+		}
+		var subtables []pdfKernSubtable
+		for _, offset := range offsets {
+			subtables = append(subtables, f.readPairPos(rd, offset))
+		}
+		if len(subtables) > 0 {
+			f.KERN.Lookups = append(f.KERN.Lookups, subtables)
+		}
+	}
+} //                                                                    readGPOS
+
+// readGSUB reads the lookups of all features in the glyph substitution
+// table. Only single, multiple, alternate and ligature substitutions
+// are read: contextual substitutions are not supported.
+func (f *pdfTTFont) readGSUB(rd *bytes.Reader) {
+	if !f.seekTable(rd, "GSUB", false) {
+		return
+	}
+	base := int64(f.Tables["GSUB"].Offset)
+	f.read(rd, 6, false) // majorVersion, minorVersion, scriptListOffset
+	featureList := base + int64(f.readUI16(rd))
+	lookupList := base + int64(f.readUI16(rd))
+	f.GSUB.Features = f.readFeatureList(rd, featureList)
+	used := make(map[int]bool)
+	for _, indices := range f.GSUB.Features {
+		for _, index := range indices {
+			used[index] = true
+		}
+	}
+	f.seek(rd, lookupList)
+	f.GSUB.Lookups = make([]pdfGSUBLookup, f.readUI16(rd))
+	for i := range f.GSUB.Lookups {
+		if !used[i] || f.Err != nil {
+			continue
+		}
+		kind, offsets := f.readLookup(rd, lookupList, i, 7)
+		if kind < 1 || kind > 4 {
+			continue
+		}
+		lookup := &f.GSUB.Lookups[i]
+		lookup.Kind = int(kind)
+		for _, offset := range offsets {
+			lookup.Subtables = append(lookup.Subtables,
+				f.readSubst(rd, offset, kind))
+		}
+	}
+} //                                                                    readGSUB
+
+// readKERN reads pairs of glyphs and their kerning values from the 'kern'
+// table, in either the Windows (version 0) or Apple (version 1) format.
+// Only subtables in format 0 with horizontal kerning values are used.
+func (f *pdfTTFont) readKERN(rd *bytes.Reader) {
+	if !f.seekTable(rd, "kern", false) {
+		return
+	}
+	apple := f.readUI16(rd) == 1 // Apple's version is 1.0 (32-bit)
+	var count int
+	if apple {
+		f.read(rd, 2, false)
+		count = int(f.readUI32(rd))
+	} else {
+		count = int(f.readUI16(rd))
+	}
+	f.KERN.Pairs = make(map[uint32]int16)
+	for i := 0; i < count && f.Err == nil; i++ {
+		var (
+			start      = f.pos(rd)
+			length     int64
+			format     uint16
+			horizontal bool
+		)
+		if apple {
+			length = int64(f.readUI32(rd))
+			coverage := f.readUI16(rd)
+			f.read(rd, 2, false) // tupleIndex
+			format = coverage & 0xFF
+			// not vertical, cross-stream or variation kerning
+			horizontal = coverage&0xE000 == 0
+		} else {
+			f.read(rd, 2, false) // version
+			length = int64(f.readUI16(rd))
+			coverage := f.readUI16(rd)
+			format = coverage >> 8
+			// horizontal, not minimum or cross-stream kerning
+			horizontal = coverage&0x0007 == 0x0001
+		}
+		if format != 0 || !horizontal {
+			f.seek(rd, start+length)
+			continue
+		}
+		n := int(f.readUI16(rd))
+		f.read(rd, 6, false) // searchRange, entrySelector, rangeShift
+		for j := 0; j < n && f.Err == nil; j++ {
+			pair := f.readUI32(rd) // left and right glyphs
+			f.KERN.Pairs[pair] += f.readI16(rd)
+		}
+		// the reader is now at the end of the subtable: the
+		// length of big subtables overflows in the Windows format
+	}
+} //                                                                    readKERN
+
+// readLookup reads the lookup at 'index' in the lookup list at 'offset'
+// (from the start of the font data), returning the lookup type and the
+// offsets of its subtables. Subtables of lookups of type 'extension'
+// are replaced with the subtables they point to.
+func (f *pdfTTFont) readLookup(rd *bytes.Reader, offset int64, index int,
+	extension uint16) (kind uint16, subtables []int64) {
+	f.seek(rd, offset+2+int64(index)*2)
+	lookup := offset + int64(f.readUI16(rd))
+	f.seek(rd, lookup)
+	kind = f.readUI16(rd)
+	f.read(rd, 2, false) // lookupFlag
+	for _, sub := range f.readUI16Array(rd) {
+		subtables = append(subtables, lookup+int64(sub))
+	}
+	if kind != extension {
+		return kind, subtables
+	}
+	// an extension subtable has the type of the lookup and a 32-bit offset
+	for i, sub := range subtables {
+		f.seek(rd, sub+2) // skip format
+		kind = f.readUI16(rd)
+		subtables[i] = sub + int64(f.readUI32(rd))
+	}
+	return kind, subtables
+} //                                                                  readLookup
+
+// readPairPos reads the GPOS pair adjustment subtable at 'offset'
+// (from the start of the font data). Only the horizontal advance
+// of the first glyph of each pair is used.
+func (f *pdfTTFont) readPairPos(rd *bytes.Reader,
+	offset int64) pdfKernSubtable {
+	//
+	// readAdvance reads a value record with the fields given by
+	// 'format' flags, returning its XAdvance (flag 0x0004)
+	readAdvance := func(format uint16) int16 {
+		var ret int16
+		for flag := uint16(0x0001); flag <= 0x0080; flag <<= 1 {
+			if format&flag == 0 {
+				continue
+			}
+			if val := f.readI16(rd); flag == 0x0004 {
+				ret = val
+			}
+		}
+		return ret
+	}
+	f.seek(rd, offset)
+	var (
+		ret      pdfKernSubtable
+		firsts   []uint16 // first glyphs in order of coverage index
+		format   = f.readUI16(rd)
+		coverage = offset + int64(f.readUI16(rd))
+		format1  = f.readUI16(rd)
+		format2  = f.readUI16(rd)
+	)
+	switch format {
+	case 1: // adjustments of individual pairs
+		n := int(f.readUI16(rd))
+		sets := make([]int64, 0, n)
+		for i := 0; i < n && f.Err == nil; i++ {
+			sets = append(sets, offset+int64(f.readUI16(rd)))
+		}
+		firsts = f.readCoverage(rd, coverage)
+		ret.Pairs = make(map[uint32]int16)
+		for i, set := range sets {
+			if i >= len(firsts) || f.Err != nil {
+				break
+			}
+			f.seek(rd, set)
+			count := int(f.readUI16(rd))
+			for j := 0; j < count && f.Err == nil; j++ {
+				second := f.readUI16(rd)
+				val := readAdvance(format1)
+				readAdvance(format2)
+				ret.Pairs[uint32(firsts[i])<<16|uint32(second)] = val
+			}
+		}
+	case 2: // adjustments of pairs of glyph classes
+		classDef1 := offset + int64(f.readUI16(rd))
+		classDef2 := offset + int64(f.readUI16(rd))
+		count1, count2 := int(f.readUI16(rd)), int(f.readUI16(rd))
+		for i := 0; i < count1 && f.Err == nil; i++ {
+			vals := make([]int16, count2)
+			for j := range vals {
+				vals[j] = readAdvance(format1)
+				readAdvance(format2)
+			}
+			ret.Values = append(ret.Values, vals)
+		}
+		if ret.Values == nil {
+			ret.Values = [][]int16{}
+		}
+		ret.Class1 = f.readClassDef(rd, classDef1)
+		ret.Class2 = f.readClassDef(rd, classDef2)
+		firsts = f.readCoverage(rd, coverage)
+	default:
+		if f.Err == nil {
+			f.Err = pdfError{id: 0xE9A4C2, msg: "Invalid pair adjustment",
+				val: fmt.Sprintf("format %d", format)}
+		}
+		return ret
+	}
+	ret.Coverage = make(map[uint16]bool)
+	for _, glyph := range firsts {
+		ret.Coverage[glyph] = true
+	}
+	return ret
+} //                                                                 readPairPos
+
+// readSubst reads the GSUB subtable at 'offset' (from the start of the
+// font data) of a single (1), multiple (2), alternate (3) or ligature (4)
+// substitution lookup. Substitutions with invalid glyphs are left out.
+func (f *pdfTTFont) readSubst(rd *bytes.Reader, offset int64,
+	kind uint16) pdfGSUBSubtable {
+	valid := func(glyphs ...uint16) bool {
+		for _, glyph := range glyphs {
+			if glyph >= f.MAXP.NumGlyphs {
+				return false
+			}
+		}
+		return len(glyphs) > 0
+	}
+	// readOffsets reads an array of offsets from the start of 'offset'
+	readOffsets := func(offset int64) (ret []int64) {
+		for _, it := range f.readUI16Array(rd) {
+			ret = append(ret, offset+int64(it))
+		}
+		return ret
+	}
+	ret := pdfGSUBSubtable{
+		Glyphs:    make(map[uint16][]uint16),
+		Ligatures: make(map[uint16][]pdfLigature),
+	}
+	f.seek(rd, offset)
+	format := f.readUI16(rd)
+	coverage := offset + int64(f.readUI16(rd))
+	switch {
+	case kind == 1 && format == 1: // delta added to each glyph
+		delta := f.readUI16(rd)
+		for _, glyph := range f.readCoverage(rd, coverage) {
+			if valid(glyph + delta) {
+				ret.Glyphs[glyph] = []uint16{glyph + delta}
+			}
+		}
+	case kind == 1 && format == 2: // substitute of each glyph
+		substs := f.readUI16Array(rd)
+		for i, glyph := range f.readCoverage(rd, coverage) {
+			if i < len(substs) && valid(substs[i]) {
+				ret.Glyphs[glyph] = substs[i : i+1]
+			}
+		}
+	case (kind == 2 || kind == 3) && format == 1: // sequences, alternates
+		sets := readOffsets(offset)
+		for i, glyph := range f.readCoverage(rd, coverage) {
+			if i >= len(sets) || f.Err != nil {
+				break
+			}
+			f.seek(rd, sets[i])
+			if glyphs := f.readUI16Array(rd); valid(glyphs...) {
+				ret.Glyphs[glyph] = glyphs
+			}
+		}
+	case kind == 4 && format == 1: // ligatures
+		sets := readOffsets(offset)
+		for i, glyph := range f.readCoverage(rd, coverage) {
+			if i >= len(sets) || f.Err != nil {
+				break
+			}
+			f.seek(rd, sets[i])
+			for _, lig := range readOffsets(sets[i]) {
+				f.seek(rd, lig)
+				it := pdfLigature{Glyph: f.readUI16(rd)}
+				n := int(f.readUI16(rd)) // number of glyphs, incl. first
+				for j := 1; j < n && f.Err == nil; j++ {
+					it.Components = append(it.Components, f.readUI16(rd))
+				}
+				if valid(append(it.Components, it.Glyph)...) {
+					ret.Ligatures[glyph] = append(ret.Ligatures[glyph], it)
+				}
+			}
+		}
+	}
+	return ret
+} //                                                                   readSubst
+
+// shape returns the glyphs of text 's' (in logical order) after glyph
+// substitution. Characters that are not in the font are written as
+// .notdef (glyph 0). Arabic letters are given their joining form, so
+// that the 'isol', 'init', 'medi' and 'fina' features shape them.
+func (f *pdfTTFont) shape(s string) []pdfGlyph {
+	runes := []rune(s)
+	joining := make([]byte, len(runes))
+	for i, r := range runes {
+		joining[i] = ttfJoiningType(r)
+	}
+	// joins returns true if the first character, in direction 'step'
+	// from 'i', that is not transparent has one of the joining 'types'
+	joins := func(i, step int, types string) bool {
+		for i += step; i >= 0 && i < len(runes); i += step {
+			if joining[i] != 'T' {
+				return strings.IndexByte(types, joining[i]) != -1
+			}
+		}
+		return false
+	}
+	glyphs := make([]pdfGlyph, 0, len(runes))
+	for i, r := range runes {
+		glyph, _ := f.glyph(r)
+		form := ""
+		if kind := joining[i]; kind == 'D' || kind == 'R' {
+			before := joins(i, -1, "DC")
+			after := kind == 'D' && joins(i, 1, "DRC")
+			switch {
+			case before && after:
+				form = "medi"
+			case before:
+				form = "fina"
+			case after:
+				form = "init"
+			default:
+				form = "isol"
+			}
+		}
+		glyphs = append(glyphs, pdfGlyph{glyph, string(r), form})
+	}
+	return f.substitute(glyphs)
+} //                                                                       shape
+
+// substitute applies the GSUB lookups of the OpenType features turned
+// on by SetFontFeatures() to 'glyphs'. Lookups are applied in the order
+// of the font's lookup list.
+func (f *pdfTTFont) substitute(glyphs []pdfGlyph) []pdfGlyph {
+	var (
+		values   = make(map[int]int)    // value of the feature of each lookup
+		forms    = make(map[int]string) // joining form of each form lookup
+		unmasked = make(map[int]bool)   // lookups used by other features
+	)
+	for tag, indices := range f.GSUB.Features {
+		val := f.pdf.fontFeature(tag)
+		if val == 0 {
+			continue
+		}
+		isForm := tag == "isol" || tag == "init" || tag == "medi" ||
+			tag == "fina"
+		for _, index := range indices {
+			if index >= len(f.GSUB.Lookups) {
+				continue
+			}
+			values[index] = val
+			if isForm {
+				forms[index] = tag
+			} else {
+				unmasked[index] = true
+			}
+		}
+	}
+	indices := make([]int, 0, len(values))
+	for index := range values {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	for _, index := range indices {
+		lookup, form := f.GSUB.Lookups[index], forms[index]
+		if unmasked[index] {
+			form = ""
+		}
+		if lookup.Kind != 0 {
+			glyphs = f.applyLookup(lookup, values[index], form, glyphs)
+		}
+	}
+	return glyphs
+} //                                                                  substitute
+
+// -----------------------------------------------------------------------------
+// # Font Embedding Methods (f *pdfTTFont)
+
+// pdfVersion returns the PDF version needed by the embedded font:
+// OpenType font programs (used for CFF outlines) need PDF 1.6
+func (f *pdfTTFont) pdfVersion() string {
+	if f.CFF.Version != 0 {
+		return "1.6"
+	}
+	return "1.4"
+} //                                                                  pdfVersion
+
+// subsetCFF returns the CFF or CFF2 table of a subset font, in which all
+// glyphs not listed in 'glyphs' have empty outlines. Glyph indices are
+// not changed. PDF selects glyphs of CID-keyed fonts using the charset,
+// so their charset is replaced with one that maps each CID to the glyph
+// with the same index. The structures the Top DICT refers to are laid
+// out after it, and all their offsets are updated.
+func (f *pdfTTFont) subsetCFF(glyphs []uint16) []byte {
+	var (
+		cff         = &f.CFF
+		cff2        = cff.Version == 2
+		charStrings = make([][]byte, len(cff.CharStrings))
+		charset     = cff.Charset
+		topDict     = cff.TopDict
+		offsets     = map[int][]int{17: {0}} // offset operands by operator
+		at          = 0
+	)
+	for g := range charStrings {
+		switch {
+		case at < len(glyphs) && int(glyphs[at]) == g:
+			charStrings[g] = cff.CharStrings[g]
+			at++
+		case !cff2:
+			charStrings[g] = []byte{14} // endchar (CFF2 needs no operator)
+		}
+	}
+	hasCharset := false
+	for _, op := range cff.TopDict {
+		switch {
+		case op.Op == 1230: // ROS: only in CID-keyed fonts
+			// format 0 with no glyphs after .notdef, or format 2: one range
+			charset = []byte{0}
+			if n := len(charStrings); n > 1 {
+				charset = []byte{2, 0, 1, byte((n - 2) >> 8), byte(n - 2)}
+			}
+		case op.Op == 15:
+			hasCharset = true
+		case op.Op == 16 && len(op.Args) == 1 && op.Args[0] > 1:
+			offsets[16] = nil // leave out custom encoding: PDF uses CIDs
+		}
+	}
+	if charset != nil && !hasCharset {
+		topDict = append([]pdfCFFOp{}, topDict...)
+		topDict = append(topDict, pdfCFFOp{Op: 15})
+	}
+	// the Top DICT has the same size with any offsets, so the
+	// structures after it can be laid out before it is encoded
+	var front, body bytes.Buffer
+	writeFront := func() {
+		front.Reset()
+		top := cffDict(topDict, offsets)
+		if cff2 {
+			front.Write([]byte{2, 0, 5, byte(len(top) >> 8), byte(len(top))})
+			front.Write(top)
+		} else {
+			front.Write([]byte{1, 0, 4, 4}) // version 1.0, sizes
+			front.Write(cff.Names)
+			front.Write(cffIndex([][]byte{top}, false))
+			front.Write(cff.Strings)
+		}
+		front.Write(cff.GlobalSubrs)
+	}
+	if charset != nil { // otherwise keep the predefined charset
+		offsets[15] = []int{0}
+	}
+	for _, op := range []int{1237, 24, 1236} {
+		offsets[op] = []int{0}
+	}
+	offsets[18] = []int{0, 0} // Private: size and offset
+	writeFront()
+	pos := func() []int { return []int{front.Len() + body.Len()} }
+	if charset != nil {
+		offsets[15] = pos()
+		body.Write(charset)
+	}
+	if cff.FDSelect != nil {
+		offsets[1237] = pos()
+		body.Write(cff.FDSelect)
+	}
+	if cff.VarStore != nil {
+		offsets[24] = pos()
+		body.Write(cff.VarStore)
+	}
+	offsets[17] = pos()
+	body.Write(cffIndex(charStrings, cff2))
+	//
+	// Private DICTs, each followed by its local subroutines
+	privates := make([][]int, len(cff.Privates)) // size and offset of each
+	for i, private := range cff.Privates {
+		subrs := map[int][]int{}
+		if private.Subrs != nil { // Subrs offset is from the Private DICT
+			subrs[19] = []int{len(cffDict(private.Dict, map[int][]int{
+				19: {0}}))}
+		}
+		dict := cffDict(private.Dict, subrs)
+		privates[i] = append([]int{len(dict)}, pos()...)
+		body.Write(dict)
+		body.Write(private.Subrs)
+	}
+	if len(cff.FDArray) > 0 {
+		dicts := make([][]byte, len(cff.FDArray))
+		for i, fd := range cff.FDArray {
+			dicts[i] = cffDict(fd, map[int][]int{18: privates[i]})
+		}
+		offsets[1236] = pos()
+		body.Write(cffIndex(dicts, cff2))
+	} else if len(privates) > 0 {
+		offsets[18] = privates[0]
+	}
+	writeFront()
+	return append(front.Bytes(), body.Bytes()...)
+} //                                                                   subsetCFF
+
+// subsetFont returns a font program with only the tables that PDF needs,
+// in which all glyphs not listed in 'glyphs' have no outlines. Glyph
+// indices are not changed, so CIDs can map to glyphs by identity.
+// Fonts with CFF outlines are returned as OpenType font programs.
+func (f *pdfTTFont) subsetFont(glyphs []uint16) []byte {
+	head := append([]byte{}, f.table("head")...)
+	if len(head) >= 54 {
+		binary.BigEndian.PutUint32(head[8:], 0) // checkSumAdjustment
+	}
+	tables := map[string][]byte{
+		"head": head, "hhea": f.table("hhea"),
+		"hmtx": f.table("hmtx"), "maxp": f.table("maxp"),
+	}
+	version := []byte{0, 1, 0, 0}
+	switch f.CFF.Version {
+	case 1:
+		tables["CFF "], version = f.subsetCFF(glyphs), []byte("OTTO")
+	case 2:
+		tables["CFF2"], version = f.subsetCFF(glyphs), []byte("OTTO")
+	default:
+		tables["glyf"], tables["loca"] = f.subsetGlyf(glyphs)
+		if len(head) >= 54 {
+			binary.BigEndian.PutUint16(head[50:], 1) // indexToLocFormat
+		}
+		for _, tag := range []string{"cvt ", "fpgm", "prep"} { // hinting
+			if table := f.table(tag); table != nil {
+				tables[tag] = table
+			}
+		}
+	}
+	ret := ttfPackTables(version, tables)
+	//
+	// set checkSumAdjustment so that the checksum of the font is 0xB1B0AFBA
+	if i := bytes.Index(ret[:12+16*len(tables)], []byte("head")); i != -1 {
+		offset := binary.BigEndian.Uint32(ret[i+8:])
+		binary.BigEndian.PutUint32(ret[offset+8:],
+			0xB1B0AFBA-ttfChecksum(ret))
+	}
+	return ret
+} //                                                                  subsetFont
+
+// subsetGlyf returns the 'glyf' and 'loca' tables of a subset TrueType
+// font, in which all glyphs not listed in 'glyphs' have no outlines.
+// The 'loca' table is in long format.
+func (f *pdfTTFont) subsetGlyf(glyphs []uint16) (glyf, loca []byte) {
+	var (
+		n   = int(f.MAXP.NumGlyphs)
+		buf bytes.Buffer
+		at  = 0
+	)
+	loca = make([]byte, (n+1)*4)
+	for g := 0; g < n; g++ {
+		binary.BigEndian.PutUint32(loca[g*4:], uint32(buf.Len()))
+		if at < len(glyphs) && int(glyphs[at]) == g {
+			at++
+			buf.Write(f.glyphData(uint16(g)))
+			for buf.Len()%4 != 0 {
+				buf.WriteByte(0)
+			}
+		}
+	}
+	binary.BigEndian.PutUint32(loca[n*4:], uint32(buf.Len()))
+	return buf.Bytes(), loca
+} //                                                                  subsetGlyf
+
+// subsetName returns the font's PostScript name prefixed with a tag of
+// six uppercase letters (e.g. 'ABCDEF+Name'), which PDF requires for
+// subset fonts. The tag is derived from the glyphs in the subset.
+func (f *pdfTTFont) subsetName(glyphs []uint16) string {
+	name := strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' || strings.ContainsRune("()<>[]{}/%#", r) {
+			return -1
+		}
+		return r
+	}, f.NAME.PostScriptName)
+	if name == "" {
+		name = "Unnamed"
+	}
+	buf := bytes.NewBufferString(name)
+	for _, glyph := range glyphs {
+		buf.WriteByte(byte(glyph >> 8))
+		buf.WriteByte(byte(glyph))
+	}
+	hash := sha512.Sum512(buf.Bytes())
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = 'A' + hash[i]%26
+	}
+	return string(tag) + "+" + name
+} //                                                                  subsetName
+
+// toUnicodeCMap returns a CMap that maps each glyph (i.e. CID) to the
+// Unicode text it represents, so that text can be copied from the PDF
+func (f *pdfTTFont) toUnicodeCMap(glyphs []uint16) []byte {
+	var chars []string
+	for _, glyph := range glyphs {
+		s, found := f.Used[glyph]
+		if !found || s == "" {
+			continue
+		}
+		hex := ""
+		for _, u := range utf16.Encode([]rune(s)) {
+			hex += fmt.Sprintf("%04X", u)
+		}
+		chars = append(chars, fmt.Sprintf("<%04X> <%s>\n", glyph, hex))
+	}
+	var buf bytes.Buffer
+	buf.WriteString("/CIDInit /ProcSet findresource begin\n" +
+		"12 dict begin\n" +
+		"begincmap\n" +
+		"/CIDSystemInfo <</Registry (Adobe) /Ordering (UCS) " +
+		"/Supplement 0>> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n" +
+		"/CMapType 2 def\n" +
+		"1 begincodespacerange\n" +
+		"<0000> <FFFF>\n" +
+		"endcodespacerange\n")
+	for len(chars) > 0 { // up to 100 entries per block
+		n := len(chars)
+		if n > 100 {
+			n = 100
+		}
+		buf.WriteString(fmt.Sprintf("%d beginbfchar\n", n))
+		for _, s := range chars[:n] {
+			buf.WriteString(s)
+		}
+		buf.WriteString("endbfchar\n")
+		chars = chars[n:]
+	}
+	buf.WriteString("endcmap\n" +
+		"CMapName currentdict /CMap defineresource pop\n" +
+		"end\n" +
+		"end")
+	return buf.Bytes()
+} //                                                               toUnicodeCMap
+
+// usedGlyphs returns the sorted indices of the glyphs used in the PDF,
+// including .notdef and the components of composite glyphs
+func (f *pdfTTFont) usedGlyphs() []uint16 {
+	used := map[uint16]bool{0: true}
+	var add func(glyph uint16)
+	add = func(glyph uint16) {
+		if glyph >= f.MAXP.NumGlyphs {
+			return
+		}
+		used[glyph] = true
+		data := f.glyphData(glyph)
+		if len(data) < 10 || int16(binary.BigEndian.Uint16(data)) >= 0 {
+			return // empty or simple glyph
+		}
+		// composite glyph: add each component
+		const (
+			argsAreWords   = 0x0001
+			haveScale      = 0x0008
+			moreComponents = 0x0020
+			haveXYScale    = 0x0040
+			have2x2        = 0x0080
+		)
+		for i := 10; i+4 <= len(data); {
+			flags := binary.BigEndian.Uint16(data[i:])
+			component := binary.BigEndian.Uint16(data[i+2:])
+			if !used[component] {
+				add(component)
+			}
+			i += 6
+			if flags&argsAreWords != 0 {
+				i += 2
+			}
+			switch {
+			case flags&haveScale != 0:
+				i += 2
+			case flags&haveXYScale != 0:
+				i += 4
+			case flags&have2x2 != 0:
+				i += 8
+			}
+			if flags&moreComponents == 0 {
+				break
+			}
+		}
+	}
+	for glyph := range f.Used {
+		add(glyph)
+	}
+	ret := make([]uint16, 0, len(used))
+	for glyph := range used {
+		ret = append(ret, glyph)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret
+} //                                                                  usedGlyphs
+
+// widthsArray returns the contents of a CIDFont's /W array, with the widths
+// of 'glyphs' grouped in runs of consecutive glyphs: 'first [w1 w2 ...]'
+func (f *pdfTTFont) widthsArray(glyphs []uint16) string {
+	var buf bytes.Buffer
+	for i, glyph := range glyphs {
+		if i == 0 || glyphs[i-1] != glyph-1 {
+			if i > 0 {
+				buf.WriteString("]\n")
+			}
+			buf.WriteString(fmt.Sprintf("%d [", glyph))
+		} else {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(fmt.Sprint(f.unitsToPt1000(
+			int(f.HMTX.Widths[glyph]))))
+	}
+	if len(glyphs) > 0 {
+		buf.WriteString("]")
+	}
+	return buf.String()
+} //                                                                 widthsArray
+
+// -----------------------------------------------------------------------------
+// # Helper Methods (f *pdfTTFont)
+
+// glyph returns the glyph index of rune 'r', or .notdef (0) if not found
+func (f *pdfTTFont) glyph(r rune) (glyph uint16, found bool) {
+	glyph, found = f.CMAP.Chars[int(r)]
+	return glyph, found
+} //                                                                       glyph
+
+// glyphData returns the outline data of a glyph from the 'glyf' table
+func (f *pdfTTFont) glyphData(glyph uint16) []byte {
+	glyf := f.table("glyf")
+	if int(glyph)+1 >= len(f.LOCA) {
+		return nil
+	}
+	start, end := f.LOCA[glyph], f.LOCA[glyph+1]
+	if start >= end || int(end) > len(glyf) {
+		return nil
+	}
+	return glyf[start:end]
+} //                                                                   glyphData
+
+// pos returns the position of the reader from the start of the font data
+func (f *pdfTTFont) pos(rd *bytes.Reader) int64 {
+	return rd.Size() - int64(rd.Len())
+} //                                                                         pos
 
 // read __
 func (f *pdfTTFont) read(rd *bytes.Reader, size int, useData ...bool) []byte {
@@ -292,7 +2198,8 @@ func (f *pdfTTFont) read(rd *bytes.Reader, size int, useData ...bool) []byte {
 		return nil
 	}
 	if n != size {
-		/// 0xE9B50D: error "END OF FILE DURING READING"
+		f.Err = pdfError{id: 0xE9B50D, msg: "End of file during reading",
+			val: fmt.Sprintf("%d of %d bytes", n, size)}
 		return nil
 	}
 	return ret
@@ -320,6 +2227,19 @@ func (f *pdfTTFont) readUI16(rd *bytes.Reader) uint16 {
 	return uint16(ar[0])<<8 | uint16(ar[1])
 } //                                                                    readUI16
 
+// readUI16Array reads a 16-bit count followed by that many 16-bit values
+func (f *pdfTTFont) readUI16Array(rd *bytes.Reader) []uint16 {
+	count := int(f.readUI16(rd))
+	ret := make([]uint16, 0, count)
+	for i := 0; i < count && f.Err == nil; i++ {
+		ret = append(ret, f.readUI16(rd))
+	}
+	if f.Err != nil {
+		return nil
+	}
+	return ret
+} //                                                               readUI16Array
+
 // readUI32 __
 func (f *pdfTTFont) readUI32(rd *bytes.Reader) uint32 {
 	ar := f.read(rd, 4)
@@ -330,4 +2250,211 @@ func (f *pdfTTFont) readUI32(rd *bytes.Reader) uint32 {
 		uint32(ar[2])<<8 | uint32(ar[3])
 } //                                                                    readUI32
 
+// seek moves the reader to 'offset' bytes from the start of the font data
+func (f *pdfTTFont) seek(rd *bytes.Reader, offset int64) {
+	if f.Err != nil {
+		return
+	}
+	if _, err := rd.Seek(offset, io.SeekStart); err != nil {
+		f.Err = err
+	}
+} //                                                                        seek
+
+// seekTable moves the reader to the start of the table named 'tag'.
+// Returns false if the table is missing, which is an error only
+// if 'required' is true.
+func (f *pdfTTFont) seekTable(rd *bytes.Reader, tag string,
+	required bool) bool {
+	if f.Err != nil {
+		return false
+	}
+	table, found := f.Tables[tag]
+	if !found {
+		if required {
+			f.Err = pdfError{id: 0xE3C81F, msg: "Missing font table", val: tag}
+		}
+		return false
+	}
+	f.seek(rd, int64(table.Offset))
+	return f.Err == nil
+} //                                                                   seekTable
+
+// table returns the data of the table named 'tag', or nil if not found
+func (f *pdfTTFont) table(tag string) []byte {
+	table, found := f.Tables[tag]
+	if !found {
+		return nil
+	}
+	return f.Data[table.Offset : table.Offset+table.Length]
+} //                                                                       table
+
+// unitsToPt1000 converts font design units to thousandths of a point (at
+// a font size of 1 point), which PDF uses for glyph widths and metrics
+func (f *pdfTTFont) unitsToPt1000(units int) int {
+	ret := float64(units) * 1000 / float64(f.HEAD.UnitsPerEm)
+	if ret < 0 {
+		return int(ret - 0.5)
+	}
+	return int(ret + 0.5)
+} //                                                               unitsToPt1000
+
+// -----------------------------------------------------------------------------
+// # Functions
+
+// cffDict encodes the operators of a CFF DICT. The operands of operators
+// listed in 'offsets' are replaced with the listed values, each encoded
+// in 5 bytes, so that the size of the DICT doesn't depend on the values.
+// Operators listed with nil values are left out.
+func cffDict(ops []pdfCFFOp, offsets map[int][]int) []byte {
+	var buf bytes.Buffer
+	for _, op := range ops {
+		vals, found := offsets[op.Op]
+		switch {
+		case !found:
+			buf.Write(op.Raw)
+		case vals == nil:
+			continue
+		default:
+			for _, val := range vals {
+				buf.Write([]byte{29, byte(val >> 24), byte(val >> 16),
+					byte(val >> 8), byte(val)})
+			}
+		}
+		if op.Op >= 1200 {
+			buf.Write([]byte{12, byte(op.Op - 1200)})
+			continue
+		}
+		buf.WriteByte(byte(op.Op))
+	}
+	return buf.Bytes()
+} //                                                                     cffDict
+
+// cffIndex encodes 'items' as a CFF INDEX structure.
+// In CFF2 tables, the count of items is a 32-bit number.
+func cffIndex(items [][]byte, cff2 bool) []byte {
+	var (
+		buf   bytes.Buffer
+		count = []byte{byte(len(items) >> 24), byte(len(items) >> 16),
+			byte(len(items) >> 8), byte(len(items))}
+	)
+	if !cff2 {
+		count = count[2:]
+	}
+	buf.Write(count)
+	if len(items) == 0 {
+		return buf.Bytes()
+	}
+	last := 1 // the last offset, which is 1 + size of all items
+	for _, item := range items {
+		last += len(item)
+	}
+	offSize := 1
+	for limit := 0xFF; last > limit; limit = limit<<8 | 0xFF {
+		offSize++
+	}
+	buf.WriteByte(byte(offSize))
+	for i, offset := 0, 1; i <= len(items); i++ {
+		for shift := (offSize - 1) * 8; shift >= 0; shift -= 8 {
+			buf.WriteByte(byte(offset >> shift))
+		}
+		if i < len(items) {
+			offset += len(items[i])
+		}
+	}
+	for _, item := range items {
+		buf.Write(item)
+	}
+	return buf.Bytes()
+} //                                                                    cffIndex
+
+// ttfChecksum returns the checksum of a table or font: the sum
+// of all 32-bit words in the data, padded with zeros if needed
+func ttfChecksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+} //                                                                 ttfChecksum
+
+// ttfJoiningType returns the Arabic joining type of rune 'r': 'D' (dual
+// joining), 'R' (joins to the previous letter only), 'C' (join causing,
+// e.g. tatweel), 'T' (transparent, e.g. vowel marks) or 'U' (non-joining)
+func ttfJoiningType(r rune) byte {
+	switch {
+	case r == 0x640, r == 0x200D:
+		return 'C'
+	case r != 0x200C && unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 'T'
+	case r >= 0x622 && r <= 0x625, r == 0x627, r == 0x629,
+		r >= 0x62F && r <= 0x632, r == 0x648, r >= 0x671 && r <= 0x673,
+		r >= 0x675 && r <= 0x677, r >= 0x688 && r <= 0x699, r == 0x6C0,
+		r >= 0x6C3 && r <= 0x6CB, r == 0x6CD, r == 0x6CF, r == 0x6D2,
+		r == 0x6D3, r == 0x6D5, r == 0x6EE, r == 0x6EF,
+		r >= 0x759 && r <= 0x75B, r == 0x76B, r == 0x76C, r == 0x771,
+		r == 0x773, r == 0x774, r == 0x778, r == 0x779,
+		r >= 0x8AA && r <= 0x8AC, r == 0x8AE, r == 0x8B1, r == 0x8B2,
+		r == 0x8B9:
+		return 'R'
+	case r == 0x620, r == 0x626, r == 0x628, r >= 0x62A && r <= 0x62E,
+		r >= 0x633 && r <= 0x63F, r >= 0x641 && r <= 0x647, r == 0x649,
+		r == 0x64A, r == 0x66E, r == 0x66F, r >= 0x678 && r <= 0x687,
+		r >= 0x69A && r <= 0x6BF, r == 0x6C1, r == 0x6C2, r == 0x6CC,
+		r == 0x6CE, r == 0x6D0, r == 0x6D1, r >= 0x6FA && r <= 0x6FC,
+		r == 0x6FF, r >= 0x750 && r <= 0x77F, r >= 0x7CA && r <= 0x7EA,
+		r >= 0x8A0 && r <= 0x8C8:
+		return 'D'
+	}
+	return 'U'
+} //                                                              ttfJoiningType
+
+// ttfPackTables assembles an sfnt font file from 'tables'. The table
+// directory is sorted by tag, and each table is aligned to 4 bytes.
+func ttfPackTables(version []byte, tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	n := len(tags)
+	pow2 := 1 // largest power of 2 <= number of tables
+	for pow2*2 <= n {
+		pow2 *= 2
+	}
+	var (
+		buf    bytes.Buffer
+		word   = make([]byte, 4)
+		offset = 12 + 16*n
+		log2   = 0
+	)
+	for i := pow2; i > 1; i /= 2 {
+		log2++
+	}
+	buf.Write(version)
+	for _, val := range []int{n, pow2 * 16, log2, n*16 - pow2*16} {
+		binary.BigEndian.PutUint16(word, uint16(val))
+		buf.Write(word[:2])
+	}
+	for _, tag := range tags {
+		table := tables[tag]
+		buf.WriteString(tag)
+		for _, val := range []uint32{
+			ttfChecksum(table), uint32(offset), uint32(len(table)),
+		} {
+			binary.BigEndian.PutUint32(word, val)
+			buf.Write(word)
+		}
+		offset += (len(table) + 3) &^ 3
+	}
+	for _, tag := range tags {
+		buf.Write(tables[tag])
+		for buf.Len()%4 != 0 {
+			buf.WriteByte(0)
+		}
+	}
+	return buf.Bytes()
+} //                                                               ttfPackTables
+
 // end
@@ -0,0 +1,1171 @@
+// -----------------------------------------------------------------------------
+// github.com/balacode/one-file-pdf                 one-file-pdf/[pdf_ttfont_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package pdf
+
+// # Font Handler Tests:
+//   Test_pdfTTFont_readFont_
+//   Test_pdfTTFont_textWidthPt_
+//   Test_pdfTTFont_writeText_
+//   Test_pdfTTFont_writeFontObjects_
+//   Test_pdfTTFont_subsetFont_
+//   Test_pdfTTFont_usedGlyphs_
+//   Test_pdfTTFont_readCFF_
+//   Test_pdfTTFont_subsetCFF_
+//   Test_pdfTTFont_readTTF_collection_
+//   Test_pdfTTFont_readWOFF_
+//   Test_pdfTTFont_kerning_
+//   Test_pdfTTFont_substitute_
+//   Test_pdfTTFont_shape_
+//
+// # Test Font Builders
+//   tBuildCollection(fonts ...[]byte) []byte
+//   tBuildFont(version string, tables map[string][]byte) []byte
+//   tBuildGSUB(tags []string, kinds []uint16, subs ...[]byte) []byte
+//   tBuildWOFF(font []byte) []byte
+//   tCFFTable(name string, numGlyphs int, kind string) []byte
+//   tGPOSTable() []byte
+//   tGSUBTable() []byte
+//   tKernTable(pairs ...int) []byte
+//   tOpenTypeFont(name, chars, kind string) []byte
+//   tTrueTypeFont(name, chars string, widths ...uint16) []byte
+//   tReadTables(data []byte) map[string][]byte
+//   tTrueTypeTables(name, chars string, widths ...uint16) map[string][]byte
+//   tVerifyXref(t *testing.T, pdf []byte)
+//   tWrite(buf *bytes.Buffer, values ...interface{})
+
+//  This file contains unit tests for the TrueType font handler.
+//  The fonts used in these tests are built in memory by the
+//  test font builders, so no font files are needed.
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Test_pdfTTFont_readFont_ tests parsing of TrueType tables by readFont()
+func Test_pdfTTFont_readFont_(t *testing.T) {
+	//
+	// read a font from a slice of bytes
+	func() {
+		var doc PDF
+		f := &pdfTTFont{}
+		ok := f.readFont(&doc, tTrueTypeFont("TestSans", "AB€😀", 600, 700))
+		tEqual(t, ok, true)
+		tEqual(t, len(doc.Errors()), 0)
+		tEqual(t, f.NAME.PostScriptName, "TestSans")
+		tEqual(t, f.HEAD.UnitsPerEm, 1000)
+		tEqual(t, f.HEAD.YMin, -200)
+		tEqual(t, f.HEAD.YMax, 800)
+		tEqual(t, f.HHEA.Ascent, 800)
+		tEqual(t, f.HHEA.Descent, -200)
+		tEqual(t, f.MAXP.NumGlyphs, 5)
+		tEqual(t, f.OS2.STypoAscender, 750)
+		tEqual(t, f.OS2.STypoDescender, -250)
+		tEqual(t, f.OS2.STypoLineGap, 200)
+		tEqual(t, f.POST.ItalicAngle, -12)
+		tEqual(t, len(f.LOCA), 6)
+		tEqual(t, len(f.HMTX.Widths), 5)
+		// glyphs after the last width use the last width (700)
+		for i, want := range []uint16{500, 600, 700, 700, 700} {
+			tEqual(t, f.HMTX.Widths[i], want)
+		}
+		// BMP runes come from format 4, the emoji from format 12
+		for r, want := range map[rune]uint16{'A': 1, 'B': 2, '€': 3, '😀': 4} {
+			glyph, found := f.glyph(r)
+			tEqual(t, found, true)
+			tEqual(t, glyph, want)
+		}
+		_, found := f.glyph('Z')
+		tEqual(t, found, false)
+	}()
+	// read a font from a file, then from the same file again:
+	// the font should only be parsed once and added to p.fonts once
+	func() {
+		filename := filepath.Join(t.TempDir(), "test.ttf")
+		err := os.WriteFile(filename, tTrueTypeFont("TestSans", "Hi"), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		doc := NewPDF("A4")
+		doc.SetFont(filename, 10).DrawText("Hi").DrawText("iH")
+		failIfHasErrors(t, doc.Errors)
+		tEqual(t, len(doc.fonts), 1)
+		tEqual(t, doc.fonts[0].name, filename)
+		tEqual(t, doc.FontName(), filename)
+	}()
+	// a font name that is neither built-in nor an existing file
+	// should only log 'Invalid font' and revert to Helvetica
+	func() {
+		doc := NewPDF("A4")
+		doc.SetFont("NoSuchFont.ttf", 10).DrawText("Hi")
+		tEqual(t, len(doc.Errors()), 1)
+		tEqual(t, doc.PullError(),
+			`Invalid font "NoSuchFont.ttf" @DrawText`)
+		tEqual(t, doc.FontName(), "Helvetica")
+	}()
+	// data that is not a TrueType font should log an error
+	func() {
+		var doc PDF
+		f := &pdfTTFont{}
+		tEqual(t, f.readFont(&doc, []byte("%!PS-AdobeFont-1.0")), false)
+		tEqual(t, len(doc.Errors()), 1)
+		tEqual(t, doc.ErrorInfo(doc.PullError()).Msg,
+			"Unsupported font format")
+	}()
+	// a font without a required table (hmtx) should log an error
+	func() {
+		var doc PDF
+		f := &pdfTTFont{}
+		tables := tTrueTypeTables("TestSans", "A")
+		delete(tables, "hmtx")
+		data := tBuildFont("\x00\x01\x00\x00", tables)
+		tEqual(t, f.readFont(&doc, data), false)
+		info := doc.ErrorInfo(doc.PullError())
+		tEqual(t, info.Msg, "Missing font table")
+		tEqual(t, info.Val, fmt.Sprintf("hmtx in []byte len(%d)", len(data)))
+	}()
+} //                                                    Test_pdfTTFont_readFont_
+
+// Test_pdfTTFont_textWidthPt_ tests text width measurement using
+// the CMAP and HMTX tables, at different units-per-em and font sizes
+func Test_pdfTTFont_textWidthPt_(t *testing.T) {
+	doc := NewPDF("A4")
+	doc.SetFont("Helvetica", 20)
+	f := &pdfTTFont{}
+	f.readFont(&doc, tTrueTypeFont("TestSans", "AB", 600, 700))
+	tEqual(t, f.textWidthPt(""), 0)
+	tEqual(t, f.textWidthPt("A"), 12)  // 600/1000 * 20pt
+	tEqual(t, f.textWidthPt("AB"), 26) // (600+700)/1000 * 20pt
+	tEqual(t, f.textWidthPt("Z"), 10)  // .notdef is 500 units wide
+	doc.SetHorizontalScaling(50)
+	tEqual(t, f.textWidthPt("AB"), 13)
+	doc.SetHorizontalScaling(100)
+	//
+	// the same font with 2048 units per em
+	tables := tTrueTypeTables("TestSans", "AB", 1228, 1434)
+	binary.BigEndian.PutUint16(tables["head"][18:], 2048)
+	f = &pdfTTFont{}
+	f.readFont(&doc, tBuildFont("\x00\x01\x00\x00", tables))
+	tEqual(t, f.textWidthPt("AB"), 25.9961) // 2662/2048 * 20pt
+} //                                                 Test_pdfTTFont_textWidthPt_
+
+// Test_pdfTTFont_writeText_ tests that text is written as glyph indices
+func Test_pdfTTFont_writeText_(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "test.ttf")
+	err := os.WriteFile(filename, tTrueTypeFont("TestSans", "Helo"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("cm").SetFont(filename, 10).
+		SetXY(1, 1).DrawText("Hello").DrawText("!")
+	got := doc.pages[0].content.String()
+	tEqual(t, strings.Contains(got,
+		"BT 28.346 813.543 Td [<00010002000300030004>] TJ ET"), true)
+	// '!' is not in the font: .notdef is written and an error logged
+	tEqual(t, strings.Contains(got, "[<0000>] TJ ET"), true)
+	tEqual(t, len(doc.Errors()), 1)
+	tEqual(t, doc.PullError(), `Glyph not in font "at 0 = '!'" @DrawText`)
+} //                                                   Test_pdfTTFont_writeText_
+
+// Test_pdfTTFont_writeFontObjects_ tests the objects of an embedded font
+func Test_pdfTTFont_writeFontObjects_(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "test.ttf")
+	err := os.WriteFile(filename, tTrueTypeFont("Test Sans", "Hel€o"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetFont(filename, 10).SetXY(1, 1).
+		DrawText("Hello").SetFont("Helvetica", 10).DrawText("Hi")
+	ar := doc.Bytes()
+	failIfHasErrors(t, doc.Errors)
+	tVerifyXref(t, ar)
+	got := string(ar)
+	//
+	// the subset tag is six letters derived from the glyphs,
+	// spaces are removed from the PostScript name
+	f := doc.fonts[0].handler.(*pdfTTFont)
+	name := f.subsetName([]uint16{0, 1, 2, 3, 5})
+	tEqual(t, regexp.MustCompile(`^[A-Z]{6}\+TestSans$`).MatchString(name),
+		true)
+	for _, want := range []string{
+		// fonts start at object 5, after the catalog, pages and one page;
+		// the other objects of the embedded font follow all the fonts
+		"5 0 obj <</Type/Font/Subtype/Type0/BaseFont/" + name + "\n" +
+			"/Encoding/Identity-H/DescendantFonts[7 0 R]\n" +
+			"/ToUnicode 9 0 R>>\n",
+		"6 0 obj <</Type/Font/Subtype/Type1/Name/FNT2\n/BaseFont/Helvetica\n",
+		"7 0 obj <</Type/Font/Subtype/CIDFontType2/BaseFont/" + name + "\n" +
+			"/CIDSystemInfo <</Registry(Adobe)/Ordering(Identity)" +
+			"/Supplement 0>>\n" +
+			"/FontDescriptor 8 0 R/CIDToGIDMap/Identity\n" +
+			"/W[0 [500 600 600 600]\n5 [600]]>>\n",
+		"8 0 obj <</Type/FontDescriptor/FontName/" + name + "/Flags 68\n" +
+			"/FontBBox[0 -200 1000 800]/ItalicAngle -12\n" +
+			"/Ascent 800/Descent -200/CapHeight 700/StemV 87\n" +
+			"/FontFile2 10 0 R>>\n",
+		"9 0 obj <</Length ",
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n" +
+			"4 beginbfchar\n" +
+			"<0001> <0048>\n<0002> <0065>\n<0003> <006C>\n<0005> <006F>\n" +
+			"endbfchar\n",
+		"10 0 obj <</Length1 ",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("PDF does not contain %q", want)
+		}
+	}
+	// the font file is the subset font
+	i := strings.Index(got, "10 0 obj <</Length1 ")
+	var length1, length int
+	fmt.Sscanf(got[i:], "10 0 obj <</Length1 %d/Length %d>> stream\n",
+		&length1, &length)
+	tEqual(t, length1, length)
+	i += strings.Index(got[i:], "stream\n") + len("stream\n")
+	tEqual(t, bytes.Equal(ar[i:i+length],
+		f.subsetFont([]uint16{0, 1, 2, 3, 5})), true)
+	//
+	// a font that must not be embedded is written without a font file
+	tables := tTrueTypeTables("TestSans", "A")
+	binary.BigEndian.PutUint16(tables["OS/2"][8:], 0x0002) // fsType
+	os.WriteFile(filename, tBuildFont("\x00\x01\x00\x00", tables), 0644)
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetFont(filename, 10).DrawText("A")
+	got = string(doc.Bytes())
+	tEqual(t, strings.Contains(got, "/FontFile2"), false)
+	tEqual(t, strings.Contains(got, "/StemV 87>>\n"), true)
+	tEqual(t, doc.ErrorInfo(doc.PullError()).Msg,
+		"Font license does not allow embedding")
+} //                                            Test_pdfTTFont_writeFontObjects_
+
+// Test_pdfTTFont_subsetFont_ tests that a subset font keeps the glyph
+// indices, keeps only the used outlines, and has valid checksums
+func Test_pdfTTFont_subsetFont_(t *testing.T) {
+	var doc PDF
+	f := &pdfTTFont{}
+	f.readFont(&doc, tTrueTypeFont("TestSans", "ABC", 600, 700, 800))
+	data := f.subsetFont([]uint16{0, 2})
+	//
+	// the checksum of the whole font must be 0xB1B0AFBA
+	tEqual(t, ttfChecksum(data), 0xB1B0AFBA)
+	//
+	// the subset has no cmap (CIDs are glyph indices), so
+	// add the original cmap to be able to read the subset
+	tables := tReadTables(data)
+	tables["cmap"] = f.table("cmap")
+	sub := &pdfTTFont{}
+	tEqual(t, sub.readFont(&doc, tBuildFont("\x00\x01\x00\x00", tables)),
+		true)
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, sub.HEAD.UnitsPerEm, 1000)
+	tEqual(t, sub.HEAD.IndexToLocFormat, 1)
+	tEqual(t, sub.MAXP.NumGlyphs, 4)
+	tEqual(t, len(sub.LOCA), 5)
+	tEqual(t, len(sub.HMTX.Widths), 4)
+	tEqual(t, sub.HMTX.Widths[3], 800)
+	tEqual(t, len(sub.glyphData(1)), 0)
+	// glyph data is padded to 4 bytes
+	tEqual(t, len(f.glyphData(2)), 34)
+	tEqual(t, len(sub.glyphData(2)), 36)
+	tEqual(t, bytes.HasPrefix(sub.glyphData(2), f.glyphData(2)), true)
+	tEqual(t, len(sub.glyphData(3)), 0)
+	//
+	// each table's checksum must be correct
+	tags := []string{"glyf", "head", "hhea", "hmtx", "loca", "maxp"}
+	tEqual(t, len(tables), len(tags)+1)
+	for _, tag := range tags {
+		table, found := tables[tag]
+		tEqual(t, found, true)
+		if tag == "head" {
+			continue // the checksum ignores checkSumAdjustment
+		}
+		i := bytes.Index(data[:12+16*len(tags)], []byte(tag))
+		tEqual(t, binary.BigEndian.Uint32(data[i+4:]), ttfChecksum(table))
+	}
+} //                                                  Test_pdfTTFont_subsetFont_
+
+// Test_pdfTTFont_usedGlyphs_ tests that used glyphs include
+// .notdef and the components of composite glyphs
+func Test_pdfTTFont_usedGlyphs_(t *testing.T) {
+	var doc PDF
+	tables := tTrueTypeTables("TestSans", "ABCD")
+	//
+	// make glyph 4 ('D') a composite of glyphs 1 and 3
+	var glyf, loca bytes.Buffer
+	simple := tables["glyf"][:len(tables["glyf"])/4]
+	for i := 0; i < 4; i++ {
+		tWrite(&loca, uint32(glyf.Len()))
+		if i > 0 {
+			glyf.Write(simple)
+		}
+	}
+	tWrite(&loca, uint32(glyf.Len()))
+	tWrite(&glyf, int16(-1), [4]int16{},
+		uint16(0x0020|0x0001), uint16(1), int16(0), int16(0), // more, words
+		uint16(0x0008), uint16(3), int8(10), int8(0), int16(0x4000)) // scale
+	tWrite(&loca, uint32(glyf.Len()))
+	tables["glyf"], tables["loca"] = glyf.Bytes(), loca.Bytes()
+	//
+	f := &pdfTTFont{}
+	f.readFont(&doc, tBuildFont("\x00\x01\x00\x00", tables))
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, fmt.Sprint(f.usedGlyphs()), "[0]")
+	f.Used = map[uint16]string{4: "D"}
+	tEqual(t, fmt.Sprint(f.usedGlyphs()), "[0 1 3 4]")
+	f.Used = map[uint16]string{2: "B", 1: "A"}
+	tEqual(t, fmt.Sprint(f.usedGlyphs()), "[0 1 2]")
+	tEqual(t, f.widthsArray(f.usedGlyphs()), "0 [500 600 600]")
+	tEqual(t, f.widthsArray([]uint16{0, 2, 4}), "0 [500]\n2 [600]\n4 [600]")
+} //                                                  Test_pdfTTFont_usedGlyphs_
+
+// Test_pdfTTFont_readCFF_ tests reading OpenType fonts with CFF outlines
+func Test_pdfTTFont_readCFF_(t *testing.T) {
+	for _, kind := range []string{"CFF", "CID", "CFF2"} {
+		var doc PDF
+		f := &pdfTTFont{}
+		ok := f.readFont(&doc, tOpenTypeFont("TestSerif", "AB€", kind))
+		tEqual(t, ok, true)
+		failIfHasErrors(t, doc.Errors)
+		tEqual(t, f.NAME.PostScriptName, "TestSerif")
+		tEqual(t, f.CFF.Version, map[string]int{"CFF": 1, "CID": 1,
+			"CFF2": 2}[kind])
+		tEqual(t, len(f.CFF.CharStrings), 4)
+		tEqual(t, len(f.CFF.Privates), 1)
+		tEqual(t, len(f.CFF.Privates[0].Subrs) > 0, true)
+		tEqual(t, len(f.CFF.FDArray), map[string]int{"CID": 1, "CFF2": 1}[kind])
+		tEqual(t, f.CFF.FDSelect != nil, kind == "CID")
+		tEqual(t, f.CFF.Charset != nil, kind != "CFF2")
+		tEqual(t, len(f.LOCA), 0)
+		glyph, found := f.glyph('€')
+		tEqual(t, found, true)
+		tEqual(t, glyph, 3)
+	}
+	// an OpenType font with CFF outlines must have a CFF table
+	var doc PDF
+	tables := tTrueTypeTables("TestSerif", "A")
+	delete(tables, "glyf")
+	delete(tables, "loca")
+	f := &pdfTTFont{}
+	tEqual(t, f.readFont(&doc, tBuildFont("OTTO", tables)), false)
+	info := doc.ErrorInfo(doc.PullError())
+	tEqual(t, info.Msg, "Missing font table")
+	tEqual(t, strings.HasPrefix(info.Val, "CFF  in []byte"), true)
+	//
+	// the number of glyphs must match 'maxp'
+	tables["CFF "] = tCFFTable("TestSerif", 3, "CFF")
+	tEqual(t, f.readFont(&doc, tBuildFont("OTTO", tables)), false)
+	tEqual(t, doc.ErrorInfo(doc.PullError()).Msg, "Invalid CFF glyph count")
+} //                                                     Test_pdfTTFont_readCFF_
+
+// Test_pdfTTFont_subsetCFF_ tests subsetting and embedding of CFF fonts
+func Test_pdfTTFont_subsetCFF_(t *testing.T) {
+	for _, kind := range []string{"CFF", "CID", "CFF2"} {
+		var doc PDF
+		f := &pdfTTFont{}
+		f.readFont(&doc, tOpenTypeFont("TestSerif", "AB€", kind))
+		data := f.subsetCFF([]uint16{0, 2})
+		//
+		// read the subset CFF table in place of the original
+		tag := map[bool]string{true: "CFF2", false: "CFF "}[kind == "CFF2"]
+		tables := tTrueTypeTables("TestSerif", "AB€")
+		delete(tables, "glyf")
+		delete(tables, "loca")
+		tables[tag] = data
+		sub := &pdfTTFont{}
+		tEqual(t, sub.readFont(&doc, tBuildFont("OTTO", tables)), true)
+		failIfHasErrors(t, doc.Errors)
+		empty := []byte{14} // endchar
+		if kind == "CFF2" {
+			empty = []byte{}
+		}
+		for g, want := range [][]byte{f.CFF.CharStrings[0], empty,
+			f.CFF.CharStrings[2], empty} {
+			tEqual(t, bytes.Equal(sub.CFF.CharStrings[g], want), true)
+		}
+		tEqual(t, bytes.Equal(sub.CFF.Names, f.CFF.Names), true)
+		tEqual(t, bytes.Equal(sub.CFF.Strings, f.CFF.Strings), true)
+		tEqual(t, bytes.Equal(sub.CFF.FDSelect, f.CFF.FDSelect), true)
+		tEqual(t, bytes.Equal(sub.CFF.Privates[0].Subrs,
+			f.CFF.Privates[0].Subrs), true)
+		switch kind {
+		case "CFF": // name-keyed fonts keep their charset
+			tEqual(t, bytes.Equal(sub.CFF.Charset, f.CFF.Charset), true)
+		case "CID": // CID-keyed fonts get an identity charset: CID = glyph
+			tEqual(t, fmt.Sprint(sub.CFF.Charset), "[2 0 1 0 2]")
+		}
+	}
+	// embed the font as CIDFontType0 in an OpenType font program
+	filename := filepath.Join(t.TempDir(), "test.otf")
+	err := os.WriteFile(filename, tOpenTypeFont("TestSerif", "Hi", "CID"),
+		0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetFont(filename, 10).DrawText("Hi")
+	ar := doc.Bytes()
+	failIfHasErrors(t, doc.Errors)
+	tVerifyXref(t, ar)
+	got := string(ar)
+	f := doc.fonts[0].handler.(*pdfTTFont)
+	name := f.subsetName([]uint16{0, 1, 2})
+	for _, want := range []string{
+		"%PDF-1.6\n",
+		"6 0 obj <</Type/Font/Subtype/CIDFontType0/BaseFont/" + name + "\n" +
+			"/CIDSystemInfo <</Registry(Adobe)/Ordering(Identity)" +
+			"/Supplement 0>>\n" +
+			"/FontDescriptor 7 0 R\n" +
+			"/W[0 [500 600 600]]>>\n",
+		"/FontFile3 9 0 R>>\n",
+		"9 0 obj <</Subtype/OpenType/Length ",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("PDF does not contain %q", want)
+		}
+	}
+	i := strings.Index(got, "9 0 obj <</Subtype/OpenType/Length ")
+	i += strings.Index(got[i:], "stream\n") + len("stream\n")
+	program := f.subsetFont([]uint16{0, 1, 2})
+	tEqual(t, bytes.Equal(ar[i:i+len(program)], program), true)
+	tEqual(t, string(program[:4]), "OTTO")
+	tEqual(t, ttfChecksum(program), 0xB1B0AFBA)
+	tables := tReadTables(program)
+	tEqual(t, len(tables), 5) // CFF, head, hhea, hmtx, maxp
+	tEqual(t, bytes.Equal(tables["CFF "], f.subsetCFF([]uint16{0, 1, 2})),
+		true)
+} //                                                   Test_pdfTTFont_subsetCFF_
+
+// Test_pdfTTFont_readTTF_collection_ tests selecting a font
+// from a font collection by index or PostScript name
+func Test_pdfTTFont_readTTF_collection_(t *testing.T) {
+	ttc := tBuildCollection(tTrueTypeFont("TestSans", "A"),
+		tOpenTypeFont("TestSerif", "AB", "CFF"))
+	for _, test := range []struct {
+		face, name, err string
+	}{
+		{"", "TestSans", ""},
+		{"0", "TestSans", ""},
+		{"1", "TestSerif", ""},
+		{"TestSerif", "TestSerif", ""},
+		{"2", "", `Font not in collection "\"2\" of 2 in []byte len(`},
+		{"Nope", "", `Font not in collection "\"Nope\" of 2 in []byte len(`},
+	} {
+		var doc PDF
+		f := &pdfTTFont{Face: test.face}
+		tEqual(t, f.readFont(&doc, ttc), test.err == "")
+		if test.err != "" {
+			tEqual(t, strings.HasPrefix(doc.PullError().Error(), test.err), true)
+			continue
+		}
+		failIfHasErrors(t, doc.Errors)
+		tEqual(t, f.NAME.PostScriptName, test.name)
+		tEqual(t, f.CFF.Version, map[string]int{"TestSerif": 1}[test.name])
+		tEqual(t, int(f.MAXP.NumGlyphs), len(f.HMTX.Widths))
+	}
+	// select a font in a collection file using '#'
+	filename := filepath.Join(t.TempDir(), "test.ttc")
+	if err := os.WriteFile(filename, ttc, 0644); err != nil {
+		t.Fatal(err)
+	}
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetFont(filename+"#TestSerif", 10).
+		DrawText("BA")
+	ar := doc.Bytes()
+	failIfHasErrors(t, doc.Errors)
+	tVerifyXref(t, ar)
+	tEqual(t, doc.FontName(), filename+"#TestSerif")
+	tEqual(t, strings.Contains(string(ar), "/Subtype/CIDFontType0/"), true)
+	tEqual(t, strings.Contains(string(ar), "+TestSerif"), true)
+} //                                          Test_pdfTTFont_readTTF_collection_
+
+// Test_pdfTTFont_readWOFF_ tests reading of fonts in WOFF files
+func Test_pdfTTFont_readWOFF_(t *testing.T) {
+	for _, font := range [][]byte{
+		tTrueTypeFont("TestSans", "AB€", 600, 700),
+		tOpenTypeFont("TestSerif", "AB€", "CFF"),
+	} {
+		var doc PDF
+		sfnt, woff := &pdfTTFont{}, &pdfTTFont{}
+		tEqual(t, sfnt.readFont(&doc, font), true)
+		tEqual(t, woff.readFont(&doc, tBuildWOFF(font)), true)
+		failIfHasErrors(t, doc.Errors)
+		tEqual(t, woff.NAME.PostScriptName, sfnt.NAME.PostScriptName)
+		tEqual(t, woff.CFF.Version, sfnt.CFF.Version)
+		tEqual(t, woff.HMTX.Widths, sfnt.HMTX.Widths)
+		tEqual(t, woff.CMAP.Chars, sfnt.CMAP.Chars)
+		//
+		// the WOFF font is subset like the font it contains
+		glyphs := []uint16{0, 1, 3}
+		tEqual(t, bytes.Equal(woff.subsetFont(glyphs),
+			sfnt.subsetFont(glyphs)), true)
+	}
+	// a WOFF file is used like any other font file
+	filename := filepath.Join(t.TempDir(), "test.woff")
+	woff := tBuildWOFF(tTrueTypeFont("TestSans", "AB€", 600, 700))
+	if err := os.WriteFile(filename, woff, 0644); err != nil {
+		t.Fatal(err)
+	}
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetFont(filename, 10).DrawText("A€")
+	ar := doc.Bytes()
+	failIfHasErrors(t, doc.Errors)
+	tVerifyXref(t, ar)
+	tEqual(t, strings.Contains(string(ar), "+TestSans"), true)
+	tEqual(t, strings.Contains(string(ar), "/FontFile2 "), true)
+	//
+	// invalid WOFF data: missing table directory, or compressed tables
+	// with invalid zlib headers
+	corrupt := append([]byte{}, woff...)
+	for i := 0; i < int(binary.BigEndian.Uint16(woff[12:])); i++ {
+		entry := woff[44+20*i:]
+		offset := binary.BigEndian.Uint32(entry[4:])
+		if binary.BigEndian.Uint32(entry[8:]) <
+			binary.BigEndian.Uint32(entry[12:]) {
+			corrupt[offset] = 0
+		}
+	}
+	for _, tc := range []struct {
+		data []byte
+		want string
+	}{
+		{woff[:44], "Invalid WOFF font"},
+		{corrupt, "Failed decompressing"},
+	} {
+		var doc PDF
+		tEqual(t, (&pdfTTFont{}).readFont(&doc, tc.data), false)
+		tEqual(t, doc.ErrorInfo(doc.PullError()).Msg, tc.want)
+	}
+} //                                                    Test_pdfTTFont_readWOFF_
+
+// Test_pdfTTFont_kerning_ tests kerning from 'kern' and GPOS tables
+func Test_pdfTTFont_kerning_(t *testing.T) {
+	// glyphs: A=1 V=2 T=3 o=4
+	tables := tTrueTypeTables("TestSans", "AVTo", 600)
+	tables["kern"] = tKernTable(1, 2, -50, 3, 4, -40)
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10)
+	f := &pdfTTFont{}
+	tEqual(t, f.readFont(&doc, tBuildFont("\x00\x01\x00\x00", tables)), true)
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, f.kerning(1, 2), -50)
+	tEqual(t, f.kerning(2, 1), 0)
+	tEqual(t, f.kerning(3, 4), -40)
+	tEqual(t, f.textWidthPt("AV"), 11.5)   // (600+600-50)/1000 * 10pt
+	tEqual(t, f.textWidthPt("VA"), 12)     // no kerning
+	tEqual(t, f.textWidthPt("AVTo"), 23.1) // (2400-50-40)/1000 * 10pt
+	//
+	// kerning is written as numbers in the TJ array
+	doc.RegisterFont("Kern", tBuildFont("\x00\x01\x00\x00", tables)).
+		SetFont("Kern", 10).SetXY(10, 10).DrawText("AVTo")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, strings.Contains(doc.pages[0].content.String(),
+		"[<0001>50.000<00020003>40.000<0004>] TJ ET"), true)
+	//
+	// GPOS kerning takes precedence over the 'kern' table:
+	// a pair in an extension lookup and a class pair
+	tables["GPOS"] = tGPOSTable()
+	f = &pdfTTFont{}
+	tEqual(t, f.readFont(&doc, tBuildFont("\x00\x01\x00\x00", tables)), true)
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, len(f.KERN.Lookups), 2)
+	tEqual(t, f.kerning(1, 2), -80)
+	tEqual(t, f.kerning(3, 4), -60)
+	tEqual(t, f.kerning(3, 2), -20) // both lookups apply
+	tEqual(t, f.kerning(4, 3), 0)
+	tEqual(t, f.textWidthPt("AVTo"), 22.6) // (2400-80-60)/1000 * 10pt
+	//
+	// kerned text is aligned using its kerned width:
+	// x = 100pt box width - 22.6pt text width - 10pt/6 margin
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").
+		RegisterFont("Kern", tBuildFont("\x00\x01\x00\x00", tables)).
+		SetFont("Kern", 10).DrawTextInBox(0, 0, 100, 20, "RT", "AVTo")
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, strings.Contains(doc.pages[0].content.String(),
+		"BT 75.733 "), true)
+	tEqual(t, strings.Contains(doc.pages[0].content.String(),
+		"[<0001>80.000<00020003>60.000<0004>] TJ ET"), true)
+} //                                                     Test_pdfTTFont_kerning_
+
+// Test_pdfTTFont_substitute_ tests glyph substitution using GSUB lookups
+// of OpenType features turned on and off with SetFontFeatures()
+func Test_pdfTTFont_substitute_(t *testing.T) {
+	// glyphs: f=1 i=2 l=3 1=4, ligatures fi=5 fl=6, tabular 1=7,
+	// alternates of f=8 and 9 (see tGSUBTable)
+	tables := tTrueTypeTables("TestSans", "fil1ABCDE",
+		600, 300, 300, 500, 800, 850, 600, 700, 750)
+	tables["GSUB"] = tGSUBTable()
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10)
+	f := &pdfTTFont{}
+	tEqual(t, f.readFont(&doc, tBuildFont("\x00\x01\x00\x00", tables)), true)
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, len(f.GSUB.Lookups), 4)
+	//
+	// ligatures are on by default, other features are off
+	tEqual(t, f.textWidthPt("fi"), 8)     // ligature: 800/1000 * 10pt
+	tEqual(t, f.textWidthPt("fil1"), 16)  // 800 + 300 + 500
+	tEqual(t, f.textWidthPt("fl1"), 13.5) // 850 + 500
+	doc.SetFontFeatures("-liga, tnum")
+	tEqual(t, f.textWidthPt("fi"), 9)   // 600 + 300
+	tEqual(t, f.textWidthPt("fl1"), 15) // 600 + 300 + 600
+	doc.SetFontFeatures("salt smcp")
+	tEqual(t, f.textWidthPt("f"), 7)  // first alternate
+	tEqual(t, f.textWidthPt("l"), 7)  // l + 5 = glyph 8
+	tEqual(t, f.textWidthPt("fi"), 8) // ligatures come first
+	doc.SetFontFeatures("salt=2")
+	tEqual(t, f.textWidthPt("f"), 7.5)
+	doc.SetFontFeatures("salt=9") // the last alternate
+	tEqual(t, f.textWidthPt("f"), 7.5)
+	failIfHasErrors(t, doc.Errors)
+	//
+	// ligatures are written as one glyph, mapped to all their characters
+	doc = NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").
+		RegisterFont("Liga", tBuildFont("\x00\x01\x00\x00", tables)).
+		SetFont("Liga", 10).SetXY(10, 10).DrawText("fil1").
+		SetFontFeatures("tnum").DrawText("fl1")
+	failIfHasErrors(t, doc.Errors)
+	got := doc.pages[0].content.String()
+	tEqual(t, strings.Contains(got, "[<000500030004>] TJ ET"), true)
+	tEqual(t, strings.Contains(got, "[<00060007>] TJ ET"), true)
+	f = doc.fonts[len(doc.fonts)-1].handler.(*pdfTTFont)
+	cmap := string(f.toUnicodeCMap(f.usedGlyphs()))
+	tEqual(t, strings.Contains(cmap, "<0005> <00660069>\n"), true)
+	tEqual(t, strings.Contains(cmap, "<0006> <0066006C>\n"), true)
+	tEqual(t, strings.Contains(cmap, "<0007> <0031>\n"), true)
+} //                                                  Test_pdfTTFont_substitute_
+
+// Test_pdfTTFont_shape_ tests shaping of Arabic letters using their
+// joining forms, and writing right-to-left text
+func Test_pdfTTFont_shape_(t *testing.T) {
+	// glyphs: beh=1 alef=2 tatweel=3 fatha=4, forms of beh: init=5,
+	// medi=6, fina=7 (single substitutions with a delta of 4, 5 and 6),
+	// space=8 a=9 b=10
+	tables := tTrueTypeTables("TestArabic", "\u0628\u0627\u0640\u064Exyz ab",
+		600, 400, 300, 0, 500, 550, 650)
+	var subs [3]bytes.Buffer
+	for i := range subs {
+		tWrite(&subs[i], uint16(1), uint16(6), uint16(4+i),
+			uint16(1), uint16(1), uint16(1))
+	}
+	tables["GSUB"] = tBuildGSUB([]string{"init", "medi", "fina"},
+		[]uint16{1, 1, 1}, subs[0].Bytes(), subs[1].Bytes(), subs[2].Bytes())
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").SetFont("Helvetica", 10)
+	f := &pdfTTFont{}
+	tEqual(t, f.readFont(&doc, tBuildFont("\x00\x01\x00\x00", tables)), true)
+	failIfHasErrors(t, doc.Errors)
+	ids := func(s string) string {
+		ret := ""
+		for _, glyph := range f.shape(s) {
+			ret += fmt.Sprintf("%d%s ", glyph.ID, glyph.Form)
+		}
+		return strings.TrimSpace(ret)
+	}
+	tEqual(t, ids("\u0628"), "1isol") // no 'isol' lookup in the font
+	tEqual(t, ids("\u0628\u0628\u0628"), "5init 6medi 7fina")
+	tEqual(t, ids("\u0628 \u0628"), "1isol 8 1isol")
+	//
+	// alef joins only to the previous letter, tatweel joins on both
+	// sides, vowel marks are transparent
+	tEqual(t, ids("\u0628\u0627\u0628"), "5init 2fina 1isol")
+	tEqual(t, ids("\u0640\u0628\u0640"), "3 6medi 3")
+	tEqual(t, ids("\u0628\u064E\u0628"), "5init 4 7fina")
+	//
+	// joining forms can be turned off
+	doc.SetFontFeatures("-medi")
+	tEqual(t, ids("\u0628\u0628\u0628"), "5init 1medi 7fina")
+	doc.SetFontFeatures("")
+	//
+	// right-to-left text is shaped first, then written in reverse:
+	// the paragraph is right-to-left, so 'ab' is displayed first
+	doc.RegisterFont("Arabic", tBuildFont("\x00\x01\x00\x00", tables)).
+		SetFont("Arabic", 10).SetXY(10, 10).
+		DrawText("\u0628\u0628\u0627 ab")
+	failIfHasErrors(t, doc.Errors)
+	got := doc.pages[0].content.String()
+	tEqual(t, strings.Contains(got,
+		"BT 10.000 831.890 Td [<0009000A>] TJ ET\n"+
+			"BT 23.000 831.890 Td [<0008000200060005>] TJ ET"), true)
+} //                                                       Test_pdfTTFont_shape_
+
+// -----------------------------------------------------------------------------
+// # Test Font Builders
+
+// tBuildCollection assembles a font collection (.ttc file) from font files
+func tBuildCollection(fonts ...[]byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("ttcf")
+	tWrite(&buf, uint32(0x00010000), uint32(len(fonts)))
+	start := 12 + 4*len(fonts)
+	for _, font := range fonts {
+		tWrite(&buf, uint32(start))
+		start += len(font)
+	}
+	for _, font := range fonts {
+		// table offsets are from the start of the collection
+		font = append([]byte{}, font...)
+		count := int(binary.BigEndian.Uint16(font[4:]))
+		for i := 0; i < count; i++ {
+			entry := font[12+16*i+8:]
+			binary.BigEndian.PutUint32(entry,
+				binary.BigEndian.Uint32(entry)+uint32(buf.Len()))
+		}
+		buf.Write(font)
+	}
+	return buf.Bytes()
+} //                                                            tBuildCollection
+
+// tBuildFont assembles an sfnt font file from the given tables.
+// 'version' is the 4-byte sfnt version tag, e.g. "\x00\x01\x00\x00"
+func tBuildFont(version string, tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	var dir, data bytes.Buffer
+	dir.WriteString(version)
+	tWrite(&dir, uint16(len(tags)), uint16(0), uint16(0), uint16(0))
+	offset := 12 + 16*len(tags)
+	for _, tag := range tags {
+		table := tables[tag]
+		dir.WriteString(tag)
+		tWrite(&dir, uint32(0), uint32(offset+data.Len()), uint32(len(table)))
+		data.Write(table)
+		for data.Len()%4 != 0 {
+			data.WriteByte(0)
+		}
+	}
+	return append(dir.Bytes(), data.Bytes()...)
+} //                                                                  tBuildFont
+
+// tBuildGSUB assembles a GSUB table with a feature for each of 'tags'.
+// Each feature uses one lookup of type 'kinds[i]' with subtable 'subs[i]'.
+func tBuildGSUB(tags []string, kinds []uint16, subs ...[]byte) []byte {
+	var gsub bytes.Buffer
+	//
+	// header, empty script list, feature list, lookup list
+	n := len(tags)
+	tWrite(&gsub, uint32(0x00010000), uint16(10), uint16(12),
+		uint16(12+2+12*n), uint16(0), uint16(n))
+	for i, tag := range tags {
+		gsub.WriteString(tag)
+		tWrite(&gsub, uint16(2+6*n+6*i))
+	}
+	for i := range tags {
+		tWrite(&gsub, uint16(0), uint16(1), uint16(i))
+	}
+	tWrite(&gsub, uint16(n))
+	offset := 2 + 2*n
+	for _, sub := range subs {
+		tWrite(&gsub, uint16(offset))
+		offset += 8 + len(sub)
+	}
+	for i, sub := range subs {
+		tWrite(&gsub, kinds[i], uint16(0), uint16(1), uint16(8))
+		gsub.Write(sub)
+	}
+	return gsub.Bytes()
+} //                                                                  tBuildGSUB
+
+// tBuildWOFF converts an sfnt font file to a WOFF font. Tables are
+// compressed if that makes them smaller, as WOFF requires
+func tBuildWOFF(font []byte) []byte {
+	tables := tReadTables(font)
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	var dir, data bytes.Buffer
+	offset := 44 + 20*len(tags)
+	for _, tag := range tags {
+		table := tables[tag]
+		var buf bytes.Buffer
+		zw := zlib.NewWriter(&buf)
+		zw.Write(table)
+		zw.Close()
+		comp := table
+		if buf.Len() < len(table) {
+			comp = buf.Bytes()
+		}
+		dir.WriteString(tag)
+		tWrite(&dir, uint32(offset+data.Len()), uint32(len(comp)),
+			uint32(len(table)), uint32(0))
+		data.Write(comp)
+		for data.Len()%4 != 0 {
+			data.WriteByte(0)
+		}
+	}
+	var buf bytes.Buffer
+	buf.WriteString("wOFF")
+	buf.Write(font[:4])
+	tWrite(&buf, uint32(offset+data.Len()), uint16(len(tags)), uint16(0),
+		uint32(len(font)), uint16(1), uint16(0),
+		uint32(0), uint32(0), uint32(0), uint32(0), uint32(0))
+	buf.Write(dir.Bytes())
+	buf.Write(data.Bytes())
+	return buf.Bytes()
+} //                                                                  tBuildWOFF
+
+// tCFFTable builds a minimal CFF table with 'numGlyphs' glyphs: .notdef is
+// empty, the other glyphs are squares. 'kind' is "CFF" for a name-keyed
+// font, "CID" for a CID-keyed font or "CFF2" for a CFF2 table. Each font
+// has one Private DICT with one local subroutine.
+func tCFFTable(name string, numGlyphs int, kind string) []byte {
+	var (
+		cff2   = kind == "CFF2"
+		glyph  = []byte{189, 139, 21, 248, 36, 6, 248, 36, 7, 252, 36, 6}
+		glyphs = [][]byte{{}}
+		num5   = func(val int) []byte {
+			return []byte{29, byte(val >> 24), byte(val >> 16),
+				byte(val >> 8), byte(val)}
+		}
+		join = func(parts ...[]byte) []byte {
+			return bytes.Join(parts, nil)
+		}
+		n        = byte(numGlyphs)
+		private  = join([]byte{139, 20}, num5(8), []byte{19}) // Subrs at 8
+		subrs    = cffIndex([][]byte{{11}}, cff2)             // 11: return
+		strs     = cffIndex(nil, false)
+		charset  = []byte{0}                      // format 0: SIDs 1, 2, ...
+		fdSelect = []byte{3, 0, 1, 0, 0, 0, 0, n} // one range: glyphs to FD 0
+		offs     = map[string]int{}
+	)
+	if !cff2 {
+		glyph, glyphs[0] = append(glyph, 14), []byte{14} // endchar
+	}
+	for i := 1; i < numGlyphs; i++ {
+		glyphs = append(glyphs, glyph)
+		charset = append(charset, 0, byte(i))
+	}
+	if kind == "CID" {
+		strs = cffIndex([][]byte{[]byte("Adobe"), []byte("Identity")}, false)
+		charset = []byte{2, 0, 100, 0, n - 2} // format 2: CIDs 100, 101, ...
+	}
+	layout := func() []byte {
+		var top []byte
+		switch kind {
+		case "CFF":
+			top = join(num5(offs["charset"]), []byte{15},
+				num5(offs["charStrings"]), []byte{17},
+				num5(len(private)), num5(offs["private"]), []byte{18})
+		case "CID":
+			top = join([]byte{248, 27, 248, 28, 139, 12, 30}, // ROS
+				num5(offs["charset"]), []byte{15},
+				num5(offs["fdSelect"]), []byte{12, 37},
+				num5(offs["charStrings"]), []byte{17},
+				num5(offs["fdArray"]), []byte{12, 36})
+		case "CFF2":
+			top = join(num5(offs["charStrings"]), []byte{17},
+				num5(offs["fdArray"]), []byte{12, 36})
+			return join([]byte{2, 0, 5, 0, byte(len(top))}, top,
+				cffIndex(nil, true))
+		}
+		return join([]byte{1, 0, 4, 4}, cffIndex([][]byte{[]byte(name)}, false),
+			cffIndex([][]byte{top}, false), strs, cffIndex(nil, false))
+	}
+	var (
+		front = layout()
+		body  bytes.Buffer
+		put   = func(key string, data []byte) {
+			offs[key] = len(front) + body.Len()
+			body.Write(data)
+		}
+	)
+	if !cff2 {
+		put("charset", charset)
+	}
+	if kind == "CID" {
+		put("fdSelect", fdSelect)
+	}
+	put("charStrings", cffIndex(glyphs, cff2))
+	put("private", private)
+	body.Write(subrs)
+	if kind != "CFF" {
+		put("fdArray", cffIndex([][]byte{join(num5(len(private)),
+			num5(offs["private"]), []byte{18})}, cff2))
+	}
+	return append(layout(), body.Bytes()...)
+} //                                                                   tCFFTable
+
+// tGPOSTable builds a GPOS table with two lookups of the 'kern' feature:
+// an extension lookup with PairPos format 1 (A-V: -80, T-V: -10) and a
+// lookup with PairPos format 2 (T and class 1 'o': -60, class 2 'V': -10),
+// for glyphs A=1 V=2 T=3 o=4
+func tGPOSTable() []byte {
+	var pair1, pair2, ext, lookup1, lookup2, gpos bytes.Buffer
+	//
+	// PairPos format 1: header, 2 pair sets, coverage
+	tWrite(&pair1, uint16(1), uint16(26), uint16(4), uint16(0), uint16(2),
+		uint16(14), uint16(20))
+	tWrite(&pair1, uint16(1), uint16(2), int16(-80)) // A: V
+	tWrite(&pair1, uint16(1), uint16(2), int16(-10)) // T: V
+	tWrite(&pair1, uint16(1), uint16(2), uint16(1), uint16(3))
+	//
+	// PairPos format 2: header, class values, coverage, class definitions
+	tWrite(&pair2, uint16(2), uint16(28), uint16(4), uint16(0),
+		uint16(38), uint16(46), uint16(2), uint16(3),
+		[3]int16{0, 0, 0}, [3]int16{0, -60, -10})
+	tWrite(&pair2, uint16(2), uint16(1), uint16(3), uint16(3), uint16(0))
+	tWrite(&pair2, uint16(1), uint16(3), uint16(1), uint16(1))
+	tWrite(&pair2, uint16(2), uint16(2), uint16(2), uint16(2), uint16(2),
+		uint16(4), uint16(4), uint16(1))
+	//
+	// lookups: extension (type 9) of pair adjustment, pair adjustment
+	tWrite(&ext, uint16(1), uint16(2), uint32(8))
+	tWrite(&lookup1, uint16(9), uint16(0), uint16(1), uint16(8))
+	lookup1.Write(ext.Bytes())
+	lookup1.Write(pair1.Bytes())
+	tWrite(&lookup2, uint16(2), uint16(0), uint16(1), uint16(8))
+	lookup2.Write(pair2.Bytes())
+	//
+	// header, empty script list, feature list, lookup list
+	tWrite(&gpos, uint32(0x00010000), uint16(10), uint16(12), uint16(34),
+		uint16(0),
+		uint16(2), [4]byte{'k', 'e', 'r', 'n'}, uint16(14),
+		[4]byte{'l', 'i', 'g', 'a'}, uint16(14), // unused feature
+		uint16(0), uint16(2), uint16(0), uint16(1),
+		uint16(2), uint16(6), uint16(6+lookup1.Len()))
+	gpos.Write(lookup1.Bytes())
+	gpos.Write(lookup2.Bytes())
+	return gpos.Bytes()
+} //                                                                  tGPOSTable
+
+// tGSUBTable builds a GSUB table with a lookup for each of these features:
+// 'liga' (f+i: 5, f+l: 6), 'tnum' (1: 7), 'salt' (f: 8 or 9), 'smcp'
+// (l: +5), for glyphs f=1 i=2 l=3 1=4
+func tGSUBTable() []byte {
+	subs := make([]bytes.Buffer, 4)
+	// ligature format 1: header, ligature set, 2 ligatures, coverage
+	tWrite(&subs[0], uint16(1), uint16(26), uint16(1), uint16(8),
+		uint16(2), uint16(6), uint16(12),
+		uint16(5), uint16(2), uint16(2),
+		uint16(6), uint16(2), uint16(3),
+		uint16(1), uint16(1), uint16(1))
+	// single format 2: header, substitute glyphs, coverage
+	tWrite(&subs[1], uint16(2), uint16(8), uint16(1), uint16(7),
+		uint16(1), uint16(1), uint16(4))
+	// alternate format 1: header, alternate set, coverage
+	tWrite(&subs[2], uint16(1), uint16(14), uint16(1), uint16(8),
+		uint16(2), uint16(8), uint16(9),
+		uint16(1), uint16(1), uint16(1))
+	// single format 1: header with delta, coverage
+	tWrite(&subs[3], uint16(1), uint16(6), uint16(5),
+		uint16(1), uint16(1), uint16(3))
+	return tBuildGSUB([]string{"liga", "tnum", "salt", "smcp"},
+		[]uint16{4, 1, 3, 1}, subs[0].Bytes(), subs[1].Bytes(),
+		subs[2].Bytes(), subs[3].Bytes())
+} //                                                                  tGSUBTable
+
+// tKernTable builds a 'kern' table (Windows format 0) from triplets
+// of left glyph, right glyph and kerning value
+func tKernTable(pairs ...int) []byte {
+	var buf bytes.Buffer
+	n := len(pairs) / 3
+	tWrite(&buf, uint16(0), uint16(1),
+		uint16(0), uint16(14+6*n), uint16(0x0001),
+		uint16(n), uint16(0), uint16(0), uint16(0))
+	for i := 0; i+2 < len(pairs); i += 3 {
+		tWrite(&buf, uint16(pairs[i]), uint16(pairs[i+1]),
+			int16(pairs[i+2]))
+	}
+	return buf.Bytes()
+} //                                                                  tKernTable
+
+// tOpenTypeFont builds an OpenType font file with CFF outlines. See
+// tTrueTypeTables() for 'name' and 'chars', tCFFTable() for 'kind'.
+func tOpenTypeFont(name, chars, kind string) []byte {
+	tables := tTrueTypeTables(name, chars)
+	delete(tables, "glyf")
+	delete(tables, "loca")
+	tag := "CFF "
+	if kind == "CFF2" {
+		tag = "CFF2"
+	}
+	tables[tag] = tCFFTable(name, len([]rune(chars))+1, kind)
+	return tBuildFont("OTTO", tables)
+} //                                                               tOpenTypeFont
+
+// tTrueTypeFont builds a TrueType font file using tTrueTypeTables()
+func tTrueTypeFont(name, chars string, widths ...uint16) []byte {
+	return tBuildFont("\x00\x01\x00\x00", tTrueTypeTables(name, chars,
+		widths...))
+} //                                                               tTrueTypeFont
+
+// tReadTables returns the tables of an sfnt font file, by tag
+func tReadTables(data []byte) map[string][]byte {
+	ret := make(map[string][]byte)
+	count := int(binary.BigEndian.Uint16(data[4:]))
+	for i := 0; i < count; i++ {
+		entry := data[12+16*i:]
+		offset := binary.BigEndian.Uint32(entry[8:])
+		length := binary.BigEndian.Uint32(entry[12:])
+		ret[string(entry[:4])] = data[offset : offset+length]
+	}
+	return ret
+} //                                                                 tReadTables
+
+// tTrueTypeTables returns the tables of a minimal TrueType font with 1000
+// units per em. The font has a .notdef glyph 500 units wide, followed
+// by a square glyph for each rune in 'chars'. 'widths' specifies the
+// advance width of each of these glyphs; all the glyphs after the
+// last width have the same width. (The default width is 600.)
+func tTrueTypeTables(name, chars string, widths ...uint16) map[string][]byte {
+	runes := []rune(chars)
+	numGlyphs := uint16(len(runes) + 1)
+	if len(widths) == 0 {
+		widths = []uint16{600}
+	}
+	widths = append([]uint16{500}, widths...)
+	if len(widths) > int(numGlyphs) {
+		widths = widths[:numGlyphs]
+	}
+	var head, hhea, maxp, hmtx, cmap, nameT, os2, post, loca, glyf bytes.Buffer
+	tWrite(&head,
+		uint32(0x00010000), uint32(0x00010000), // version, fontRevision
+		uint32(0), uint32(0x5F0F3CF5), uint16(0), // checksum, magic, flags
+		uint16(1000), uint64(0), uint64(0), // unitsPerEm, created, modified
+		int16(0), int16(-200), int16(1000), int16(800), // bounding box
+		uint16(0), uint16(8), int16(2), int16(1), int16(0)) // ..long loca
+	tWrite(&hhea, uint32(0x00010000), int16(800), int16(-200), int16(0),
+		uint16(1000), int16(0), int16(0), int16(1000), int16(1), int16(0),
+		int16(0), [4]int16{}, int16(0), uint16(len(widths)))
+	tWrite(&maxp, uint32(0x00010000), numGlyphs, [13]uint16{})
+	for _, w := range widths {
+		tWrite(&hmtx, w, int16(0))
+	}
+	// cmap: format 4 (Windows BMP) and format 12 (Windows full Unicode)
+	sorted := make([]rune, len(runes))
+	copy(sorted, runes)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	glyphOf := func(r rune) int {
+		for i, it := range runes {
+			if it == r {
+				return i + 1
+			}
+		}
+		return 0
+	}
+	var bmp []rune
+	for _, r := range sorted {
+		if r <= 0xFFFE {
+			bmp = append(bmp, r)
+		}
+	}
+	var fmt4, fmt12 bytes.Buffer
+	segCount := len(bmp) + 1
+	tWrite(&fmt4, uint16(4), uint16(16+8*segCount), uint16(0),
+		uint16(segCount*2), uint16(0), uint16(0), uint16(0))
+	for _, r := range bmp {
+		tWrite(&fmt4, uint16(r))
+	}
+	tWrite(&fmt4, uint16(0xFFFF), uint16(0))
+	for _, r := range bmp {
+		tWrite(&fmt4, uint16(r))
+	}
+	tWrite(&fmt4, uint16(0xFFFF))
+	for _, r := range bmp {
+		tWrite(&fmt4, uint16(glyphOf(r)-int(r)))
+	}
+	tWrite(&fmt4, uint16(1), make([]uint16, segCount))
+	tWrite(&fmt12, uint16(12), uint16(0), uint32(16+12*len(sorted)),
+		uint32(0), uint32(len(sorted)))
+	for _, r := range sorted {
+		tWrite(&fmt12, uint32(r), uint32(r), uint32(glyphOf(r)))
+	}
+	tWrite(&cmap, uint16(0), uint16(2),
+		uint16(3), uint16(1), uint32(20),
+		uint16(3), uint16(10), uint32(20+fmt4.Len()))
+	cmap.Write(fmt4.Bytes())
+	cmap.Write(fmt12.Bytes())
+	//
+	// name: the PostScript name (ID 6) for Macintosh and Windows platforms
+	utf16Name := make([]uint16, 0, len(name))
+	for _, r := range name {
+		utf16Name = append(utf16Name, uint16(r))
+	}
+	tWrite(&nameT, uint16(0), uint16(2), uint16(6+12*2),
+		uint16(1), uint16(0), uint16(0), uint16(6), uint16(len(name)),
+		uint16(0),
+		uint16(3), uint16(1), uint16(0x409), uint16(6),
+		uint16(len(name)*2), uint16(len(name)))
+	nameT.WriteString(name)
+	tWrite(&nameT, utf16Name)
+	//
+	// OS/2 version 4
+	tWrite(&os2, uint16(4), int16(600), uint16(400), uint16(5), uint16(0),
+		[10]int16{}, int16(0), [10]byte{}, [4]uint32{}, [4]byte{'T', 'E',
+			'S', 'T'}, uint16(0x40), uint16(32), uint16(0xFFFF),
+		int16(750), int16(-250), int16(200), uint16(800), uint16(200),
+		[2]uint32{1, 0}, int16(500), int16(700), uint16(0), uint16(32),
+		uint16(2))
+	tWrite(&post, uint32(0x00030000), int16(-12), uint16(0x8000),
+		int16(-100), int16(50), uint32(0), [4]uint32{})
+	//
+	// glyf and loca: .notdef is empty, other glyphs are squares
+	for i := 0; i < int(numGlyphs); i++ {
+		tWrite(&loca, uint32(glyf.Len()))
+		if i == 0 {
+			continue
+		}
+		tWrite(&glyf, int16(1), int16(50), int16(0), int16(450), int16(400),
+			uint16(3), uint16(0), [4]byte{1, 1, 1, 1},
+			int16(50), int16(0), int16(400), int16(0), // x deltas
+			int16(0), int16(400), int16(0), int16(-400)) // y deltas
+	}
+	tWrite(&loca, uint32(glyf.Len()))
+	return map[string][]byte{
+		"head": head.Bytes(), "hhea": hhea.Bytes(), "maxp": maxp.Bytes(),
+		"hmtx": hmtx.Bytes(), "cmap": cmap.Bytes(), "name": nameT.Bytes(),
+		"OS/2": os2.Bytes(), "post": post.Bytes(), "loca": loca.Bytes(),
+		"glyf": glyf.Bytes(),
+	}
+} //                                                             tTrueTypeTables
+
+// tVerifyXref checks that each entry in the cross-reference
+// table of 'pdf' points to the start of the right object
+func tVerifyXref(t *testing.T, pdf []byte) {
+	t.Helper()
+	s := string(pdf)
+	i := strings.LastIndex(s, "\nxref\n")
+	if i == -1 {
+		t.Fatal("xref table not found")
+	}
+	var first, count int
+	fmt.Sscanf(s[i+len("\nxref\n"):], "%d %d", &first, &count)
+	entries := regexp.MustCompile(`(\d{10}) 00000 n`).
+		FindAllStringSubmatch(s[i:], -1)
+	tEqual(t, len(entries), count-1)
+	for n, entry := range entries {
+		offset, _ := strconv.Atoi(entry[1])
+		want := fmt.Sprintf("%d 0 obj", n+1)
+		if !strings.HasPrefix(s[offset:], want) {
+			t.Errorf("xref entry %d does not point to %q", n+1, want)
+		}
+	}
+} //                                                                 tVerifyXref
+
+// tWrite writes fixed-size values to 'buf' in big-endian byte order
+func tWrite(buf *bytes.Buffer, values ...interface{}) {
+	for _, val := range values {
+		binary.Write(buf, binary.BigEndian, val)
+	}
+} //                                                                      tWrite
+
+// end
@@ -0,0 +1,694 @@
+// -----------------------------------------------------------------------------
+// github.com/balacode/one-file-pdf                  one-file-pdf/[pdf_type1.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+// This file contains a handler for PostScript Type 1 fonts. It reads the
+// font program from a .pfb (binary) or .pfa (ASCII) file, and the widths,
+// kerning pairs and descriptor values from the font's .afm file.
+// It augments PDF in pdf_core.go to embed Type 1 fonts,
+// but is not required for basic PDF functionality.
+
+// # Module Initialization
+//   init()
+//
+// # pdfFontHandler Interface (f *pdfType1Font)
+//   readFont(owner *PDF, font interface{}, optFace ...string) bool
+//   textWidthPt(s string) float64
+//   hasGlyph(r rune) bool
+//   fontMetrics() pdfFontMetrics
+//   writeText(s string, rtl bool)
+//   writeFontObjects(font *pdfFont)
+//   pdfVersion() string
+//
+// # Type 1 Parsing Methods (f *pdfType1Font)
+//   readAFM(data []byte)
+//   readPFA(data []byte)
+//   readPFB(data []byte)
+//
+// # Helper Methods (f *pdfType1Font)
+//   code(r rune) (code byte, ok bool)
+//   glyphName(r rune) string
+//   kerning(left, right string) int
+//   readData(arg interface{}, src *string) []byte
+//   toUnicodeCMap() []byte
+//
+// # Internal Constants
+//   pdfGlyphNames = []string
+
+package pdf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// pdfAFMChar contains the metrics of a glyph in an AFM file
+type pdfAFMChar struct {
+	Code  int // code in the font's built-in encoding, or -1 if not encoded
+	Width int // advance width in thousandths of the font size
+}
+
+// pdfType1Font is a PostScript Type 1 font
+type pdfType1Font struct {
+	Name string
+	Err  error
+	AFM  struct { //             Adobe font metrics, read from the .afm file
+		FontName          string
+		FontBBox          [4]int
+		ItalicAngle       float64
+		IsFixedPitch      bool
+		Ascender          int
+		Descender         int
+		CapHeight         int
+		XHeight           int
+		StdVW             int
+		UnderlinePosition int
+		EncodingScheme    string                // "FontSpecific" if symbolic
+		Chars             map[string]pdfAFMChar // metrics of each glyph by name
+		Names             map[int]string        // glyph name of each code
+		Kerning           map[[2]string]int     // KPX pairs of glyph names
+	}
+	//
+	Program []byte        // font program: clear-text, binary and trailer
+	Length1 int           // length of the clear-text portion of the program
+	Length2 int           // length of the binary (eexec encrypted) portion
+	Length3 int           // length of the trailer: zeros and 'cleartomark'
+	Codes   map[rune]byte // codes of the characters written in the PDF
+	Chars   [256]rune     // character of each code in Codes
+	pdf     *PDF
+} //                                                                pdfType1Font
+
+// -----------------------------------------------------------------------------
+// # Module Initialization
+
+// init __
+func init() {
+	pdfNewType1FontHandler = func() pdfFontHandler { return &pdfType1Font{} }
+} //                                                                        init
+
+// -----------------------------------------------------------------------------
+// # pdfFontHandler Interface (f *pdfType1Font)
+
+// readFont loads a Type 1 font program from a .pfb or .pfa file name or
+// slice of bytes, and its metrics from an .afm file. 'optFace' specifies
+// the AFM file name or data. The default is the name of the font file with
+// the .afm extension.
+func (f *pdfType1Font) readFont(owner *PDF, font interface{},
+	optFace ...string) bool {
+	f.Err = nil
+	f.pdf = owner
+	var src string
+	program := f.readData(font, &src)
+	if program == nil {
+		return false
+	}
+	f.Name = src
+	afm := ""
+	if len(optFace) > 0 {
+		afm = optFace[0]
+	} else if fileName, isString := font.(string); isString {
+		ext := filepath.Ext(fileName)
+		afm = fileName[:len(fileName)-len(ext)] + ".afm"
+		if strings.ToUpper(ext) == ext {
+			afm = strings.ToUpper(afm)
+		}
+	}
+	var metrics []byte
+	if strings.HasPrefix(afm, "StartFontMetrics") { // AFM data, not a file
+		metrics = []byte(afm)
+	} else if afm != "" {
+		var err error
+		if metrics, err = os.ReadFile(afm); err != nil {
+			f.pdf.putError(0xE9F04B, "Failed reading AFM file", afm)
+			return false
+		}
+	}
+	if program[0] == 0x80 {
+		f.readPFB(program)
+	} else {
+		f.readPFA(program)
+	}
+	if f.Err == nil && metrics == nil {
+		f.Err = pdfError{id: 0xE3B79E, msg: "No AFM metrics for font"}
+	}
+	f.readAFM(metrics)
+	if err, isT := f.Err.(pdfError); isT {
+		f.pdf.putError(err.id, err.msg, strings.TrimSpace(err.val+" in "+src))
+	} else if f.Err != nil {
+		f.pdf.putError(0xE52F8E, f.Err.Error(), src)
+	}
+	return f.Err == nil
+} //                                                                    readFont
+
+// textWidthPt returns the width of text 's' in points
+func (f *pdfType1Font) textWidthPt(s string) float64 {
+	f.Err = nil
+	var ret float64
+	prev := ""
+	for _, r := range s {
+		name := f.glyphName(r)
+		if name == "" {
+			continue // missing glyphs are not written
+		}
+		ret += float64(f.AFM.Chars[name].Width + f.kerning(prev, name))
+		prev = name
+	}
+	return ret / 1000.0 * f.pdf.fontSizePt *
+		float64(f.pdf.horzScaling) / 100.0
+} //                                                                 textWidthPt
+
+// hasGlyph returns true if the font has a glyph for the character 'r'
+func (f *pdfType1Font) hasGlyph(r rune) bool {
+	return f.glyphName(r) != ""
+} //                                                                    hasGlyph
+
+// fontMetrics returns the vertical metrics of the font, in thousandths
+// of the font size. Metrics missing from the AFM are estimated from the
+// font's bounding box.
+func (f *pdfType1Font) fontMetrics() pdfFontMetrics {
+	ret := pdfFontMetrics{
+		ascent:    f.AFM.Ascender,
+		descent:   f.AFM.Descender,
+		leading:   f.AFM.FontBBox[3] - f.AFM.FontBBox[1],
+		capHeight: f.AFM.CapHeight,
+		xHeight:   f.AFM.XHeight,
+		underline: f.AFM.UnderlinePosition,
+	}
+	if ret.ascent == 0 {
+		ret.ascent = f.AFM.FontBBox[3]
+	}
+	if ret.descent == 0 {
+		ret.descent = f.AFM.FontBBox[1]
+	}
+	if ret.capHeight == 0 {
+		ret.capHeight = ret.ascent
+	}
+	return ret
+} //                                                                 fontMetrics
+
+// writeText encodes text in the string 's'. If 'rtl' is true,
+// the characters are written in reverse (right-to-left) order.
+func (f *pdfType1Font) writeText(s string, rtl bool) {
+	f.Err = nil
+	ar := []rune(s)
+	if rtl {
+		for i, j := 0, len(ar)-1; i < j; i, j = i+1, j-1 {
+			ar[i], ar[j] = ar[j], ar[i]
+		}
+	}
+	// write hex encoded text to PDF. Kerning is written between strings
+	// in the TJ array, where positive numbers move glyphs closer
+	f.pdf.write("BT ", f.pdf.page.x, " ", f.pdf.page.y, " Td [<")
+	prev := ""
+	for i, r := range ar {
+		name := f.glyphName(r)
+		if name == "" {
+			f.pdf.putError(0xE7A5D2, "Glyph not in font",
+				fmt.Sprintf("at %d = '%s'", i, string(r)))
+			continue
+		}
+		code, ok := f.code(r)
+		if !ok {
+			f.pdf.putError(0xE4D81A, "Too many characters in Type 1 font",
+				fmt.Sprintf("at %d = '%s'", i, string(r)))
+			continue
+		}
+		if kern := f.kerning(prev, name); kern != 0 {
+			f.pdf.write(">", -kern, "<")
+		}
+		f.pdf.write(fmt.Sprintf("%02X", code))
+		prev = name
+	}
+	f.pdf.write(">] TJ ET\n")
+} //                                                                   writeText
+
+// writeFontObjects writes the PDF objects that define the embedded font:
+// a Type1 font with a /Differences encoding that gives a code to each
+// character used, the font descriptor, the font program, and a ToUnicode
+// CMap, which allows text to be extracted.
+func (f *pdfType1Font) writeFontObjects(font *pdfFont) {
+	f.Err = nil
+	var (
+		p           = f.pdf
+		descrObj    = p.reserveObj()
+		fileObj     = p.reserveObj()
+		cmapObj     = p.reserveObj()
+		first, last = 256, 0
+	)
+	for code, r := range f.Chars {
+		if r != 0 && code < first {
+			first = code
+		}
+		if r != 0 && code > last {
+			last = code
+		}
+	}
+	if first > last { // no text written with the font
+		first, last = 32, 32
+	}
+	var widths, diffs []string
+	for code := first; code <= last; code++ {
+		r := f.Chars[code]
+		if r == 0 {
+			widths = append(widths, "0")
+			continue
+		}
+		name := f.glyphName(r)
+		widths = append(widths, strconv.Itoa(f.AFM.Chars[name].Width))
+		if code == first {
+			diffs = append(diffs, strconv.Itoa(code))
+		} else if f.Chars[code-1] == 0 {
+			diffs = append(diffs, " "+strconv.Itoa(code))
+		}
+		diffs = append(diffs, "/"+name)
+	}
+	p.writeObj("/Font").write("/Subtype/Type1/BaseFont/", f.AFM.FontName,
+		"\n/FirstChar ", first, "/LastChar ", last,
+		"/Widths[", strings.Join(widths, " "), "]\n"+
+			"/Encoding<</Type/Encoding/Differences[",
+		strings.Join(diffs, ""), "]>>\n",
+		"/FontDescriptor ", descrObj, " 0 R/ToUnicode ", cmapObj, " 0 R>>\n"+
+			"endobj\n\n")
+	//
+	// font descriptor: flags 1=fixed pitch, 4=symbolic, 32=nonsymbolic,
+	// 64=italic
+	flags := 32
+	if f.AFM.EncodingScheme == "FontSpecific" {
+		flags = 4
+	}
+	if f.AFM.IsFixedPitch {
+		flags |= 1
+	}
+	if f.AFM.ItalicAngle != 0 {
+		flags |= 64
+	}
+	metrics, stemV := f.fontMetrics(), f.AFM.StdVW
+	if stemV == 0 {
+		stemV = 80 // typical of regular weight fonts
+	}
+	box, angle := f.AFM.FontBBox, f.AFM.ItalicAngle
+	p.writeObj("/FontDescriptor", descrObj).
+		write("/FontName/", f.AFM.FontName, "/Flags ", flags, "\n",
+			"/FontBBox[", box[0], " ", box[1], " ", box[2], " ", box[3], "]",
+			"/ItalicAngle ", strconv.FormatFloat(angle, 'f', -1, 64), "\n",
+			"/Ascent ", metrics.ascent, "/Descent ", metrics.descent,
+			"/CapHeight ", metrics.capHeight)
+	if f.AFM.XHeight != 0 {
+		p.write("/XHeight ", f.AFM.XHeight)
+	}
+	p.write("/StemV ", stemV, "\n/FontFile ", fileObj, " 0 R>>\n"+
+		"endobj\n\n")
+	//
+	// the font program and ToUnicode CMap
+	p.write(p.nextObj(fileObj), " 0 obj <</Length1 ", f.Length1,
+		"/Length2 ", f.Length2, "/Length3 ", f.Length3).
+		writeStreamData(f.Program).write("\n" + "endobj\n\n")
+	p.writeStreamObj(f.toUnicodeCMap(), cmapObj)
+} //                                                            writeFontObjects
+
+// pdfVersion returns the minimum PDF version needed to embed the font
+func (f *pdfType1Font) pdfVersion() string {
+	return "1.4"
+} //                                                                  pdfVersion
+
+// -----------------------------------------------------------------------------
+// # Type 1 Parsing Methods (f *pdfType1Font)
+
+// readAFM reads the font's metrics from the text of an AFM file:
+// the global font information, character metrics and kerning pairs
+func (f *pdfType1Font) readAFM(data []byte) {
+	if f.Err != nil {
+		return
+	}
+	f.AFM.Chars = make(map[string]pdfAFMChar)
+	f.AFM.Names = make(map[int]string)
+	f.AFM.Kerning = make(map[[2]string]int)
+	atoi := func(s string) int {
+		n, _ := strconv.ParseFloat(s, 64) // some AFM files use decimals
+		return int(n)
+	}
+	section := ""
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		key, args := fields[0], fields[1:]
+		if strings.HasPrefix(key, "Start") || strings.HasPrefix(key, "End") {
+			section = key
+			continue
+		}
+		switch {
+		case section == "StartCharMetrics":
+			// e.g. "C 65 ; WX 667 ; N A ; B 14 0 654 718 ;"
+			char, name := pdfAFMChar{Code: -1}, ""
+			for _, item := range strings.Split(line, ";") {
+				kv := strings.Fields(item)
+				if len(kv) < 2 {
+					continue
+				}
+				switch kv[0] {
+				case "C":
+					char.Code = atoi(kv[1])
+				case "CH":
+					n, _ := strconv.ParseInt(strings.Trim(kv[1], "<>"), 16, 32)
+					char.Code = int(n)
+				case "WX", "W0X":
+					char.Width = atoi(kv[1])
+				case "N":
+					name = kv[1]
+				}
+			}
+			if name == "" {
+				continue
+			}
+			f.AFM.Chars[name] = char
+			if char.Code >= 0 {
+				f.AFM.Names[char.Code] = name
+			}
+		case section == "StartKernPairs" && len(args) >= 3:
+			if key == "KPX" || key == "KP" {
+				f.AFM.Kerning[[2]string{args[0], args[1]}] = atoi(args[2])
+			}
+		case len(args) == 0:
+			continue
+		case key == "FontName":
+			f.AFM.FontName = args[0]
+		case key == "FontBBox" && len(args) == 4:
+			for i, arg := range args {
+				f.AFM.FontBBox[i] = atoi(arg)
+			}
+		case key == "ItalicAngle":
+			f.AFM.ItalicAngle, _ = strconv.ParseFloat(args[0], 64)
+		case key == "IsFixedPitch":
+			f.AFM.IsFixedPitch = args[0] == "true"
+		case key == "Ascender":
+			f.AFM.Ascender = atoi(args[0])
+		case key == "Descender":
+			f.AFM.Descender = atoi(args[0])
+		case key == "CapHeight":
+			f.AFM.CapHeight = atoi(args[0])
+		case key == "XHeight":
+			f.AFM.XHeight = atoi(args[0])
+		case key == "StdVW":
+			f.AFM.StdVW = atoi(args[0])
+		case key == "UnderlinePosition":
+			f.AFM.UnderlinePosition = atoi(args[0])
+		case key == "EncodingScheme":
+			f.AFM.EncodingScheme = args[0]
+		}
+	}
+	if f.AFM.FontName == "" || len(f.AFM.Chars) == 0 {
+		f.Err = pdfError{id: 0xE6E2B5, msg: "Invalid AFM metrics"}
+	}
+} //                                                                     readAFM
+
+// readPFA reads a font program in PFA (printer font ASCII) format,
+// in which the eexec encrypted portion is written in hexadecimal.
+// The portion is converted to binary, as PDF requires.
+func (f *pdfType1Font) readPFA(data []byte) {
+	if f.Err != nil {
+		return
+	}
+	if !bytes.HasPrefix(data, []byte("%!")) {
+		f.Err = pdfError{id: 0xE0C6A4, msg: "Invalid Type 1 font"}
+		return
+	}
+	// the clear-text portion ends after the line with 'eexec'
+	start := bytes.Index(data, []byte("eexec"))
+	if start == -1 {
+		f.Err = pdfError{id: 0xE2C1D7, msg: "Missing eexec section in font"}
+		return
+	}
+	start += len("eexec")
+	for start < len(data) && (data[start] == '\r' || data[start] == '\n' ||
+		data[start] == ' ' || data[start] == '\t') {
+		start++
+	}
+	// the trailer starts with the lines of zeros before 'cleartomark'
+	end := start
+	for end < len(data) {
+		line := data[end:]
+		if i := bytes.IndexAny(line, "\r\n"); i != -1 {
+			line = line[:i]
+		}
+		text := bytes.TrimSpace(line)
+		if bytes.HasPrefix(text, []byte("cleartomark")) ||
+			(len(text) >= 64 && len(bytes.Trim(text, "0")) == 0) {
+			break
+		}
+		end += len(line)
+		for end < len(data) && (data[end] == '\r' || data[end] == '\n') {
+			end++
+		}
+	}
+	encrypted, err := hex.DecodeString(strings.Join(
+		strings.Fields(string(data[start:end])), ""))
+	if err != nil {
+		encrypted = data[start:end] // already binary
+	}
+	f.Program = append(append(append([]byte{}, data[:start]...),
+		encrypted...), data[end:]...)
+	f.Length1, f.Length2, f.Length3 = start, len(encrypted), len(data)-end
+} //                                                                     readPFA
+
+// readPFB reads a font program in PFB (printer font binary) format, which
+// is a series of segments, each starting with 0x80, the segment's type
+// (1: ASCII, 2: binary, 3: end of file) and its length (4 bytes).
+func (f *pdfType1Font) readPFB(data []byte) {
+	if f.Err != nil {
+		return
+	}
+	var parts [3][]byte // clear-text, binary and trailer portions
+	for len(data) > 0 {
+		if len(data) < 2 || data[0] != 0x80 {
+			f.Err = pdfError{id: 0xE5E0A9, msg: "Invalid PFB segment"}
+			return
+		}
+		kind := data[1]
+		if kind == 3 {
+			break
+		}
+		if len(data) < 6 || (kind != 1 && kind != 2) {
+			f.Err = pdfError{id: 0xE5E0A9, msg: "Invalid PFB segment"}
+			return
+		}
+		size := int(binary.LittleEndian.Uint32(data[2:6]))
+		if size > len(data)-6 {
+			f.Err = pdfError{id: 0xE7D44C, msg: "Truncated PFB segment"}
+			return
+		}
+		i := 0 // ASCII segments after the binary ones are the trailer
+		if kind == 2 {
+			i = 1
+		} else if len(parts[1]) > 0 {
+			i = 2
+		}
+		parts[i] = append(parts[i], data[6:6+size]...)
+		data = data[6+size:]
+	}
+	if !bytes.HasPrefix(parts[0], []byte("%!")) || len(parts[1]) == 0 {
+		f.Err = pdfError{id: 0xE0C6A4, msg: "Invalid Type 1 font"}
+		return
+	}
+	f.Program = bytes.Join(parts[:], nil)
+	f.Length1, f.Length2, f.Length3 =
+		len(parts[0]), len(parts[1]), len(parts[2])
+} //                                                                     readPFB
+
+// -----------------------------------------------------------------------------
+// # Helper Methods (f *pdfType1Font)
+
+// code returns the code of the character 'r' in the font's encoding,
+// giving it the next free code if it doesn't have one yet: characters
+// 32 to 126 use their own code if possible, others use 128 to 255,
+// then any free code. Returns false if all codes are used.
+func (f *pdfType1Font) code(r rune) (code byte, ok bool) {
+	if code, ok = f.Codes[r]; ok {
+		return code, ok
+	}
+	if f.Codes == nil {
+		f.Codes = make(map[rune]byte)
+	}
+	next := -1
+	if r >= 32 && r < 127 && f.Chars[r] == 0 {
+		next = int(r)
+	}
+	for i := 128; i < 128+256 && next == -1; i++ {
+		if i%256 != 0 && f.Chars[i%256] == 0 {
+			next = i % 256
+		}
+	}
+	if next == -1 {
+		return 0, false
+	}
+	f.Codes[r], f.Chars[next] = byte(next), r
+	return byte(next), true
+} //                                                                        code
+
+// glyphName returns the name of the font's glyph for the character 'r',
+// or a blank string if the font has no glyph for it. Glyphs of symbolic
+// fonts are selected by their code, or U+F000 plus their code.
+func (f *pdfType1Font) glyphName(r rune) string {
+	if f.AFM.EncodingScheme == "FontSpecific" {
+		if r >= 0xF000 && r <= 0xF0FF {
+			r -= 0xF000
+		}
+		return f.AFM.Names[int(r)]
+	}
+	var names []string
+	if code, ok := f.pdf.builtInCode(&pdfFont{}, r); ok && code >= 32 {
+		names = append(names, pdfGlyphNames[code-32])
+	}
+	if glyph, found := pdfBuiltInGlyphs[r]; found {
+		names = append(names, glyph.name)
+	}
+	if r <= 0xFFFF {
+		names = append(names, fmt.Sprintf("uni%04X", r))
+	} else {
+		names = append(names, fmt.Sprintf("u%X", r))
+	}
+	for _, name := range names {
+		if _, found := f.AFM.Chars[name]; found {
+			return name
+		}
+	}
+	return ""
+} //                                                                   glyphName
+
+// kerning returns the kerning adjustment between two glyphs, in
+// thousandths of the font size. A negative value moves them closer.
+func (f *pdfType1Font) kerning(left, right string) int {
+	if left == "" || f.pdf.fontFeature("kern") == 0 {
+		return 0
+	}
+	return f.AFM.Kerning[[2]string{left, right}]
+} //                                                                     kerning
+
+// readData returns the data of a font file name or slice of bytes,
+// and sets 'src' to a description of the source. Returns nil if the
+// font can't be read. Logs an error, unless the file doesn't exist.
+func (f *pdfType1Font) readData(arg interface{}, src *string) []byte {
+	var (
+		data []byte
+		err  error
+	)
+	switch arg := arg.(type) {
+	case string:
+		*src = arg
+		data, err = os.ReadFile(arg)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil // not a font file: caller logs 'Invalid font'
+		}
+	case []byte:
+		*src = fmt.Sprintf("[]byte len(%d)", len(arg))
+		data = arg
+	case io.Reader:
+		*src = "io.Reader"
+		data, err = io.ReadAll(arg)
+	default:
+		f.pdf.putError(0xE1B0F3, "Invalid type in arg",
+			reflect.TypeOf(arg).String())
+		return nil
+	}
+	if err != nil {
+		f.pdf.putError(0xE8C5A0, "Failed reading font file", *src)
+		return nil
+	}
+	if len(data) == 0 {
+		f.pdf.putError(0xE0C6A4, "Invalid Type 1 font", *src)
+		return nil
+	}
+	return data
+} //                                                                    readData
+
+// toUnicodeCMap returns a ToUnicode CMap that maps the codes used
+// in the PDF to their Unicode characters
+func (f *pdfType1Font) toUnicodeCMap() []byte {
+	var chars []string
+	for code, r := range f.Chars {
+		if r == 0 {
+			continue
+		}
+		text := ""
+		for _, u := range utf16.Encode([]rune{r}) {
+			text += fmt.Sprintf("%04X", u)
+		}
+		chars = append(chars, fmt.Sprintf("<%02X> <%s>\n", code, text))
+	}
+	var buf bytes.Buffer
+	buf.WriteString("/CIDInit /ProcSet findresource begin\n" +
+		"12 dict begin\n" +
+		"begincmap\n" +
+		"/CIDSystemInfo <</Registry (Adobe) /Ordering (UCS) " +
+		"/Supplement 0>> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n" +
+		"/CMapType 2 def\n" +
+		"1 begincodespacerange\n" +
+		"<00> <FF>\n" +
+		"endcodespacerange\n")
+	for len(chars) > 0 { // up to 100 entries per block
+		n := len(chars)
+		if n > 100 {
+			n = 100
+		}
+		buf.WriteString(fmt.Sprintf("%d beginbfchar\n", n))
+		for _, s := range chars[:n] {
+			buf.WriteString(s)
+		}
+		buf.WriteString("endbfchar\n")
+		chars = chars[n:]
+	}
+	buf.WriteString("endcmap\n" +
+		"CMapName currentdict /CMap defineresource pop\n" +
+		"end\n" +
+		"end")
+	return buf.Bytes()
+} //                                                               toUnicodeCMap
+
+// -----------------------------------------------------------------------------
+// # Internal Constants
+
+// pdfGlyphNames specifies the glyph names of WinAnsiEncoding codes 32
+// to 255. Unused codes are named ".notdef"
+var pdfGlyphNames = strings.Fields(`
+	space exclam quotedbl numbersign dollar percent ampersand quotesingle
+	parenleft parenright asterisk plus comma hyphen period slash
+	zero one two three four five six seven
+	eight nine colon semicolon less equal greater question
+	at A B C D E F G H I J K L M N O P Q R S T U V W
+	X Y Z bracketleft backslash bracketright asciicircum underscore
+	grave a b c d e f g h i j k l m n o p q r s t u v w
+	x y z braceleft bar braceright asciitilde .notdef
+	Euro .notdef quotesinglbase florin quotedblbase ellipsis dagger daggerdbl
+	circumflex perthousand Scaron guilsinglleft OE .notdef Zcaron .notdef
+	.notdef quoteleft quoteright quotedblleft quotedblright bullet endash emdash
+	tilde trademark scaron guilsinglright oe .notdef zcaron Ydieresis
+	space exclamdown cent sterling currency yen brokenbar section
+	dieresis copyright ordfeminine guillemotleft logicalnot hyphen registered
+	macron degree plusminus twosuperior threesuperior acute mu paragraph
+	periodcentered cedilla onesuperior ordmasculine guillemotright onequarter
+	onehalf threequarters questiondown
+	Agrave Aacute Acircumflex Atilde Adieresis Aring AE Ccedilla
+	Egrave Eacute Ecircumflex Edieresis Igrave Iacute Icircumflex Idieresis
+	Eth Ntilde Ograve Oacute Ocircumflex Otilde Odieresis multiply
+	Oslash Ugrave Uacute Ucircumflex Udieresis Yacute Thorn germandbls
+	agrave aacute acircumflex atilde adieresis aring ae ccedilla
+	egrave eacute ecircumflex edieresis igrave iacute icircumflex idieresis
+	eth ntilde ograve oacute ocircumflex otilde odieresis divide
+	oslash ugrave uacute ucircumflex udieresis yacute thorn ydieresis
+`) //                                                              pdfGlyphNames
+
+// end
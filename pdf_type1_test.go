@@ -0,0 +1,241 @@
+// -----------------------------------------------------------------------------
+// github.com/balacode/one-file-pdf             one-file-pdf/[pdf_type1_test.go]
+// (c) balarabe@protonmail.com                                      License: MIT
+// -----------------------------------------------------------------------------
+
+package pdf
+
+// # Type 1 Font Handler Tests:
+//   Test_pdfType1Font_readFont_
+//   Test_pdfType1Font_writeText_
+//   Test_pdfType1Font_writeFontObjects_
+//
+// # Test Font Builders
+//   tType1AFM(encoding string) string
+//   tType1PFA() []byte
+//   tType1PFB() []byte
+
+//  This file contains unit tests for the Type 1 font handler.
+//  The font programs used in these tests only have the parts
+//  of a Type 1 font that the handler reads: the clear-text,
+//  binary and trailer portions.
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Test_pdfType1Font_readFont_ tests reading of PFB and PFA font programs
+// and AFM metrics by readFont()
+func Test_pdfType1Font_readFont_(t *testing.T) {
+	//
+	// a PFB and a PFA font have the same program, with a binary portion
+	var doc PDF
+	pfb, pfa := &pdfType1Font{}, &pdfType1Font{}
+	tEqual(t, pfb.readFont(&doc, tType1PFB(), tType1AFM("")), true)
+	tEqual(t, pfa.readFont(&doc, tType1PFA(), tType1AFM("")), true)
+	failIfHasErrors(t, doc.Errors)
+	tEqual(t, string(pfa.Program), string(pfb.Program))
+	tEqual(t, []int{pfb.Length1, pfb.Length2, pfb.Length3},
+		[]int{36, 4, 532})
+	tEqual(t, []int{pfa.Length1, pfa.Length2, pfa.Length3},
+		[]int{36, 4, 532})
+	//
+	// metrics from the AFM
+	tEqual(t, pfb.AFM.FontName, "TestType1")
+	tEqual(t, pfb.AFM.FontBBox, [4]int{-50, -200, 1000, 900})
+	tEqual(t, pfb.AFM.ItalicAngle, -12.5)
+	tEqual(t, pfb.AFM.Chars["A"], pdfAFMChar{Code: 65, Width: 700})
+	tEqual(t, pfb.AFM.Chars["Euro"], pdfAFMChar{Code: -1, Width: 500})
+	tEqual(t, pfb.AFM.Names[86], "V")
+	tEqual(t, pfb.AFM.Kerning[[2]string{"A", "V"}], -80)
+	tEqual(t, pfb.fontMetrics(), pdfFontMetrics{ascent: 750, descent: -250,
+		leading: 1100, capHeight: 700, xHeight: 500, underline: -100})
+	//
+	// characters map to glyphs by WinAnsi names, the names of the
+	// built-in fonts' extra glyphs, or 'uniXXXX' names
+	for _, tc := range []struct {
+		r    rune
+		want string
+	}{
+		{' ', "space"}, {'A', "A"}, {'€', "Euro"}, {'ł', "lslash"},
+		{'Ж', "uni0416"}, {'B', ""}, {'中', ""},
+	} {
+		tEqual(t, pfb.glyphName(tc.r), tc.want)
+		tEqual(t, pfb.hasGlyph(tc.r), tc.want != "")
+	}
+	// a font file is read with the AFM file of the same name
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "test.pfb"), tType1PFB(), 0644)
+	os.WriteFile(filepath.Join(dir, "test.afm"), []byte(tType1AFM("")), 0644)
+	doc = NewPDF("A4")
+	font, valid := doc.loadFont(filepath.Join(dir, "test.pfb"))
+	failIfHasErrors(t, doc.Errors)
+	_, isType1 := font.handler.(*pdfType1Font)
+	tEqual(t, valid && isType1, true)
+	//
+	// errors
+	for _, tc := range []struct {
+		font interface{}
+		afm  []string
+		want string
+	}{
+		{filepath.Join(dir, "other.pfb"), nil, "Invalid font"},
+		{tType1PFB(), nil, "No AFM metrics for font"},
+		{tType1PFB(), []string{"StartFontMetrics 4.1\n"},
+			"Invalid AFM metrics"},
+		{tType1PFB()[:20], []string{tType1AFM("")}, "Truncated PFB segment"},
+		{[]byte("%!PS-AdobeFont-1.0\n"), []string{tType1AFM("")},
+			"Missing eexec section in font"},
+	} {
+		doc = NewPDF("A4")
+		doc.RegisterFont("Brand", tc.font, tc.afm...)
+		tEqual(t, doc.ErrorInfo(doc.PullError()).Msg, tc.want)
+	}
+} //                                                 Test_pdfType1Font_readFont_
+
+// Test_pdfType1Font_writeText_ tests writing and measuring of text,
+// including kerning and codes given to characters outside ASCII
+func Test_pdfType1Font_writeText_(t *testing.T) {
+	doc := NewPDF("A4")
+	doc.SetCompression(false).SetUnits("pt").
+		RegisterFont("Brand", tType1PFB(), tType1AFM("")).
+		SetFont("Brand", 10).DrawText("V")
+	//
+	// 'AV' is kerned by -80: (700 + 650 - 80) / 1000 * 10pt
+	tEqual(t, floatStr(doc.TextWidth("AV")), "12.700")
+	tEqual(t, floatStr(doc.TextWidth("A V€")), "21.000")
+	//
+	// characters 32 to 126 keep their codes, others use codes from 128
+	doc.SetXY(10, 10).DrawText("AV €łЖ")
+	failIfHasErrors(t, doc.Errors)
+	got := doc.pages[0].content.String()
+	tEqual(t, strings.Contains(got,
+		"BT 10.000 831.890 Td [<41>80<5620808182>] TJ ET\n"), true)
+	//
+	// right-to-left text is written in reverse
+	f := doc.font.handler.(*pdfType1Font)
+	doc.pages[0].content.Reset()
+	f.writeText("VA", true)
+	tEqual(t, doc.pages[0].content.String(),
+		"BT 41.200 831.890 Td [<41>80<56>] TJ ET\n")
+	//
+	// missing glyphs are left out
+	doc.DrawText("AB")
+	tEqual(t, doc.ErrorInfo(doc.PullError()).Msg, "Glyph not in font")
+	//
+	// symbolic fonts select glyphs by code, or U+F000 plus the code
+	doc = NewPDF("A4")
+	doc.RegisterFont("Dings", tType1PFB(), tType1AFM("FontSpecific")).
+		SetFont("Dings", 10).DrawText("A")
+	f = doc.font.handler.(*pdfType1Font)
+	tEqual(t, f.glyphName('A'), "A")
+	tEqual(t, f.glyphName(0xF041), "A")
+	tEqual(t, f.glyphName('€'), "")
+} //                                                Test_pdfType1Font_writeText_
+
+// Test_pdfType1Font_writeFontObjects_ tests the objects of an embedded
+// Type 1 font
+func Test_pdfType1Font_writeFontObjects_(t *testing.T) {
+	doc := NewPDF("A4")
+	doc.SetCompression(false).
+		RegisterFont("Brand", tType1PFB(), tType1AFM("")).
+		SetFont("Brand", 10).DrawText("V A€")
+	ar := doc.Bytes()
+	failIfHasErrors(t, doc.Errors)
+	tVerifyXref(t, ar)
+	got := string(ar)
+	zeros := func(n int) string { return strings.Repeat(" 0", n) }
+	for _, want := range []string{
+		"5 0 obj <</Type/Font/Subtype/Type1/BaseFont/TestType1\n" +
+			"/FirstChar 32/LastChar 128/Widths[250" + zeros(32) + " 700" +
+			zeros(20) + " 650" + zeros(41) + " 500]\n" +
+			"/Encoding<</Type/Encoding/Differences[32/space 65/A 86/V " +
+			"128/Euro]>>\n" +
+			"/FontDescriptor 6 0 R/ToUnicode 8 0 R>>\n",
+		"6 0 obj <</Type/FontDescriptor/FontName/TestType1/Flags 96\n" +
+			"/FontBBox[-50 -200 1000 900]/ItalicAngle -12.5\n" +
+			"/Ascent 750/Descent -250/CapHeight 700/XHeight 500/StemV 90\n" +
+			"/FontFile 7 0 R>>\n",
+		"7 0 obj <</Length1 36/Length2 4/Length3 532/Length 572>> stream\n" +
+			"%!PS-AdobeFont-1.0: TestType1\n" +
+			"eexec\n\x01\x02\x03\x04000",
+		"1 begincodespacerange\n<00> <FF>\nendcodespacerange\n" +
+			"4 beginbfchar\n" +
+			"<20> <0020>\n<41> <0041>\n<56> <0056>\n<80> <20AC>\n" +
+			"endbfchar\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("PDF does not contain %q", want)
+		}
+	}
+} //                                         Test_pdfType1Font_writeFontObjects_
+
+// -----------------------------------------------------------------------------
+// # Test Font Builders
+
+// tType1AFM returns the AFM metrics of the test Type 1 font.
+// 'encoding' is the encoding scheme, e.g. "FontSpecific"
+func tType1AFM(encoding string) string {
+	if encoding == "" {
+		encoding = "AdobeStandardEncoding"
+	}
+	return "StartFontMetrics 4.1\n" +
+		"FontName TestType1\n" +
+		"FontBBox -50 -200 1000 900\n" +
+		"ItalicAngle -12.5\n" +
+		"IsFixedPitch false\n" +
+		"Ascender 750\n" +
+		"Descender -250\n" +
+		"CapHeight 700\n" +
+		"XHeight 500\n" +
+		"StdVW 90\n" +
+		"UnderlinePosition -100\n" +
+		"EncodingScheme " + encoding + "\n" +
+		"StartCharMetrics 6\n" +
+		"C 32 ; WX 250 ; N space ; B 0 0 0 0 ;\n" +
+		"C 65 ; WX 700 ; N A ; B 0 0 700 700 ;\n" +
+		"C 86 ; WX 650 ; N V ; B 0 0 650 700 ;\n" +
+		"C -1 ; WX 500 ; N Euro ; B 0 0 500 700 ;\n" +
+		"C -1 ; WX 300 ; N lslash ; B 0 0 300 700 ;\n" +
+		"C -1 ; WX 800 ; N uni0416 ; B 0 0 800 700 ;\n" +
+		"EndCharMetrics\n" +
+		"StartKernData\n" +
+		"StartKernPairs 1\n" +
+		"KPX A V -80\n" +
+		"EndKernPairs\n" +
+		"EndKernData\n" +
+		"EndFontMetrics\n"
+} //                                                                   tType1AFM
+
+// tType1PFA returns the test Type 1 font program in PFA format
+func tType1PFA() []byte {
+	return []byte("%!PS-AdobeFont-1.0: TestType1\n" + "eexec\n" +
+		"01020304\n" + strings.Repeat(strings.Repeat("0", 64)+"\n", 8) +
+		"cleartomark\n")
+} //                                                                   tType1PFA
+
+// tType1PFB returns the test Type 1 font program in PFB format
+func tType1PFB() []byte {
+	var buf bytes.Buffer
+	for _, segment := range []string{
+		"\x01%!PS-AdobeFont-1.0: TestType1\n" + "eexec\n",
+		"\x02\x01\x02\x03\x04",
+		"\x01" + strings.Repeat(strings.Repeat("0", 64)+"\n", 8) +
+			"cleartomark\n",
+	} {
+		size := make([]byte, 4)
+		binary.LittleEndian.PutUint32(size, uint32(len(segment)-1))
+		buf.WriteString("\x80" + segment[:1])
+		buf.Write(size)
+		buf.WriteString(segment[1:])
+	}
+	buf.WriteString("\x80\x03")
+	return buf.Bytes()
+} //                                                                   tType1PFB
+
+// end